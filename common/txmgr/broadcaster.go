@@ -57,7 +57,11 @@ var (
 			float64(time.Minute),
 			float64(2 * time.Minute),
 		},
-	}, []string{"chainID"})
+	}, []string{"chainID", "priority"})
+	promSimulationReverts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_simulation_reverts",
+		Help: "The number of transactions predicted to revert when simulated before their first broadcast, by the action taken.",
+	}, []string{"chainID", "action"})
 )
 
 var ErrTxRemoved = errors.New("tx removed")
@@ -472,6 +476,27 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	}
 	cancel()
 
+	if simulation := eb.txConfig.Simulation(); simulation.Enabled() {
+		simCtx, cancel := context.WithTimeout(ctx, TransmitCheckTimeout)
+		revertReason, reverted, err := eb.client.SimulateTransaction(simCtx, *etx, attempt)
+		cancel()
+		if err != nil {
+			lgr.Warnw("Transaction simulation failed, sending anyway", "err", err)
+		} else if reverted {
+			etx.SimulationRevertReason = null.StringFrom(revertReason)
+			action := simulation.OnRevert()
+			promSimulationReverts.WithLabelValues(eb.chainID.String(), string(action)).Inc()
+			switch action {
+			case txmgrtypes.SimulationRevertFatalError:
+				etx.Error = null.StringFrom(fmt.Sprintf("transaction predicted to revert: %s", revertReason))
+				lgr.Warnw("Transaction predicted to revert, fatally erroring transaction.", "revertReason", revertReason)
+				return eb.saveFatallyErroredTransaction(lgr, etx), true
+			default:
+				lgr.Warnw("Transaction predicted to revert, sending anyway", "revertReason", revertReason)
+			}
+		}
+	}
+
 	if err = eb.txStore.UpdateTxUnstartedToInProgress(ctx, etx, &attempt); errors.Is(err, ErrTxRemoved) {
 		eb.lggr.Debugw("tx removed", "txID", etx.ID, "subject", etx.Subject)
 		return nil, false
@@ -549,13 +574,15 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 		// In all scenarios, the correct thing to do is assume success for now
 		// and hand off to the confirmer to get the receipt (or mark as
 		// failed).
-		observeTimeUntilBroadcast(eb.chainID, etx.CreatedAt, time.Now())
+		observeTimeUntilBroadcast(eb.chainID, etx.Priority, etx.CreatedAt, time.Now())
 		err = eb.txStore.UpdateTxAttemptInProgressToBroadcast(ctx, &etx, attempt, txmgrtypes.TxAttemptBroadcast)
 		if err != nil {
 			return err, true
 		}
-		// Increment sequence if successfully broadcasted
-		eb.sequenceTracker.GenerateNextSequence(etx.FromAddress, *etx.Sequence)
+		// Increment sequence if successfully broadcasted, unless it was reused to supersede a transaction
+		if !etx.SupersedesTxID.Valid {
+			eb.sequenceTracker.GenerateNextSequence(etx.FromAddress, *etx.Sequence)
+		}
 		return err, true
 	case client.Underpriced:
 		bumpedAttempt, retryable, replaceErr := eb.replaceAttemptWithBumpedGas(ctx, lgr, err, etx, attempt)
@@ -618,8 +645,10 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 			if err != nil {
 				return err, true
 			}
-			// Increment sequence if successfully broadcasted
-			eb.sequenceTracker.GenerateNextSequence(etx.FromAddress, *etx.Sequence)
+			// Increment sequence if successfully broadcasted, unless it was reused to supersede a transaction
+			if !etx.SupersedesTxID.Valid {
+				eb.sequenceTracker.GenerateNextSequence(etx.FromAddress, *etx.Sequence)
+			}
 			return err, true
 		}
 		// Either the unknown error prevented the transaction from being mined, or
@@ -756,23 +785,33 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) save
 	// Now we have an errored pipeline even though the tx succeeded. This case
 	// is relatively benign and probably nobody will ever run into it in
 	// practice, but something to be aware of.
-	if etx.PipelineTaskRunID.Valid && eb.resumeCallback != nil && etx.SignalCallback && !etx.CallbackCompleted {
-		err := eb.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, fmt.Errorf("fatal error while sending transaction: %s", etx.Error.String))
-		if errors.Is(err, sql.ErrNoRows) {
-			lgr.Debugw("callback missing or already resumed", "etxID", etx.ID)
-		} else if err != nil {
-			return fmt.Errorf("failed to resume pipeline: %w", err)
-		} else {
-			// Mark tx as having completed callback
-			if err := eb.txStore.UpdateTxCallbackCompleted(ctx, etx.PipelineTaskRunID.UUID, eb.chainID); err != nil {
-				return err
+	if eb.resumeCallback != nil {
+		// A batch transaction carries the pipeline runs of all the transactions merged into it
+		taskRunIDs, err := eb.txStore.FindBatchedTaskRunIDsPendingCallback(ctx, etx.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find batched transactions pending callback: %w", err)
+		}
+		if etx.PipelineTaskRunID.Valid && etx.SignalCallback && !etx.CallbackCompleted {
+			taskRunIDs = append(taskRunIDs, etx.PipelineTaskRunID.UUID)
+		}
+		for _, taskRunID := range taskRunIDs {
+			err := eb.resumeCallback(ctx, taskRunID, nil, fmt.Errorf("fatal error while sending transaction: %s", etx.Error.String))
+			if errors.Is(err, sql.ErrNoRows) {
+				lgr.Debugw("callback missing or already resumed", "etxID", etx.ID, "pipelineTaskRunID", taskRunID)
+			} else if err != nil {
+				return fmt.Errorf("failed to resume pipeline: %w", err)
+			} else {
+				// Mark tx as having completed callback
+				if err := eb.txStore.UpdateTxCallbackCompleted(ctx, taskRunID, eb.chainID); err != nil {
+					return err
+				}
 			}
 		}
 	}
 	return eb.txStore.UpdateTxFatalError(ctx, etx)
 }
 
-func observeTimeUntilBroadcast[CHAIN_ID types.ID](chainID CHAIN_ID, createdAt, broadcastAt time.Time) {
+func observeTimeUntilBroadcast[CHAIN_ID types.ID](chainID CHAIN_ID, priority txmgrtypes.TxPriority, createdAt, broadcastAt time.Time) {
 	duration := float64(broadcastAt.Sub(createdAt))
-	promTimeUntilBroadcast.WithLabelValues(chainID.String()).Observe(duration)
+	promTimeUntilBroadcast.WithLabelValues(chainID.String(), priority.String()).Observe(duration)
 }
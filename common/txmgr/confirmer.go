@@ -460,6 +460,10 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Che
 		}
 	}
 
+	if err := ec.settleSupersededTxs(ctx); err != nil {
+		return fmt.Errorf("unable to settle superseded txes: %w", err)
+	}
+
 	if err := ec.txStore.MarkAllConfirmedMissingReceipt(ctx, ec.chainID); err != nil {
 		return fmt.Errorf("unable to mark txes as 'confirmed_missing_receipt': %w", err)
 	}
@@ -516,7 +520,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 				return
 			}
 			// Resume pending task runs with failure for stuck transactions
-			if err := ec.resumeFailedTaskRuns(ctx, tx); err != nil {
+			if err := ec.resumeFailedTaskRuns(ctx, tx, errors.New(ec.stuckTxDetector.StuckTxFatalError())); err != nil {
 				errMu.Lock()
 				errorList = append(errorList, fmt.Errorf("failed to resume pending task run for transaction: %w", err))
 				errMu.Unlock()
@@ -625,20 +629,54 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sep
 	return
 }
 
-func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) resumeFailedTaskRuns(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
-	if !etx.PipelineTaskRunID.Valid || ec.resumeCallback == nil || !etx.SignalCallback || etx.CallbackCompleted {
-		return nil
+// settleSupersededTxs settles the pairs of transactions superseded by an operator of which one was mined, marking the other one
+// as fatally errored. The pipeline runs of a cancelled transaction are resumed with an error once its cancellation is mined,
+// while the ones of a replaced transaction move to its replacement once it is mined.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) settleSupersededTxs(ctx context.Context) error {
+	etxs, err := ec.txStore.FindSupersededTxsToSettle(ctx, ec.chainID)
+	if err != nil {
+		return err
 	}
-	err := ec.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, errors.New(ec.stuckTxDetector.StuckTxFatalError()))
-	if errors.Is(err, sql.ErrNoRows) {
-		ec.lggr.Debugw("callback missing or already resumed", "etxID", etx.ID)
-	} else if err != nil {
-		return fmt.Errorf("failed to resume pipeline: %w", err)
-	} else {
-		// Mark tx as having completed callback
-		if err = ec.txStore.UpdateTxCallbackCompleted(ctx, etx.PipelineTaskRunID.UUID, ec.chainID); err != nil {
+	for _, etx := range etxs {
+		// NOTE: like for the stuck transactions, the pipeline runs are resumed before the transaction is marked as fatally errored,
+		// so they are resumed again, as a no-op, if it fails to be marked
+		if etx.SupersededByTxID.Valid && etx.SupersededByCancel {
+			if err = ec.resumeFailedTaskRuns(ctx, *etx, errors.New("transaction cancelled by operator")); err != nil {
+				return fmt.Errorf("failed to resume pending task runs of cancelled transaction %d: %w", etx.ID, err)
+			}
+		}
+		if err = ec.txStore.SettleSupersededTx(ctx, etx); err != nil {
 			return err
 		}
+		ec.lggr.Infow("Settled transaction superseded by operator", "etxID", etx.ID, "err", etx.Error.String)
+	}
+	return nil
+}
+
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) resumeFailedTaskRuns(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], taskErr error) error {
+	if ec.resumeCallback == nil {
+		return nil
+	}
+	// A batch transaction carries the pipeline runs of all the transactions merged into it
+	taskRunIDs, err := ec.txStore.FindBatchedTaskRunIDsPendingCallback(ctx, etx.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find batched transactions pending callback: %w", err)
+	}
+	if etx.PipelineTaskRunID.Valid && etx.SignalCallback && !etx.CallbackCompleted {
+		taskRunIDs = append(taskRunIDs, etx.PipelineTaskRunID.UUID)
+	}
+	for _, taskRunID := range taskRunIDs {
+		err = ec.resumeCallback(ctx, taskRunID, nil, taskErr)
+		if errors.Is(err, sql.ErrNoRows) {
+			ec.lggr.Debugw("callback missing or already resumed", "etxID", etx.ID, "pipelineTaskRunID", taskRunID)
+		} else if err != nil {
+			return fmt.Errorf("failed to resume pipeline: %w", err)
+		} else {
+			// Mark tx as having completed callback
+			if err = ec.txStore.UpdateTxCallbackCompleted(ctx, taskRunID, ec.chainID); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -863,14 +901,26 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Fin
 		lggr.Infow(fmt.Sprintf("Found %d transactions to re-sent that have still not been confirmed after at least %d blocks. The oldest of these has not still not been confirmed after %d blocks. These transactions will have their gas price bumped. %s", len(etxBumps), gasBumpThreshold, oldestBlocksBehind, label.NodeConnectivityProblemWarning), "blockNum", blockNum, "address", address, "gasBumpThreshold", gasBumpThreshold)
 	}
 
+	etxCriticalBumps, err := ec.findCriticalTxsRequiringGasBump(ctx, address, blockNum, gasBumpThreshold, bumpDepth, chainID)
+	if ctx.Err() != nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(etxCriticalBumps) > 0 {
+		lggr.Infow(fmt.Sprintf("Found %d transactions to re-sent that are critical, or ahead of a critical transaction, and have still not been confirmed after at least %d blocks. These transactions will have their gas price bumped.", len(etxCriticalBumps), ec.txConfig.PriorityLanes().CriticalBumpThreshold()), "blockNum", blockNum, "address", address)
+	}
+
 	seen := make(map[int64]struct{})
 
 	for _, etx := range etxInsufficientFunds {
 		seen[etx.ID] = struct{}{}
 		etxs = append(etxs, etx)
 	}
-	for _, etx := range etxBumps {
+	for _, etx := range append(etxBumps, etxCriticalBumps...) {
 		if _, exists := seen[etx.ID]; !exists {
+			seen[etx.ID] = struct{}{}
 			etxs = append(etxs, etx)
 		}
 	}
@@ -887,6 +937,30 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Fin
 	return
 }
 
+// findCriticalTxsRequiringGasBump returns the critical transactions which have still not been confirmed after
+// the critical bump threshold, which is lower than the one of the other lanes, along with the transactions
+// ahead of them in the sequence, since they can't be confirmed before those are.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) findCriticalTxsRequiringGasBump(ctx context.Context, address ADDR, blockNum, gasBumpThreshold, bumpDepth int64, chainID CHAIN_ID) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	criticalBumpThreshold := int64(ec.txConfig.PriorityLanes().CriticalBumpThreshold())
+	// Critical transactions are bumped like the others, unless they have a lower threshold.
+	// Gas bumping stays disabled altogether when the threshold is zero.
+	if gasBumpThreshold == 0 || criticalBumpThreshold == 0 || criticalBumpThreshold >= gasBumpThreshold {
+		return nil, nil
+	}
+	etxs, err := ec.txStore.FindTxsRequiringGasBump(ctx, address, blockNum, criticalBumpThreshold, bumpDepth, chainID)
+	if err != nil {
+		return nil, err
+	}
+	// txes are ordered by sequence asc, so the last critical one is preceded by all the ones holding it back
+	last := -1
+	for i, etx := range etxs {
+		if etx.Priority == txmgrtypes.TxPriorityCritical {
+			last = i
+		}
+	}
+	return etxs[:last+1], nil
+}
+
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) attemptForRebroadcast(ctx context.Context, lggr logger.Logger, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	if len(etx.TxAttempts) > 0 {
 		etx.TxAttempts[0].Tx = etx
@@ -1261,6 +1335,11 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sen
 
 // ResumePendingTaskRuns issues callbacks to task runs that are pending waiting for receipts
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ResumePendingTaskRuns(ctx context.Context, latest, finalized int64) error {
+	// the task runs of the transactions merged into a batch are resumed with the outcomes of their own calls
+	if err := ec.recordBatchCallOutcomes(ctx, latest, finalized); err != nil {
+		return err
+	}
+
 	receiptsPlus, err := ec.txStore.FindTxesPendingCallback(ctx, latest, finalized, ec.chainID)
 
 	if err != nil {
@@ -1294,6 +1373,41 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Res
 	return nil
 }
 
+// recordBatchCallOutcomes records whether each of the calls of the batch transactions mined as deep as their task runs
+// are resumed at succeeded. A batch whose outcomes can't be fetched or recorded is retried on the next head, without
+// holding back the task runs of the other transactions.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) recordBatchCallOutcomes(ctx context.Context, latest, finalized int64) error {
+	etxs, err := ec.txStore.FindBatchTxsPendingCallOutcomes(ctx, latest, finalized, ec.chainID)
+	if err != nil {
+		return fmt.Errorf("failed to find batch transactions pending call outcomes: %w", err)
+	}
+	for _, etx := range etxs {
+		var receipt txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH]
+		for _, attempt := range etx.TxAttempts {
+			if len(attempt.Receipts) > 0 {
+				receipt = attempt.Receipts[0]
+				break
+			}
+		}
+		if receipt == nil {
+			continue
+		}
+		var succeeded []bool
+		// a reverted batch transaction failed all of its calls
+		if receipt.GetStatus() != 0 {
+			succeeded, err = ec.client.BatchCallOutcomes(ctx, *etx, receipt)
+			if err != nil {
+				ec.lggr.Warnw("Failed to fetch the call outcomes of batch transaction, will retry", "etxID", etx.ID, "err", err)
+				continue
+			}
+		}
+		if err = ec.txStore.SetBatchCallOutcomes(ctx, etx.ID, succeeded); err != nil {
+			ec.lggr.Errorw("Failed to record the call outcomes of batch transaction, will retry", "etxID", etx.ID, "err", err)
+		}
+	}
+	return nil
+}
+
 // observeUntilTxConfirmed observes the promBlocksUntilTxConfirmed metric for each confirmed
 // transaction.
 func observeUntilTxConfirmed[
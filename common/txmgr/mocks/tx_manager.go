@@ -18,6 +18,8 @@ import (
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 
 	types "github.com/smartcontractkit/chainlink/v2/common/types"
+
+	uuid "github.com/google/uuid"
 )
 
 // TxManager is an autogenerated mock type for the TxManager type
@@ -33,6 +35,111 @@ func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) EXPECT
 	return &TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{mock: &_m.Mock}
 }
 
+// AbandonPipelineTaskRunTxs provides a mock function with given fields: ctx, taskRunIDs, reason
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) AbandonPipelineTaskRunTxs(ctx context.Context, taskRunIDs []uuid.UUID, reason string) error {
+	ret := _m.Called(ctx, taskRunIDs, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbandonPipelineTaskRunTxs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, string) error); ok {
+		r0 = rf(ctx, taskRunIDs, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TxManager_AbandonPipelineTaskRunTxs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AbandonPipelineTaskRunTxs'
+type TxManager_AbandonPipelineTaskRunTxs_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// AbandonPipelineTaskRunTxs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskRunIDs []uuid.UUID
+//   - reason string
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) AbandonPipelineTaskRunTxs(ctx interface{}, taskRunIDs interface{}, reason interface{}) *TxManager_AbandonPipelineTaskRunTxs_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_AbandonPipelineTaskRunTxs_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("AbandonPipelineTaskRunTxs", ctx, taskRunIDs, reason)}
+}
+
+func (_c *TxManager_AbandonPipelineTaskRunTxs_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(ctx context.Context, taskRunIDs []uuid.UUID, reason string)) *TxManager_AbandonPipelineTaskRunTxs_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TxManager_AbandonPipelineTaskRunTxs_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(_a0 error) *TxManager_AbandonPipelineTaskRunTxs_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxManager_AbandonPipelineTaskRunTxs_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(context.Context, []uuid.UUID, string) error) *TxManager_AbandonPipelineTaskRunTxs_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelTransaction provides a mock function with given fields: ctx, txID
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CancelTransaction(ctx context.Context, txID int64) (txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	ret := _m.Called(ctx, txID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelTransaction")
+	}
+
+	var r0 txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)); ok {
+		return rf(ctx, txID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]); ok {
+		r0 = rf(ctx, txID)
+	} else {
+		r0 = ret.Get(0).(txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, txID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxManager_CancelTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelTransaction'
+type TxManager_CancelTransaction_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// CancelTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - txID int64
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CancelTransaction(ctx interface{}, txID interface{}) *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("CancelTransaction", ctx, txID)}
+}
+
+func (_c *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(ctx context.Context, txID int64)) *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(etx, err)
+	return _c
+}
+
+func (_c *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(context.Context, int64) (txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)) *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Close() error {
 	ret := _m.Called()
@@ -925,6 +1032,112 @@ func (_c *TxManager_RegisterResumeCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, B
 	return _c
 }
 
+// RegisterRevertABI provides a mock function with given fields: to, abiJSON
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterRevertABI(to ADDR, abiJSON string) error {
+	ret := _m.Called(to, abiJSON)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterRevertABI")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(ADDR, string) error); ok {
+		r0 = rf(to, abiJSON)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TxManager_RegisterRevertABI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterRevertABI'
+type TxManager_RegisterRevertABI_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// RegisterRevertABI is a helper method to define mock.On call
+//   - to ADDR
+//   - abiJSON string
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterRevertABI(to interface{}, abiJSON interface{}) *TxManager_RegisterRevertABI_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_RegisterRevertABI_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("RegisterRevertABI", to, abiJSON)}
+}
+
+func (_c *TxManager_RegisterRevertABI_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(to ADDR, abiJSON string)) *TxManager_RegisterRevertABI_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(ADDR), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TxManager_RegisterRevertABI_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(_a0 error) *TxManager_RegisterRevertABI_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxManager_RegisterRevertABI_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(ADDR, string) error) *TxManager_RegisterRevertABI_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceTransaction provides a mock function with given fields: ctx, txID, encodedPayload, feeLimit
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) ReplaceTransaction(ctx context.Context, txID int64, encodedPayload []byte, feeLimit uint64) (txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	ret := _m.Called(ctx, txID, encodedPayload, feeLimit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceTransaction")
+	}
+
+	var r0 txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []byte, uint64) (txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)); ok {
+		return rf(ctx, txID, encodedPayload, feeLimit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []byte, uint64) txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]); ok {
+		r0 = rf(ctx, txID, encodedPayload, feeLimit)
+	} else {
+		r0 = ret.Get(0).(txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []byte, uint64) error); ok {
+		r1 = rf(ctx, txID, encodedPayload, feeLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxManager_ReplaceTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceTransaction'
+type TxManager_ReplaceTransaction_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// ReplaceTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - txID int64
+//   - encodedPayload []byte
+//   - feeLimit uint64
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) ReplaceTransaction(ctx interface{}, txID interface{}, encodedPayload interface{}, feeLimit interface{}) *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("ReplaceTransaction", ctx, txID, encodedPayload, feeLimit)}
+}
+
+func (_c *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(ctx context.Context, txID int64, encodedPayload []byte, feeLimit uint64)) *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]byte), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(etx, err)
+	return _c
+}
+
+func (_c *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(context.Context, int64, []byte, uint64) (txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)) *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Reset provides a mock function with given fields: addr, abandon
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Reset(addr ADDR, abandon bool) error {
 	ret := _m.Called(addr, abandon)
@@ -1079,6 +1292,73 @@ func (_c *TxManager_Start_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, F
 	return _c
 }
 
+// SubscribeTxStatusEvents provides a mock function with given fields: afterEventID
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SubscribeTxStatusEvents(afterEventID int64) (<-chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], func(), error) {
+	ret := _m.Called(afterEventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeTxStatusEvents")
+	}
+
+	var r0 <-chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH]
+	var r1 func()
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int64) (<-chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], func(), error)); ok {
+		return rf(afterEventID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) <-chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH]); ok {
+		r0 = rf(afterEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) func()); ok {
+		r1 = rf(afterEventID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(int64) error); ok {
+		r2 = rf(afterEventID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// TxManager_SubscribeTxStatusEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeTxStatusEvents'
+type TxManager_SubscribeTxStatusEvents_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// SubscribeTxStatusEvents is a helper method to define mock.On call
+//   - afterEventID int64
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SubscribeTxStatusEvents(afterEventID interface{}) *TxManager_SubscribeTxStatusEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_SubscribeTxStatusEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("SubscribeTxStatusEvents", afterEventID)}
+}
+
+func (_c *TxManager_SubscribeTxStatusEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(afterEventID int64)) *TxManager_SubscribeTxStatusEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *TxManager_SubscribeTxStatusEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(_a0 <-chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], _a1 func(), _a2 error) *TxManager_SubscribeTxStatusEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *TxManager_SubscribeTxStatusEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(int64) (<-chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], func(), error)) *TxManager_SubscribeTxStatusEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Trigger provides a mock function with given fields: addr
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Trigger(addr ADDR) {
 	_m.Called(addr)
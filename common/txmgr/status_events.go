@@ -0,0 +1,273 @@
+package txmgr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+const (
+	// statusEventsBatchSize is the maximum number of events loaded at once
+	statusEventsBatchSize = 100
+	// statusEventWebhookTimeout is the maximum duration of a delivery to the webhook
+	statusEventWebhookTimeout = 10 * time.Second
+	// maxStatusEventRetryDelay caps the delay between the retries of a failed delivery
+	maxStatusEventRetryDelay = time.Hour
+	// statusEventsReapInterval is how often the events past the reaper threshold are deleted
+	statusEventsReapInterval = time.Hour
+)
+
+// StatusEventPublisher publishes the state changes of the transactions with an idempotency key, which the TxStore
+// records along with the changes. It delivers them at least once, in order, to the configured webhook, and streams them
+// to the subscribers of the Txm.
+type StatusEventPublisher[CHAIN_ID types.ID, ADDR types.Hashable, TX_HASH types.Hashable] struct {
+	store   txmgrtypes.TxStatusEventStore[ADDR, CHAIN_ID, TX_HASH]
+	config  txmgrtypes.StatusEventsConfig
+	chainID CHAIN_ID
+	lggr    logger.SugaredLogger
+	client  *http.Client
+	chStop  services.StopChan
+	wg      sync.WaitGroup
+}
+
+// NewStatusEventPublisher instantiates a new publisher of the state changes of the transactions of the chain
+func NewStatusEventPublisher[CHAIN_ID types.ID, ADDR types.Hashable, TX_HASH types.Hashable](lggr logger.Logger, store txmgrtypes.TxStatusEventStore[ADDR, CHAIN_ID, TX_HASH], config txmgrtypes.StatusEventsConfig, chainID CHAIN_ID) *StatusEventPublisher[CHAIN_ID, ADDR, TX_HASH] {
+	return &StatusEventPublisher[CHAIN_ID, ADDR, TX_HASH]{
+		store:   store,
+		config:  config,
+		chainID: chainID,
+		lggr:    logger.Sugared(logger.Named(lggr, "StatusEventPublisher")),
+		client:  &http.Client{},
+		chStop:  make(services.StopChan),
+	}
+}
+
+// Start the delivery of the events to the webhook, if any. Should only be called once.
+func (p *StatusEventPublisher[CHAIN_ID, ADDR, TX_HASH]) Start() {
+	if p.config.WebhookURL() == nil {
+		p.lggr.Debug("no webhook configured, events are only streamed to subscribers")
+		return
+	}
+	p.wg.Add(1)
+	go p.deliveryLoop()
+}
+
+// Stop the delivery of the events and the subscriptions. Should only be called once.
+func (p *StatusEventPublisher[CHAIN_ID, ADDR, TX_HASH]) Stop() {
+	close(p.chStop)
+	p.wg.Wait()
+}
+
+func (p *StatusEventPublisher[CHAIN_ID, ADDR, TX_HASH]) deliveryLoop() {
+	defer p.wg.Done()
+	ctx, cancel := p.chStop.NewCtx()
+	defer cancel()
+	ticker := services.NewTicker(p.config.PollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.chStop:
+			return
+		case <-ticker.C:
+			p.deliverEvents(ctx)
+		}
+	}
+}
+
+// deliverEvents delivers the undelivered events in order, until one fails or is waiting for its retry
+func (p *StatusEventPublisher[CHAIN_ID, ADDR, TX_HASH]) deliverEvents(ctx context.Context) {
+	for {
+		events, err := p.store.FindTxStatusEventsToDeliver(ctx, p.chainID, p.config.MaxDeliveryAttempts(), statusEventsBatchSize)
+		if err != nil {
+			p.lggr.Errorw("Failed to find transaction status events to deliver", "err", err)
+			return
+		}
+		for _, event := range events {
+			if time.Now().Before(event.DeliverAfter) {
+				return
+			}
+			if err = p.deliver(ctx, event); err != nil {
+				attempts := event.DeliveryAttempts + 1
+				lggr := p.lggr.With("eventID", event.ID, "txID", event.TxID, "idempotencyKey", event.IdempotencyKey, "attempts", attempts)
+				if attempts >= p.config.MaxDeliveryAttempts() {
+					lggr.Errorw("Failed to deliver transaction status event, giving up on it", "err", err)
+				} else {
+					lggr.Warnw("Failed to deliver transaction status event, will retry", "err", err)
+				}
+				if err = p.store.UpdateTxStatusEventDeliveryFailed(ctx, event.ID, time.Now().Add(p.retryDelay(attempts))); err != nil {
+					lggr.Errorw("Failed to save transaction status event delivery attempt", "err", err)
+				}
+				return
+			}
+			if err = p.store.MarkTxStatusEventDelivered(ctx, event.ID); err != nil {
+				p.lggr.Errorw("Failed to mark transaction status event delivered", "eventID", event.ID, "err", err)
+				return
+			}
+		}
+		if len(events) < statusEventsBatchSize {
+			return
+		}
+	}
+}
+
+// retryDelay returns the delay before retrying the delivery of an event, doubled for each failed attempt after the first
+func (p *StatusEventPublisher[CHAIN_ID, ADDR, TX_HASH]) retryDelay(attempts uint32) time.Duration {
+	delay := p.config.RetryInterval()
+	for i := uint32(1); i < attempts && delay < maxStatusEventRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxStatusEventRetryDelay)
+}
+
+func (p *StatusEventPublisher[CHAIN_ID, ADDR, TX_HASH]) deliver(ctx context.Context, event txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH]) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, statusEventWebhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.WebhookURL().String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Subscribe streams the events following the one with the given ID, or the events to come if afterID is negative.
+// The channel is closed once unsubscribed, or when the publisher stops.
+func (p *StatusEventPublisher[CHAIN_ID, ADDR, TX_HASH]) Subscribe(afterID int64) (<-chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], func(), error) {
+	if afterID < 0 {
+		ctx, cancel := p.chStop.NewCtx()
+		defer cancel()
+		latestID, err := p.store.FindLatestTxStatusEventID(ctx, p.chainID)
+		if err != nil {
+			return nil, nil, err
+		}
+		afterID = latestID
+	}
+	ch := make(chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], statusEventsBatchSize)
+	chUnsub := make(chan struct{})
+	var unsubOnce sync.Once
+	unsub := func() { unsubOnce.Do(func() { close(chUnsub) }) }
+	p.wg.Add(1)
+	go p.stream(afterID, ch, chUnsub)
+	return ch, unsub, nil
+}
+
+func (p *StatusEventPublisher[CHAIN_ID, ADDR, TX_HASH]) stream(afterID int64, ch chan<- txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], chUnsub <-chan struct{}) {
+	defer p.wg.Done()
+	defer close(ch)
+	ctx, cancel := p.chStop.NewCtx()
+	defer cancel()
+	for {
+		events, err := p.store.FindTxStatusEventsAfter(ctx, p.chainID, afterID, statusEventsBatchSize)
+		if err != nil {
+			p.lggr.Errorw("Failed to find transaction status events", "afterID", afterID, "err", err)
+		}
+		for _, event := range events {
+			select {
+			case ch <- event:
+				afterID = event.ID
+			case <-chUnsub:
+				return
+			case <-p.chStop:
+				return
+			}
+		}
+		if len(events) == statusEventsBatchSize {
+			continue
+		}
+		select {
+		case <-time.After(p.config.PollInterval()):
+		case <-chUnsub:
+			return
+		case <-p.chStop:
+			return
+		}
+	}
+}
+
+// StatusEventReaper deletes the status events past the reaper threshold which are done with. The TxStore records the
+// events whether or not they are published, so it runs regardless of the publisher.
+type StatusEventReaper[CHAIN_ID types.ID, ADDR types.Hashable, TX_HASH types.Hashable] struct {
+	store   txmgrtypes.TxStatusEventStore[ADDR, CHAIN_ID, TX_HASH]
+	config  txmgrtypes.StatusEventsConfig
+	chainID CHAIN_ID
+	lggr    logger.SugaredLogger
+	chStop  services.StopChan
+	chDone  chan struct{}
+}
+
+// NewStatusEventReaper instantiates a new reaper of the status events of the chain
+func NewStatusEventReaper[CHAIN_ID types.ID, ADDR types.Hashable, TX_HASH types.Hashable](lggr logger.Logger, store txmgrtypes.TxStatusEventStore[ADDR, CHAIN_ID, TX_HASH], config txmgrtypes.StatusEventsConfig, chainID CHAIN_ID) *StatusEventReaper[CHAIN_ID, ADDR, TX_HASH] {
+	return &StatusEventReaper[CHAIN_ID, ADDR, TX_HASH]{
+		store:   store,
+		config:  config,
+		chainID: chainID,
+		lggr:    logger.Sugared(logger.Named(lggr, "StatusEventReaper")),
+		chStop:  make(services.StopChan),
+		chDone:  make(chan struct{}),
+	}
+}
+
+// Start the reaper. Should only be called once.
+func (r *StatusEventReaper[CHAIN_ID, ADDR, TX_HASH]) Start() {
+	r.lggr.Debugf("started with age threshold %v", r.config.ReaperThreshold())
+	go r.runLoop()
+}
+
+// Stop the reaper. Should only be called once.
+func (r *StatusEventReaper[CHAIN_ID, ADDR, TX_HASH]) Stop() {
+	close(r.chStop)
+	<-r.chDone
+}
+
+func (r *StatusEventReaper[CHAIN_ID, ADDR, TX_HASH]) runLoop() {
+	defer close(r.chDone)
+	ctx, cancel := r.chStop.NewCtx()
+	defer cancel()
+	ticker := services.NewTicker(statusEventsReapInterval)
+	defer ticker.Stop()
+	for {
+		r.reapEvents(ctx)
+		select {
+		case <-r.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reapEvents deletes the events past the reaper threshold which are done with: the ones delivered or given up on, or
+// all of them if they aren't delivered to a webhook
+func (r *StatusEventReaper[CHAIN_ID, ADDR, TX_HASH]) reapEvents(ctx context.Context) {
+	var maxAttempts uint32
+	if r.config.Enabled() && r.config.WebhookURL() != nil {
+		maxAttempts = r.config.MaxDeliveryAttempts()
+	}
+	timeThreshold := time.Now().Add(-r.config.ReaperThreshold())
+	if err := r.store.ReapTxStatusEvents(ctx, timeThreshold, maxAttempts, r.chainID); err != nil {
+		r.lggr.Errorw("Failed to reap transaction status events", "timeThreshold", timeThreshold, "err", err)
+	}
+}
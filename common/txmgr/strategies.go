@@ -62,3 +62,34 @@ func (s DropOldestStrategy) PruneQueue(ctx context.Context, pruneService txmgrty
 	}
 	return
 }
+
+var _ txmgrtypes.TxStrategy = BatchStrategy{}
+
+// BatchStrategy merges the queued transactions of its subject, sent from the same address, into
+// batch transactions of at most batchSize calls, so that jobs enqueuing many small transactions
+// per block spend a single sequence and transaction overhead on them.
+//
+// The msg.sender of the calls of a batch is the batching contract rather than the sending key, so
+// this is only suitable for calls which don't authenticate their sender.
+type BatchStrategy struct {
+	subject   uuid.UUID
+	batchSize uint32
+}
+
+// NewBatchStrategy creates a new TxStrategy that merges the queued transactions of the subject
+// into batches of at most batchSize calls.
+func NewBatchStrategy(subject uuid.UUID, batchSize uint32) BatchStrategy {
+	return BatchStrategy{subject, batchSize}
+}
+
+func (s BatchStrategy) Subject() uuid.NullUUID {
+	return uuid.NullUUID{UUID: s.subject, Valid: true}
+}
+
+func (s BatchStrategy) PruneQueue(ctx context.Context, pruneService txmgrtypes.UnstartedTxQueuePruner) (ids []int64, err error) {
+	ids, err = pruneService.BatchUnstartedTxQueue(ctx, s.batchSize, s.subject)
+	if err != nil {
+		return ids, fmt.Errorf("BatchStrategy#PruneQueue failed: %w", err)
+	}
+	return
+}
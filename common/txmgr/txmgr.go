@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	nullv4 "gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -26,6 +28,17 @@ import (
 // For more information about the Txm architecture, see the design doc:
 // https://www.notion.so/chainlink/Txm-Architecture-Overview-9dc62450cd7a443ba9e7dceffa1a8d6b
 
+var (
+	promNumEnqueuedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_enqueued_transactions",
+		Help: "Number of transactions enqueued, by priority lane",
+	}, []string{"chainID", "priority"})
+	promNumLaneFullRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_lane_full_rejections",
+		Help: "Number of transactions rejected because their priority lane had too many unstarted transactions queued",
+	}, []string{"chainID", "priority"})
+)
+
 // ResumeCallback is assumed to be idempotent
 type ResumeCallback func(ctx context.Context, id uuid.UUID, result interface{}, err error) error
 
@@ -49,8 +62,18 @@ type TxManager[
 	GetForwarderForEOA(ctx context.Context, eoa ADDR) (forwarder ADDR, err error)
 	GetForwarderForEOAOCR2Feeds(ctx context.Context, eoa, ocr2AggregatorID ADDR) (forwarder ADDR, err error)
 	RegisterResumeCallback(fn ResumeCallback)
+	// Set the ABI of the contract at the given address, whose custom errors are decoded in the revert reasons of the
+	// transactions sent to it
+	RegisterRevertABI(to ADDR, abiJSON string) error
 	SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	Reset(addr ADDR, abandon bool) error
+	// Stop the transactions of failed pipeline task runs from being sent if they are still unstarted, and from resuming
+	// the task runs once confirmed
+	AbandonPipelineTaskRunTxs(ctx context.Context, taskRunIDs []uuid.UUID, reason string) error
+	// Cancel an unconfirmed transaction by sending a transaction with no value from its sender to itself at its sequence
+	CancelTransaction(ctx context.Context, txID int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// Replace an unconfirmed transaction by sending a transaction with the given payload and fee limit at its sequence
+	ReplaceTransaction(ctx context.Context, txID int64, encodedPayload []byte, feeLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions by a field in the TxMeta blob and transaction states
 	FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions with a non-null TxMeta field that was provided by transaction states
@@ -63,6 +86,9 @@ type TxManager[
 	FindEarliestUnconfirmedTxAttemptBlock(ctx context.Context) (nullv4.Int, error)
 	CountTransactionsByState(ctx context.Context, state txmgrtypes.TxState) (count uint32, err error)
 	GetTransactionStatus(ctx context.Context, transactionID string) (state commontypes.TransactionStatus, err error)
+	// Subscribe to the state changes of the transactions with an idempotency key following the event with the given ID,
+	// or to the changes to come if afterEventID is negative
+	SubscribeTxStatusEvents(afterEventID int64) (<-chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], func(), error)
 }
 
 type reset struct {
@@ -104,6 +130,8 @@ type Txm[
 	wg       sync.WaitGroup
 
 	reaper             *Reaper[CHAIN_ID]
+	statusEvents       *StatusEventPublisher[CHAIN_ID, ADDR, TX_HASH]
+	statusEventReaper  *StatusEventReaper[CHAIN_ID, ADDR, TX_HASH]
 	resender           *Resender[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	broadcaster        *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	confirmer          *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
@@ -178,6 +206,15 @@ func NewTxm[
 	} else {
 		b.logger.Info("TxReaper: Disabled")
 	}
+	if txCfg.StatusEvents().Enabled() {
+		b.statusEvents = NewStatusEventPublisher[CHAIN_ID, ADDR, TX_HASH](lggr, b.txStore, txCfg.StatusEvents(), chainId)
+	}
+	// the status events are recorded even if they aren't published, so they're reaped either way
+	if txCfg.StatusEvents().ReaperThreshold() > 0 {
+		b.statusEventReaper = NewStatusEventReaper[CHAIN_ID, ADDR, TX_HASH](lggr, b.txStore, txCfg.StatusEvents(), chainId)
+	} else {
+		b.logger.Info("StatusEventReaper: Disabled")
+	}
 
 	return &b
 }
@@ -219,6 +256,14 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Start(ctx
 			b.resender.Start(ctx)
 		}
 
+		if b.statusEvents != nil {
+			b.statusEvents.Start()
+		}
+
+		if b.statusEventReaper != nil {
+			b.statusEventReaper.Start()
+		}
+
 		if b.fwdMgr != nil {
 			if err := ms.Start(ctx, b.fwdMgr); err != nil {
 				return fmt.Errorf("Txm: ForwarderManager failed to start: %w", err)
@@ -260,6 +305,15 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) abandon(ad
 	return nil
 }
 
+// AbandonPipelineTaskRunTxs stops the transactions of failed pipeline task runs from being sent if they are still
+// unstarted, and from resuming the task runs once confirmed. Transactions already broadcast stay tracked.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) AbandonPipelineTaskRunTxs(ctx context.Context, taskRunIDs []uuid.UUID, reason string) error {
+	if len(taskRunIDs) == 0 {
+		return nil
+	}
+	return b.txStore.AbandonPipelineTaskRunTxs(ctx, taskRunIDs, reason, b.chainID)
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Close() (merr error) {
 	return b.StopOnce("Txm", func() error {
 		close(b.chStop)
@@ -272,6 +326,12 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Close() (m
 		if b.resender != nil {
 			b.resender.Stop()
 		}
+		if b.statusEvents != nil {
+			b.statusEvents.Stop()
+		}
+		if b.statusEventReaper != nil {
+			b.statusEventReaper.Stop()
+		}
 		if b.fwdMgr != nil {
 			if err := b.fwdMgr.Close(); err != nil {
 				merr = errors.Join(merr, fmt.Errorf("Txm: failed to stop ForwarderManager: %w", err))
@@ -500,6 +560,145 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) OnNewLonge
 	}
 }
 
+// CancelTransaction cancels the unconfirmed transaction with the given ID, superseding it with a transaction sending no value
+// from its sender to itself, at the same sequence and with a bumped fee.
+// The pipeline runs waiting for the cancelled transaction are resumed with an error once the cancellation is mined.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CancelTransaction(ctx context.Context, txID int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return b.supersedeTransaction(ctx, txID, "cancelled", true, func(oldTx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+		newTx := oldTx
+		newTx.ToAddress = oldTx.FromAddress
+		newTx.EncodedPayload = []byte{}
+		newTx.Value = big.Int{}
+		newTx.Meta = nil
+		newTx.TransmitChecker = nil
+		newTx.SignalCallback = false
+		return newTx
+	})
+}
+
+// ReplaceTransaction replaces the unconfirmed transaction with the given ID, superseding it with a transaction with the given
+// payload and fee limit to the same destination, at the same sequence and with a bumped fee.
+// A nil payload or a zero fee limit keeps the ones of the replaced transaction.
+// The replacement takes over the pipeline runs waiting for the replaced transaction once it is mined.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ReplaceTransaction(ctx context.Context, txID int64, encodedPayload []byte, feeLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return b.supersedeTransaction(ctx, txID, "replaced", false, func(oldTx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+		newTx := oldTx
+		if encodedPayload != nil {
+			newTx.EncodedPayload = encodedPayload
+		}
+		if feeLimit > 0 {
+			newTx.FeeLimit = feeLimit
+		}
+		return newTx
+	})
+}
+
+// supersedeTransaction supersedes the unconfirmed transaction with the given ID with the transaction built from it by newTxFn,
+// at the same sequence and with a bumped fee.
+// Both transactions are tracked by the Confirmer until one of them is mined, then the other one is marked as fatally errored.
+// If failCallbacks is set, the pipeline runs waiting for the superseded transaction are resumed with an error once the new
+// transaction is mined, otherwise they move to it.
+// It runs while the Broadcaster and Confirmer are stopped, and the Broadcaster sends the new transaction once they restart.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) supersedeTransaction(ctx context.Context, txID int64, reason string, failCallbacks bool, newTxFn func(oldTx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	ok := b.IfStarted(func() {
+		done := make(chan error)
+		f := func() {
+			etx, err = b.supersede(ctx, txID, reason, failCallbacks, newTxFn)
+		}
+
+		b.reset <- reset{f, done}
+		if rerr := <-done; rerr != nil {
+			err = rerr
+		}
+	})
+	if !ok {
+		return etx, errors.New("not started")
+	}
+	if err != nil {
+		return etx, err
+	}
+	b.logger.Infow(fmt.Sprintf("Transaction %s by operator", reason), "txID", txID, "newTxID", etx.ID, "sequence", etx.Sequence)
+	return etx, nil
+}
+
+// supersede must not be run while Broadcaster or Confirmer are running
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) supersede(ctx context.Context, txID int64, reason string, failCallbacks bool, newTxFn func(oldTx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (newTx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	ctx, cancel := b.chStop.Ctx(ctx)
+	defer cancel()
+	oldTx, err := b.txStore.GetTxByID(ctx, txID)
+	if err != nil {
+		return newTx, fmt.Errorf("failed to find transaction %d: %w", txID, err)
+	}
+	if oldTx.ChainID.String() != b.chainID.String() {
+		return newTx, fmt.Errorf("transaction %d is on chain %s, not on chain %s", txID, oldTx.ChainID.String(), b.chainID.String())
+	}
+	if oldTx.State != TxUnconfirmed {
+		return newTx, fmt.Errorf("only unconfirmed transactions can be %s, transaction %d is %s", reason, txID, oldTx.State)
+	}
+	if oldTx.SupersededByTxID.Valid {
+		return newTx, fmt.Errorf("transaction %d is already superseded by transaction %d", txID, oldTx.SupersededByTxID.Int64)
+	}
+	if len(oldTx.TxAttempts) == 0 || oldTx.Sequence == nil {
+		return newTx, fmt.Errorf("invariant violation: unconfirmed transaction %d has no attempts or no sequence", txID)
+	}
+	if err = b.checkEnabled(ctx, oldTx.FromAddress); err != nil {
+		return newTx, err
+	}
+	inProgress, err := b.txStore.HasInProgressTransaction(ctx, oldTx.FromAddress, b.chainID)
+	if err != nil {
+		return newTx, fmt.Errorf("failed to check for transaction in progress: %w", err)
+	}
+	if inProgress {
+		return newTx, fmt.Errorf("key %s has a transaction in progress, try again once it is broadcast", oldTx.FromAddress.String())
+	}
+
+	newTx = newTxFn(*oldTx)
+	newTx.ID = 0
+	newTx.State = TxInProgress
+	newTx.Error = nullv4.String{}
+	newTx.BroadcastAt = nil
+	newTx.InitialBroadcastAt = nil
+	newTx.CreatedAt = time.Now()
+	newTx.TxAttempts = nil
+	newTx.CallbackCompleted = false
+	newTx.SimulationRevertReason = nullv4.String{}
+	newTx.SupersededByTxID = nullv4.Int{}
+	newTx.SupersededByCancel = false
+	newTx.SupersedesTxID = nullv4.IntFrom(oldTx.ID)
+	// The pipeline runs and idempotency key of a replaced transaction only move to its replacement once it is mined
+	newTx.PipelineTaskRunID = uuid.NullUUID{}
+	newTx.IdempotencyKey = nil
+
+	// The attempts are sorted by descending fee, so the first one is the one to bump
+	previousAttempt := oldTx.TxAttempts[0]
+	lggr := newTx.GetLogger(b.logger)
+	attempt, bumpedFee, bumpedFeeLimit, _, err := b.txAttemptBuilder.NewBumpTxAttempt(ctx, newTx, previousAttempt, oldTx.TxAttempts, lggr)
+	if err != nil {
+		return newTx, fmt.Errorf("failed to bump the fee of transaction %d: %w", txID, err)
+	}
+	if newTx.FeeLimit != oldTx.FeeLimit && newTx.FeeLimit != bumpedFeeLimit {
+		attempt, _, err = b.txAttemptBuilder.NewCustomTxAttempt(ctx, newTx, bumpedFee, newTx.FeeLimit, previousAttempt.TxType, lggr)
+		if err != nil {
+			return newTx, fmt.Errorf("failed to create attempt with fee limit %d: %w", newTx.FeeLimit, err)
+		}
+	}
+
+	oldTx.SupersededByCancel = failCallbacks
+	if err = b.txStore.SupersedeTx(ctx, oldTx, &newTx, &attempt); err != nil {
+		return newTx, fmt.Errorf("failed to supersede transaction %d: %w", txID, err)
+	}
+	attempt.Tx = newTx
+	newTx.TxAttempts = append(newTx.TxAttempts, attempt)
+	return newTx, nil
+}
+
+// RegisterRevertABI sets the ABI of the contract at the given address, for the revert reasons predicted by the simulation
+// of the transactions sent to it to name its custom errors
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterRevertABI(to ADDR, abiJSON string) error {
+	// The transactions are simulated by the Broadcaster
+	return b.broadcaster.client.RegisterRevertABI(to, abiJSON)
+}
+
 // Trigger forces the Broadcaster to check early for the given address
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Trigger(addr ADDR) {
 	select {
@@ -550,11 +749,17 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 	if err != nil {
 		return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
 	}
+	err = b.txStore.CheckTxQueueLaneCapacity(ctx, txRequest.FromAddress, txRequest.Priority, b.txConfig.PriorityLanes().MaxQueued(txRequest.Priority), b.chainID)
+	if err != nil {
+		promNumLaneFullRejections.WithLabelValues(b.chainID.String(), txRequest.Priority.String()).Inc()
+		return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
+	}
 
 	tx, err = b.pruneQueueAndCreateTxn(ctx, txRequest, b.chainID)
 	if err != nil {
 		return tx, err
 	}
+	promNumEnqueuedTxs.WithLabelValues(b.chainID.String(), txRequest.Priority.String()).Inc()
 
 	// Trigger the Broadcaster to check for new transaction
 	b.broadcaster.Trigger(txRequest.FromAddress)
@@ -675,6 +880,19 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetTransac
 	}
 }
 
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SubscribeTxStatusEvents(afterEventID int64) (ch <-chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], unsub func(), err error) {
+	if b.statusEvents == nil {
+		return nil, nil, errors.New("transaction status events are disabled")
+	}
+	ok := b.IfStarted(func() {
+		ch, unsub, err = b.statusEvents.Subscribe(afterEventID)
+	})
+	if !ok {
+		return nil, nil, errors.New("not started")
+	}
+	return
+}
+
 type NullTxManager[
 	CHAIN_ID types.ID,
 	HEAD types.Head[BLOCK_HASH],
@@ -717,6 +935,24 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Res
 	return nil
 }
 
+// AbandonPipelineTaskRunTxs does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) AbandonPipelineTaskRunTxs(ctx context.Context, taskRunIDs []uuid.UUID, reason string) error {
+	return nil
+}
+
+// RegisterRevertABI does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterRevertABI(to ADDR, abiJSON string) error {
+	return nil
+}
+
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CancelTransaction(ctx context.Context, txID int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) ReplaceTransaction(ctx context.Context, txID int64, encodedPayload []byte, feeLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+
 // SendNativeToken does nothing, null functionality
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	return etx, errors.New(n.ErrMsg)
@@ -762,6 +998,10 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Get
 	return
 }
 
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SubscribeTxStatusEvents(afterEventID int64) (<-chan txmgrtypes.TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], func(), error) {
+	return nil, nil, errors.New(n.ErrMsg)
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueueAndCreateTxn(
 	ctx context.Context,
 	txRequest txmgrtypes.TxRequest[ADDR, TX_HASH],
@@ -777,7 +1017,12 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueue
 	if err != nil {
 		return tx, err
 	}
-	if len(pruned) > 0 {
+	if _, batching := txRequest.Strategy.(BatchStrategy); batching && len(pruned) > 0 {
+		b.logger.Debugw(fmt.Sprintf("Merged %d unstarted transactions into batches", len(pruned)),
+			"subject", txRequest.Strategy.Subject(),
+			"merged-tx-ids", pruned,
+		)
+	} else if len(pruned) > 0 {
 		b.logger.Warnw(fmt.Sprintf("Pruned %d old unstarted transactions", len(pruned)),
 			"subject", txRequest.Strategy.Subject(),
 			"pruned-tx-ids", pruned,
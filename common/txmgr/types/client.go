@@ -30,6 +30,13 @@ type TxmClient[
 		ctx context.Context,
 		attempts []TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 	) (txReceipt []R, txErr []error, err error)
+	// BatchCallOutcomes returns whether each of the calls of a mined batch transaction succeeded, decoded from the data
+	// it returned
+	BatchCallOutcomes(
+		ctx context.Context,
+		etx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+		receipt ChainReceipt[TX_HASH, BLOCK_HASH],
+	) (succeeded []bool, err error)
 }
 
 // TransactionClient contains the methods for building, simulating, broadcasting transactions
@@ -73,6 +80,16 @@ type TransactionClient[
 		attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 		blockNumber *big.Int,
 	) (rpcErr fmt.Stringer, extractErr error)
+	// SimulateTransaction runs the transaction against the pending state, returning the decoded reason of its revert
+	// if it is predicted to revert. The error is set if the simulation itself failed.
+	SimulateTransaction(
+		ctx context.Context,
+		tx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+		attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+	) (revertReason string, reverted bool, err error)
+	// RegisterRevertABI sets the ABI of the contract at the given address, whose custom errors are decoded in the revert
+	// reasons of the transactions sent to it.
+	RegisterRevertABI(to ADDR, abiJSON string) error
 }
 
 // ChainClient contains the interfaces for reading chain parameters (chain id, sequences, etc)
@@ -1,6 +1,9 @@
 package types
 
-import "time"
+import (
+	"net/url"
+	"time"
+)
 
 type TransactionManagerChainConfig interface {
 	BroadcasterChainConfig
@@ -20,6 +23,23 @@ type TransactionManagerTransactionsConfig interface {
 
 	ForwardersEnabled() bool
 	MaxQueued() uint64
+	PriorityLanes() PriorityLanesConfig
+	StatusEvents() StatusEventsConfig
+}
+
+// StatusEventsConfig configures the publishing of the state changes of the transactions with an idempotency key.
+type StatusEventsConfig interface {
+	Enabled() bool
+	// WebhookURL returns the URL the events are posted to, if any.
+	WebhookURL() *url.URL
+	// PollInterval returns how often new events are looked for.
+	PollInterval() time.Duration
+	// RetryInterval returns the delay before the first retry of a failed delivery, doubled for each retry after it.
+	RetryInterval() time.Duration
+	// MaxDeliveryAttempts returns the number of deliveries of an event after which it is given up on.
+	MaxDeliveryAttempts() uint32
+	// ReaperThreshold returns the age after which delivered events, or events given up on, are deleted. Zero disables it.
+	ReaperThreshold() time.Duration
 }
 
 type BroadcasterChainConfig interface {
@@ -33,8 +53,26 @@ type BroadcasterFeeConfig interface {
 
 type BroadcasterTransactionsConfig interface {
 	MaxInFlight() uint32
+	Simulation() SimulationConfig
+}
+
+// SimulationConfig configures the simulation of the transactions before their first broadcast.
+type SimulationConfig interface {
+	Enabled() bool
+	// OnRevert returns what to do with a transaction whose simulation reverts.
+	OnRevert() SimulationRevertAction
 }
 
+// SimulationRevertAction is what the Broadcaster does with a transaction predicted to revert.
+type SimulationRevertAction string
+
+const (
+	// SimulationRevertSend broadcasts the transaction anyway, recording the revert reason.
+	SimulationRevertSend SimulationRevertAction = "Send"
+	// SimulationRevertFatalError marks the transaction as fatally errored with the revert reason.
+	SimulationRevertFatalError SimulationRevertAction = "FatalError"
+)
+
 type BroadcasterListenerConfig interface {
 	FallbackPollInterval() time.Duration
 }
@@ -62,6 +100,18 @@ type ConfirmerDatabaseConfig interface {
 type ConfirmerTransactionsConfig interface {
 	MaxInFlight() uint32
 	ForwardersEnabled() bool
+	PriorityLanes() PriorityLanesConfig
+}
+
+// PriorityLanesConfig configures the lanes of the transactions of a key, see TxPriority.
+type PriorityLanesConfig interface {
+	// MaxQueued returns the maximum number of unstarted transactions of the lane per key,
+	// on top of the limit on all the unstarted transactions of the key. Zero means no limit.
+	MaxQueued(priority TxPriority) uint64
+	// CriticalBumpThreshold returns the number of blocks after which the fee of an unconfirmed critical
+	// transaction is bumped, along with the ones ahead of it in the sequence. Zero means the same
+	// threshold as for the other lanes.
+	CriticalBumpThreshold() uint64
 }
 
 type ResenderChainConfig interface {
@@ -93,6 +93,9 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// Priority is the lane of the transaction; the zero value is TxPriorityNormal.
+	Priority TxPriority
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -110,6 +113,35 @@ type TransmitCheckerSpec[ADDR types.Hashable] struct {
 	VRFRequestBlockNumber *big.Int `json:",omitempty"`
 }
 
+// TxPriority is the lane of a transaction. The unstarted transactions of a key are broadcast
+// from the highest priority lane first, so that a backlog in a lower lane doesn't delay
+// time-critical transactions sharing the key.
+type TxPriority int8
+
+const (
+	TxPriorityBulk TxPriority = iota - 1
+	// TxPriorityNormal is the default lane.
+	TxPriorityNormal
+	TxPriorityCritical
+)
+
+// TxPriorities lists the priority lanes, from the highest to the lowest.
+var TxPriorities = []TxPriority{TxPriorityCritical, TxPriorityNormal, TxPriorityBulk}
+
+// String returns string formatted priorities for logging and metrics
+func (p TxPriority) String() string {
+	switch p {
+	case TxPriorityCritical:
+		return "critical"
+	case TxPriorityNormal:
+		return "normal"
+	case TxPriorityBulk:
+		return "bulk"
+	default:
+		return fmt.Sprintf("unknown_priority(%d)", int8(p))
+	}
+}
+
 // TransmitCheckerType describes the type of check that should be performed before a transaction is
 // executed on-chain.
 type TransmitCheckerType string
@@ -232,6 +264,19 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	// Priority is the lane of the transaction, which orders the broadcast of the unstarted transactions of a key
+	Priority TxPriority
+
+	// SimulationRevertReason is the reason the transaction was predicted to revert for when simulated before its first broadcast
+	SimulationRevertReason null.String
+
+	// SupersededByTxID is the ID of the transaction an operator cancelled or replaced this transaction with
+	SupersededByTxID null.Int
+	// SupersededByCancel marks that the transaction superseding this one cancels it, rather than replaces it
+	SupersededByCancel bool
+	// SupersedesTxID is the ID of the transaction this transaction cancels or replaces, at the same sequence
+	SupersedesTxID null.Int
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
@@ -266,6 +311,7 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetLogger(lgr logger
 		"sequence", e.Sequence,
 		"checker", e.TransmitChecker,
 		"feeLimit", e.FeeLimit,
+		"priority", e.Priority,
 	)
 
 	meta, err := e.GetMeta()
@@ -338,6 +384,54 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetChecker() (Transm
 	return t, nil
 }
 
+// TxStatusEvent is a change of the state of a transaction with an idempotency key, published by the TxManager.
+type TxStatusEvent[CHAIN_ID types.ID, ADDR types.Hashable, TX_HASH types.Hashable] struct {
+	// ID increases with each event of the chain, so that receivers can tell the events they already got
+	ID             int64
+	TxID           int64
+	ChainID        CHAIN_ID
+	IdempotencyKey string
+	FromAddress    ADDR
+	State          TxState
+	Error          null.String
+	// TxHash is the hash of the mined attempt of the transaction, or else of its latest attempt, if any
+	TxHash    *TX_HASH
+	CreatedAt time.Time
+
+	DeliveryAttempts uint32
+	DeliverAfter     time.Time
+}
+
+type txStatusEventJSON struct {
+	ID             int64     `json:"id"`
+	TxID           int64     `json:"txID"`
+	ChainID        string    `json:"chainID"`
+	IdempotencyKey string    `json:"idempotencyKey"`
+	FromAddress    string    `json:"fromAddress"`
+	State          TxState   `json:"state"`
+	Error          string    `json:"error,omitempty"`
+	TxHash         string    `json:"txHash,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// MarshalJSON encodes the event as it is delivered to the webhook and the event stream.
+func (e TxStatusEvent[CHAIN_ID, ADDR, TX_HASH]) MarshalJSON() ([]byte, error) {
+	j := txStatusEventJSON{
+		ID:             e.ID,
+		TxID:           e.TxID,
+		ChainID:        e.ChainID.String(),
+		IdempotencyKey: e.IdempotencyKey,
+		FromAddress:    e.FromAddress.String(),
+		State:          e.State,
+		Error:          e.Error.String,
+		CreatedAt:      e.CreatedAt,
+	}
+	if e.TxHash != nil {
+		j.TxHash = (*e.TxHash).String()
+	}
+	return json.Marshal(j)
+}
+
 // Provides error classification to external components in a chain agnostic way
 // Only exposes the error types that could be set in the transaction error field
 type ErrorClassifier interface {
@@ -31,18 +31,33 @@ type TxStore[
 ] interface {
 	UnstartedTxQueuePruner
 	TxHistoryReaper[CHAIN_ID]
+	TxStatusEventStore[ADDR, CHAIN_ID, TX_HASH]
 	TransactionStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, SEQ, FEE]
 
 	// Find confirmed txes beyond the minConfirmations param that require callback but have not yet been signaled
 	FindTxesPendingCallback(ctx context.Context, latest, finalized int64, chainID CHAIN_ID) (receiptsPlus []ReceiptPlus[R], err error)
+	// Find the batch transactions mined beyond the minConfirmations param, loaded with their attempts and receipts, whose calls have no recorded outcome yet
+	FindBatchTxsPendingCallOutcomes(ctx context.Context, latest, finalized int64, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// Record whether each of the calls of a batch transaction succeeded, or that all of them failed if succeeded is nil
+	SetBatchCallOutcomes(ctx context.Context, etxID int64, succeeded []bool) error
 	// Update tx to mark that its callback has been signaled
 	UpdateTxCallbackCompleted(ctx context.Context, pipelineTaskRunRid uuid.UUID, chainId CHAIN_ID) error
 	SaveFetchedReceipts(ctx context.Context, r []R, state TxState, errorMsg *string, chainID CHAIN_ID) error
 
 	// additional methods for tx store management
 	CheckTxQueueCapacity(ctx context.Context, fromAddress ADDR, maxQueuedTransactions uint64, chainID CHAIN_ID) (err error)
+	// CheckTxQueueLaneCapacity checks the limit on the unstarted transactions of a priority lane
+	CheckTxQueueLaneCapacity(ctx context.Context, fromAddress ADDR, priority TxPriority, maxQueuedTransactions uint64, chainID CHAIN_ID) (err error)
 	Close()
 	Abandon(ctx context.Context, id CHAIN_ID, addr ADDR) error
+	// Mark the unstarted transactions of the pipeline task runs as fatally errored with reason, and the callbacks of all their transactions as completed
+	AbandonPipelineTaskRunTxs(ctx context.Context, taskRunIDs []uuid.UUID, reason string, chainID CHAIN_ID) error
+	// Mark an unconfirmed transaction as superseded by a new in_progress transaction at its sequence
+	SupersedeTx(ctx context.Context, oldTx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], newTx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt *TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
+	// Find the pending transactions of the superseded and superseding pairs of which the other transaction was mined
+	FindSupersededTxsToSettle(ctx context.Context, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// Mark a transaction returned by FindSupersededTxsToSettle as fatally errored
+	SettleSupersededTx(ctx context.Context, etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
 	// Find transactions by a field in the TxMeta blob and transaction states
 	FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []TxState, chainID *big.Int) (tx []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions with a non-null TxMeta field that was provided by transaction states
@@ -68,6 +83,8 @@ type TransactionStore[
 	CountUnstartedTransactions(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (count uint32, err error)
 	CreateTransaction(ctx context.Context, txRequest TxRequest[ADDR, TX_HASH], chainID CHAIN_ID) (tx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	DeleteInProgressAttempt(ctx context.Context, attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
+	// Find the pipeline task runs of the transactions merged into a batch transaction which have not been signaled yet
+	FindBatchedTaskRunIDsPendingCallback(ctx context.Context, etxID int64) (ids []uuid.UUID, err error)
 	FindLatestSequence(ctx context.Context, fromAddress ADDR, chainId CHAIN_ID) (SEQ, error)
 	FindTxsRequiringGasBump(ctx context.Context, address ADDR, blockNum, gasBumpThreshold, depth int64, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	FindTxsRequiringResubmissionDueToInsufficientFunds(ctx context.Context, address ADDR, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
@@ -113,8 +130,27 @@ type TxHistoryReaper[CHAIN_ID types.ID] interface {
 	ReapTxHistory(ctx context.Context, timeThreshold time.Time, chainID CHAIN_ID) error
 }
 
+// TxStatusEventStore holds the state changes of the transactions with an idempotency key, in the order they happened
+type TxStatusEventStore[ADDR types.Hashable, CHAIN_ID types.ID, TX_HASH types.Hashable] interface {
+	// FindLatestTxStatusEventID returns the ID of the latest event of the chain, or zero if there is none
+	FindLatestTxStatusEventID(ctx context.Context, chainID CHAIN_ID) (id int64, err error)
+	// FindTxStatusEventsAfter returns up to limit events of the chain following the event with the given ID
+	FindTxStatusEventsAfter(ctx context.Context, chainID CHAIN_ID, afterID int64, limit int) (events []TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], err error)
+	// FindTxStatusEventsToDeliver returns up to limit of the oldest undelivered events of the chain with fewer than maxAttempts delivery attempts
+	FindTxStatusEventsToDeliver(ctx context.Context, chainID CHAIN_ID, maxAttempts uint32, limit int) (events []TxStatusEvent[CHAIN_ID, ADDR, TX_HASH], err error)
+	MarkTxStatusEventDelivered(ctx context.Context, id int64) error
+	// UpdateTxStatusEventDeliveryFailed counts a failed delivery attempt of the event, to be retried after the given time
+	UpdateTxStatusEventDeliveryFailed(ctx context.Context, id int64, deliverAfter time.Time) error
+	// ReapTxStatusEvents deletes the events of the chain delivered before timeThreshold, and the undelivered events
+	// recorded before it with at least maxAttempts delivery attempts
+	ReapTxStatusEvents(ctx context.Context, timeThreshold time.Time, maxAttempts uint32, chainID CHAIN_ID) error
+}
+
 type UnstartedTxQueuePruner interface {
 	PruneUnstartedTxQueue(ctx context.Context, queueSize uint32, subject uuid.UUID) (ids []int64, err error)
+	// BatchUnstartedTxQueue merges the unstarted transactions of the subject into batch transactions
+	// of at most batchSize calls, and returns the ids of the merged transactions
+	BatchUnstartedTxQueue(ctx context.Context, batchSize uint32, subject uuid.UUID) (ids []int64, err error)
 }
 
 // R is the raw unparsed transaction receipt
@@ -48,3 +48,15 @@ func TestTxAttemptState(t *testing.T) {
 		}
 	})
 }
+
+func TestTxPriority_String(t *testing.T) {
+	assert.Equal(t, "critical", TxPriorityCritical.String())
+	assert.Equal(t, "normal", TxPriorityNormal.String())
+	assert.Equal(t, "normal", TxPriority(0).String(), "the zero value is the normal lane")
+	assert.Equal(t, "bulk", TxPriorityBulk.String())
+	assert.Equal(t, "unknown_priority(5)", TxPriority(5).String())
+
+	for i := 1; i < len(TxPriorities); i++ {
+		assert.Greater(t, TxPriorities[i-1], TxPriorities[i], "the lanes are listed from the highest priority")
+	}
+}
@@ -683,6 +683,10 @@ func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (*transactionsConfig) Batching() evmconfig.Batching           { return &batchingConfig{} }
+func (*transactionsConfig) PriorityLanes() evmconfig.PriorityLanes { return &priorityLanesConfig{} }
+func (*transactionsConfig) Simulation() evmconfig.Simulation       { return &simulationConfig{} }
+func (*transactionsConfig) StatusEvents() evmconfig.StatusEvents   { return &statusEventsConfig{} }
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -690,6 +694,32 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type batchingConfig struct{}
+
+func (*batchingConfig) Multicall3Address() common.Address { return txmgr.Multicall3Address }
+func (*batchingConfig) MaxCalls() uint32                  { return 10 }
+
+type priorityLanesConfig struct{}
+
+func (*priorityLanesConfig) CriticalMaxQueued() uint64     { return 0 }
+func (*priorityLanesConfig) NormalMaxQueued() uint64       { return 0 }
+func (*priorityLanesConfig) BulkMaxQueued() uint64         { return 0 }
+func (*priorityLanesConfig) CriticalBumpThreshold() uint64 { return 0 }
+
+type simulationConfig struct{}
+
+func (*simulationConfig) Enabled() bool    { return false }
+func (*simulationConfig) OnRevert() string { return "Send" }
+
+type statusEventsConfig struct{}
+
+func (*statusEventsConfig) Enabled() bool                  { return false }
+func (*statusEventsConfig) WebhookURL() *url.URL           { return nil }
+func (*statusEventsConfig) PollInterval() time.Duration    { return time.Second }
+func (*statusEventsConfig) RetryInterval() time.Duration   { return 10 * time.Second }
+func (*statusEventsConfig) MaxDeliveryAttempts() uint32    { return 10 }
+func (*statusEventsConfig) ReaperThreshold() time.Duration { return 168 * time.Hour }
+
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
 	RpcDefaultBatchSize uint32
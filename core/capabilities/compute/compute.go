@@ -73,7 +73,8 @@ type Compute struct {
 	registry coretypes.CapabilitiesRegistry
 	modules  *moduleCache
 
-	transformer              ConfigTransformer
+	transformer ConfigTransformer
+	// outgoingConnectorHandler is used to fetch on behalf of modules; fetching is unavailable if it's nil.
 	outgoingConnectorHandler *webapi.OutgoingConnectorHandler
 	idGenerator              func() string
 }
@@ -197,6 +198,9 @@ func (c *Compute) Close() error {
 
 func (c *Compute) createFetcher(workflowID, workflowExecutionID string) func(req *wasmpb.FetchRequest) (*wasmpb.FetchResponse, error) {
 	return func(req *wasmpb.FetchRequest) (*wasmpb.FetchResponse, error) {
+		if c.outgoingConnectorHandler == nil {
+			return nil, errors.New("fetch is not available without a gateway connector")
+		}
 		if err := validation.ValidateWorkflowOrExecutionID(workflowID); err != nil {
 			return nil, fmt.Errorf("workflow ID %q is invalid: %w", workflowID, err)
 		}
@@ -7,6 +7,11 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
 	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
@@ -124,12 +129,29 @@ func (c *client) UnregisterFromWorkflow(ctx context.Context, request commoncap.U
 }
 
 func (c *client) Execute(ctx context.Context, capReq commoncap.CapabilityRequest) (commoncap.CapabilityResponse, error) {
+	// The span's context is propagated to the remote nodes along with the request.
+	attrs := []attribute.KeyValue{
+		attribute.String("capabilityID", c.remoteCapabilityInfo.ID),
+		attribute.Int64("callerDonID", int64(c.localDONInfo.ID)),
+		attribute.String("workflowID", capReq.Metadata.WorkflowID),
+		attribute.String("workflowExecutionID", capReq.Metadata.WorkflowExecutionID),
+	}
+	if c.remoteCapabilityInfo.DON != nil {
+		attrs = append(attrs, attribute.Int64("capabilityDonID", int64(c.remoteCapabilityInfo.DON.ID)))
+	}
+	ctx, span := otel.Tracer("").Start(ctx, "remote target call", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	defer span.End()
+
 	req, err := c.executeRequest(ctx, capReq)
 	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
 		return commoncap.CapabilityResponse{}, fmt.Errorf("failed to execute request: %w", err)
 	}
 
 	resp := <-req.ResponseChan()
+	if resp.Err != nil {
+		span.SetStatus(codes.Error, resp.Err.Error())
+	}
 	return resp.CapabilityResponse, resp.Err
 }
 
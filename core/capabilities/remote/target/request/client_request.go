@@ -8,6 +8,8 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/protobuf/proto"
 
 	ragep2ptypes "github.com/smartcontractkit/libocr/ragep2p/types"
@@ -68,6 +70,9 @@ func NewClientRequest(ctx context.Context, lggr logger.Logger, req commoncap.Cap
 
 	responseReceived := make(map[p2ptypes.PeerID]bool)
 
+	traceContext := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, traceContext)
+
 	ctxWithCancel, cancelFn := context.WithCancel(ctx)
 	wg := &sync.WaitGroup{}
 	for peerID, delay := range peerIDToTransmissionDelay {
@@ -82,6 +87,7 @@ func NewClientRequest(ctx context.Context, lggr logger.Logger, req commoncap.Cap
 				Method:          types.MethodExecute,
 				Payload:         rawRequest,
 				MessageId:       []byte(messageID),
+				TraceContext:    traceContext,
 			}
 
 			select {
@@ -8,6 +8,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 
 	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/pb"
@@ -193,6 +196,29 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 		}
 	})
 
+	t.Run("Propagates trace context", func(t *testing.T) {
+		propagator := otel.GetTextMapPropagator()
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+		defer otel.SetTextMapPropagator(propagator)
+
+		spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{2},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx, cancel := context.WithCancel(trace.ContextWithSpanContext(context.Background(), spanContext))
+		defer cancel()
+
+		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
+		request, err := request.NewClientRequest(ctx, lggr, capabilityRequest, messageID, capInfo,
+			workflowDonInfo, dispatcher, 10*time.Minute)
+		require.NoError(t, err)
+		defer request.Cancel(errors.New("test end"))
+
+		sent := <-dispatcher.msgs
+		assert.Equal(t, "00-01000000000000000000000000000000-0200000000000000-01", sent.TraceContext["traceparent"])
+	})
+
 	t.Run("Send second message with same error as first", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
@@ -6,6 +6,12 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
 	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/pb"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
@@ -85,6 +91,8 @@ func (e *ServerRequest) OnMessage(ctx context.Context, msg *types.MessageBody) e
 
 	e.lggr.Debugw("OnMessage called for request", "msgId", msg.MessageId, "calls", len(e.requesters), "hasResponse", e.response != nil)
 	if e.minimumRequiredRequestsReceived() && !e.hasResponse() {
+		// The execution is traced as part of the trace of the request which completed the quorum.
+		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(msg.TraceContext))
 		if err := e.executeRequest(ctx, msg.Payload); err != nil {
 			e.setError(types.Error_INTERNAL_ERROR, err.Error())
 		}
@@ -124,10 +132,20 @@ func (e *ServerRequest) executeRequest(ctx context.Context, payload []byte) erro
 		return fmt.Errorf("failed to unmarshal capability request: %w", err)
 	}
 
+	ctxWithTimeout, span := otel.Tracer("").Start(ctxWithTimeout, "remote target execution", trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(
+		attribute.String("capabilityID", e.capabilityID),
+		attribute.Int64("capabilityDonID", int64(e.capabilityDonID)),
+		attribute.Int64("callerDonID", int64(e.callingDon.ID)),
+		attribute.String("workflowID", capabilityRequest.Metadata.WorkflowID),
+		attribute.String("workflowExecutionID", capabilityRequest.Metadata.WorkflowExecutionID),
+	))
+	defer span.End()
+
 	e.lggr.Debugw("executing capability", "metadata", capabilityRequest.Metadata)
 	capResponse, err := e.capability.Execute(ctxWithTimeout, capabilityRequest)
 
 	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
 		e.lggr.Debugw("received execution error", "workflowExecutionID", capabilityRequest.Metadata.WorkflowExecutionID, "error", err)
 		return fmt.Errorf("failed to execute capability: %w", err)
 	}
@@ -0,0 +1,170 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/robfig/cron/v3"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/cron/croncap"
+)
+
+const ID = "cron-trigger@1.0.0"
+
+const defaultSendChannelBufferSize = 1000
+
+var cronTriggerInfo = capabilities.MustNewCapabilityInfo(
+	ID,
+	capabilities.CapabilityTypeTrigger,
+	"A trigger that starts a workflow run on a cron schedule.",
+)
+
+// Schedules have an optional seconds field, e.g. "*/30 * * * * *" fires every 30 seconds.
+var parser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Cron Trigger Capability Input
+type Input struct {
+}
+
+// Cron Trigger Capability Service
+// Runs the schedule of each registered trigger, emitting an event on every tick.
+type TriggerService struct {
+	services.StateMachine
+	capabilities.CapabilityInfo
+	capabilities.Validator[croncap.Config, Input, capabilities.TriggerResponse]
+	lggr     logger.Logger
+	registry core.CapabilitiesRegistry
+	clock    clockwork.Clock
+
+	mu       sync.Mutex
+	triggers map[string]*cronTrigger
+}
+
+var _ capabilities.TriggerCapability = (*TriggerService)(nil)
+var _ services.Service = &TriggerService{}
+
+// Creates a new Cron Trigger Service, which adds itself to the registry on .Start()
+func NewTriggerService(lggr logger.Logger, registry core.CapabilitiesRegistry, clock clockwork.Clock) *TriggerService {
+	return &TriggerService{
+		CapabilityInfo: cronTriggerInfo,
+		Validator:      capabilities.NewValidator[croncap.Config, Input, capabilities.TriggerResponse](capabilities.ValidatorArgs{Info: cronTriggerInfo}),
+		lggr:           logger.Named(lggr, "CronTriggerCapabilityService"),
+		registry:       registry,
+		clock:          clock,
+		triggers:       map[string]*cronTrigger{},
+	}
+}
+
+func (s *TriggerService) Info(ctx context.Context) (capabilities.CapabilityInfo, error) {
+	return s.CapabilityInfo, nil
+}
+
+// RegisterTrigger starts running the schedule of a new trigger.
+func (s *TriggerService) RegisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	if req.Config == nil {
+		return nil, errors.New("config is required to register a cron trigger")
+	}
+	reqConfig, err := s.ValidateConfig(req.Config)
+	if err != nil {
+		return nil, err
+	}
+	schedule, location, err := parseSchedule(*reqConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.triggers[req.TriggerID]; ok {
+		return nil, fmt.Errorf("triggerId %s already registered", req.TriggerID)
+	}
+	var t *cronTrigger
+	ok := s.IfNotStopped(func() {
+		t = newCronTrigger(logger.With(s.lggr, "triggerID", req.TriggerID, "workflowID", req.Metadata.WorkflowID),
+			s.clock, req.TriggerID, schedule, location)
+		s.triggers[req.TriggerID] = t
+		go t.run()
+	})
+	if !ok {
+		return nil, errors.New("cannot create new trigger since CronTriggerCapabilityService has been stopped")
+	}
+	s.lggr.Infow("RegisterTrigger", "triggerId", req.TriggerID, "WorkflowID", req.Metadata.WorkflowID, "schedule", reqConfig.Schedule)
+	return t.ch, nil
+}
+
+func (s *TriggerService) UnregisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.triggers[req.TriggerID]
+	if !ok {
+		return fmt.Errorf("triggerId %s not registered", req.TriggerID)
+	}
+	t.Close()
+	delete(s.triggers, req.TriggerID)
+	s.lggr.Infow("UnregisterTrigger", "triggerId", req.TriggerID, "WorkflowID", req.Metadata.WorkflowID)
+	return nil
+}
+
+// Start the service.
+func (s *TriggerService) Start(ctx context.Context) error {
+	return s.StartOnce("CronTriggerCapabilityService", func() error {
+		s.lggr.Info("Starting CronTriggerCapabilityService")
+		return s.registry.Add(ctx, s)
+	})
+}
+
+// Close stops the Service, and the schedules of all the triggers.
+func (s *TriggerService) Close() error {
+	return s.StopOnce("CronTriggerCapabilityService", func() error {
+		s.lggr.Info("Stopping CronTriggerCapabilityService")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for id, t := range s.triggers {
+			t.Close()
+			delete(s.triggers, id)
+		}
+		return nil
+	})
+}
+
+func (s *TriggerService) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+func (s *TriggerService) Name() string {
+	return s.lggr.Name()
+}
+
+// parseSchedule parses the schedule of a trigger, in its timezone, which defaults to UTC.
+func parseSchedule(cfg croncap.Config) (cron.Schedule, *time.Location, error) {
+	// Intervals are relative to when the trigger was registered, which differs between the nodes
+	// of a DON, so they wouldn't tick in unison.
+	if strings.HasPrefix(cfg.Schedule, "@every") {
+		return nil, nil, fmt.Errorf("invalid cron schedule %q: @every is not supported, use a schedule with a seconds field instead", cfg.Schedule)
+	}
+	if strings.HasPrefix(cfg.Schedule, "CRON_TZ=") || strings.HasPrefix(cfg.Schedule, "TZ=") {
+		return nil, nil, fmt.Errorf("invalid cron schedule %q: use the timezone field instead", cfg.Schedule)
+	}
+	schedule, err := parser.Parse(cfg.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid cron schedule %q: %w", cfg.Schedule, err)
+	}
+	location := time.UTC
+	if cfg.Timezone != nil && *cfg.Timezone != "" {
+		location, err = time.LoadLocation(*cfg.Timezone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid timezone %q: %w", *cfg.Timezone, err)
+		}
+	}
+	return schedule, location, nil
+}
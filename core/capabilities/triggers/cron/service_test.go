@@ -0,0 +1,144 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/cron/croncap"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const triggerID = "wf_123_trigger_0"
+
+func newTestService(t *testing.T, now time.Time) (*TriggerService, clockwork.FakeClock) {
+	lggr := logger.TestLogger(t)
+	clock := clockwork.NewFakeClockAt(now)
+	s := NewTriggerService(lggr, coreCap.NewRegistry(lggr), clock)
+	servicetest.Run(t, s)
+	return s, clock
+}
+
+func registrationRequest(t *testing.T, config map[string]any) capabilities.TriggerRegistrationRequest {
+	cfg, err := values.NewMap(config)
+	require.NoError(t, err)
+	return capabilities.TriggerRegistrationRequest{
+		TriggerID: triggerID,
+		Metadata:  capabilities.RequestMetadata{WorkflowID: "123"},
+		Config:    cfg,
+	}
+}
+
+func nextEvent(t *testing.T, clock clockwork.FakeClock, ch <-chan capabilities.TriggerResponse, d time.Duration) capabilities.TriggerEvent {
+	clock.BlockUntil(1)
+	clock.Advance(d)
+	select {
+	case resp := <-ch:
+		require.NoError(t, resp.Err)
+		return resp.Event
+	case <-time.After(testutils.WaitTimeout(t)):
+		require.FailNow(t, "timed out waiting for trigger event")
+	}
+	return capabilities.TriggerEvent{}
+}
+
+func scheduledExecutionTime(t *testing.T, event capabilities.TriggerEvent) string {
+	var output croncap.Output
+	require.NoError(t, event.Outputs.UnwrapTo(&output))
+	return output.ScheduledExecutionTime
+}
+
+func TestCronTrigger_EmitsEventsOnSchedule(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	s, clock := newTestService(t, time.Date(2024, 1, 1, 0, 0, 3, 0, time.UTC))
+
+	ch, err := s.RegisterTrigger(ctx, registrationRequest(t, map[string]any{"schedule": "*/10 * * * * *"}))
+	require.NoError(t, err)
+
+	event := nextEvent(t, clock, ch, 7*time.Second)
+	assert.Equal(t, ID, event.TriggerType)
+	assert.Equal(t, triggerID+"@2024-01-01T00:00:10Z", event.ID)
+	assert.Equal(t, "2024-01-01T00:00:10Z", scheduledExecutionTime(t, event))
+
+	event = nextEvent(t, clock, ch, 10*time.Second)
+	assert.Equal(t, triggerID+"@2024-01-01T00:00:20Z", event.ID)
+
+	_, err = s.RegisterTrigger(ctx, registrationRequest(t, map[string]any{"schedule": "*/10 * * * * *"}))
+	assert.ErrorContains(t, err, "already registered")
+
+	require.NoError(t, s.UnregisterTrigger(ctx, capabilities.TriggerRegistrationRequest{TriggerID: triggerID}))
+	_, open := <-ch
+	assert.False(t, open, "the channel is closed once the trigger is unregistered")
+	assert.Error(t, s.UnregisterTrigger(ctx, capabilities.TriggerRegistrationRequest{TriggerID: triggerID}))
+}
+
+func TestCronTrigger_NodesEmitMatchingEvents(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	config := map[string]any{"schedule": "0 * * * * *"}
+
+	// The clocks of the nodes of a DON are never quite in sync.
+	node1, clock1 := newTestService(t, time.Date(2024, 1, 1, 0, 0, 58, 100, time.UTC))
+	node2, clock2 := newTestService(t, time.Date(2024, 1, 1, 0, 0, 59, 900, time.UTC))
+	ch1, err := node1.RegisterTrigger(ctx, registrationRequest(t, config))
+	require.NoError(t, err)
+	ch2, err := node2.RegisterTrigger(ctx, registrationRequest(t, config))
+	require.NoError(t, err)
+
+	event1 := nextEvent(t, clock1, ch1, 2*time.Second)
+	event2 := nextEvent(t, clock2, ch2, time.Second)
+	assert.Equal(t, event1, event2)
+	assert.Equal(t, triggerID+"@2024-01-01T00:01:00Z", event1.ID)
+}
+
+func TestCronTrigger_Timezone(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	s, clock := newTestService(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	ch, err := s.RegisterTrigger(ctx, registrationRequest(t, map[string]any{
+		"schedule": "0 0 9 * * *",
+		"timezone": "America/New_York",
+	}))
+	require.NoError(t, err)
+
+	// 9am in New York is 2pm UTC in winter.
+	event := nextEvent(t, clock, ch, 14*time.Hour)
+	assert.Equal(t, "2024-01-01T14:00:00Z", scheduledExecutionTime(t, event))
+}
+
+func TestCronTrigger_InvalidConfig(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	s, _ := newTestService(t, time.Now())
+
+	for _, tc := range []struct {
+		name   string
+		config map[string]any
+		err    string
+	}{
+		{"missing schedule", map[string]any{}, "schedule"},
+		{"invalid schedule", map[string]any{"schedule": "every minute"}, "invalid cron schedule"},
+		{"interval", map[string]any{"schedule": "@every 10s"}, "@every is not supported"},
+		{"timezone in schedule", map[string]any{"schedule": "CRON_TZ=UTC 0 * * * *"}, "use the timezone field"},
+		{"invalid timezone", map[string]any{"schedule": "0 * * * *", "timezone": "Mars/Olympus_Mons"}, "invalid timezone"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.RegisterTrigger(ctx, registrationRequest(t, tc.config))
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	_, err := s.RegisterTrigger(ctx, capabilities.TriggerRegistrationRequest{TriggerID: triggerID})
+	assert.ErrorContains(t, err, "config is required")
+}
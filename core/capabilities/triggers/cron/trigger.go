@@ -0,0 +1,99 @@
+package cron
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/robfig/cron/v3"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/cron/croncap"
+)
+
+// cronTrigger emits an event on every tick of the schedule of a workflow trigger.
+type cronTrigger struct {
+	ch        chan capabilities.TriggerResponse
+	lggr      logger.Logger
+	clock     clockwork.Clock
+	triggerID string
+	schedule  cron.Schedule
+	location  *time.Location
+	stopCh    services.StopChan
+	done      chan struct{}
+}
+
+func newCronTrigger(lggr logger.Logger, clock clockwork.Clock, triggerID string, schedule cron.Schedule, location *time.Location) *cronTrigger {
+	return &cronTrigger{
+		ch:        make(chan capabilities.TriggerResponse, defaultSendChannelBufferSize),
+		lggr:      lggr,
+		clock:     clock,
+		triggerID: triggerID,
+		schedule:  schedule,
+		location:  location,
+		stopCh:    make(services.StopChan),
+		done:      make(chan struct{}),
+	}
+}
+
+// run emits the events of the trigger until it's closed.
+func (t *cronTrigger) run() {
+	defer close(t.done)
+	defer close(t.ch)
+	ctx, cancel := t.stopCh.NewCtx()
+	defer cancel()
+
+	next := t.schedule.Next(t.clock.Now().In(t.location))
+	for !next.IsZero() {
+		timer := t.clock.NewTimer(next.Sub(t.clock.Now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.Chan():
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case t.ch <- createTriggerResponse(t.triggerID, next):
+		}
+
+		// Ticks missed while the engine wasn't consuming events are skipped, rather than emitted late.
+		now := t.clock.Now().In(t.location)
+		if now.Before(next) {
+			now = next
+		}
+		next = t.schedule.Next(now)
+	}
+	t.lggr.Warn("Cron schedule has no more ticks")
+}
+
+// createTriggerResponse creates the event of a tick. Both its ID and outputs are derived from the scheduled time
+// rather than the actual one, so that the nodes of a DON emit identical events, which start the same execution.
+func createTriggerResponse(triggerID string, scheduled time.Time) capabilities.TriggerResponse {
+	scheduledTime := scheduled.UTC().Format(time.RFC3339)
+	outputs, err := values.WrapMap(croncap.Output{ScheduledExecutionTime: scheduledTime})
+	if err != nil {
+		return capabilities.TriggerResponse{
+			Err: fmt.Errorf("error wrapping trigger event: %w", err),
+		}
+	}
+	return capabilities.TriggerResponse{
+		Event: capabilities.TriggerEvent{
+			TriggerType: ID,
+			ID:          fmt.Sprintf("%s@%s", triggerID, scheduledTime),
+			Outputs:     outputs,
+		},
+	}
+}
+
+// Close stops the schedule of the trigger and closes its channel.
+func (t *cronTrigger) Close() {
+	close(t.stopCh)
+	<-t.done
+}
@@ -4,6 +4,8 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -39,6 +41,22 @@ func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
 
+func (t *transactionsConfig) Batching() Batching {
+	return &batchingConfig{c: t.c.Batching}
+}
+
+func (t *transactionsConfig) PriorityLanes() PriorityLanes {
+	return &priorityLanesConfig{c: t.c.PriorityLanes}
+}
+
+func (t *transactionsConfig) Simulation() Simulation {
+	return &simulationConfig{c: t.c.Simulation}
+}
+
+func (t *transactionsConfig) StatusEvents() StatusEvents {
+	return &statusEventsConfig{c: t.c.StatusEvents}
+}
+
 type autoPurgeConfig struct {
 	c toml.AutoPurgeConfig
 }
@@ -58,3 +76,75 @@ func (a *autoPurgeConfig) MinAttempts() *uint32 {
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+type batchingConfig struct {
+	c toml.BatchingConfig
+}
+
+func (b *batchingConfig) Multicall3Address() common.Address {
+	return b.c.Multicall3Address.Address()
+}
+
+func (b *batchingConfig) MaxCalls() uint32 {
+	return *b.c.MaxCalls
+}
+
+type priorityLanesConfig struct {
+	c toml.PriorityLanesConfig
+}
+
+func (p *priorityLanesConfig) CriticalMaxQueued() uint64 {
+	return uint64(*p.c.CriticalMaxQueued)
+}
+
+func (p *priorityLanesConfig) NormalMaxQueued() uint64 {
+	return uint64(*p.c.NormalMaxQueued)
+}
+
+func (p *priorityLanesConfig) BulkMaxQueued() uint64 {
+	return uint64(*p.c.BulkMaxQueued)
+}
+
+func (p *priorityLanesConfig) CriticalBumpThreshold() uint64 {
+	return uint64(*p.c.CriticalBumpThreshold)
+}
+
+type simulationConfig struct {
+	c toml.SimulationConfig
+}
+
+func (s *simulationConfig) Enabled() bool {
+	return *s.c.Enabled
+}
+
+func (s *simulationConfig) OnRevert() string {
+	return *s.c.OnRevert
+}
+
+type statusEventsConfig struct {
+	c toml.StatusEventsConfig
+}
+
+func (s *statusEventsConfig) Enabled() bool {
+	return *s.c.Enabled
+}
+
+func (s *statusEventsConfig) WebhookURL() *url.URL {
+	return s.c.WebhookURL.URL()
+}
+
+func (s *statusEventsConfig) PollInterval() time.Duration {
+	return s.c.PollInterval.Duration()
+}
+
+func (s *statusEventsConfig) RetryInterval() time.Duration {
+	return s.c.RetryInterval.Duration()
+}
+
+func (s *statusEventsConfig) MaxDeliveryAttempts() uint32 {
+	return *s.c.MaxDeliveryAttempts
+}
+
+func (s *statusEventsConfig) ReaperThreshold() time.Duration {
+	return s.c.ReaperThreshold.Duration()
+}
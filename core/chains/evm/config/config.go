@@ -110,6 +110,10 @@ type Transactions interface {
 	MaxInFlight() uint32
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
+	Batching() Batching
+	PriorityLanes() PriorityLanes
+	Simulation() Simulation
+	StatusEvents() StatusEvents
 }
 
 type AutoPurgeConfig interface {
@@ -119,6 +123,36 @@ type AutoPurgeConfig interface {
 	DetectionApiUrl() *url.URL
 }
 
+// Batching configures the merging of the queued transactions of a job into Multicall3 batch transactions.
+type Batching interface {
+	Multicall3Address() gethcommon.Address
+	MaxCalls() uint32
+}
+
+// PriorityLanes configures the lanes of the critical, normal and bulk transactions of a key.
+type PriorityLanes interface {
+	CriticalMaxQueued() uint64
+	NormalMaxQueued() uint64
+	BulkMaxQueued() uint64
+	CriticalBumpThreshold() uint64
+}
+
+// Simulation configures the simulation of transactions before they are first broadcast.
+type Simulation interface {
+	Enabled() bool
+	OnRevert() string
+}
+
+// StatusEvents configures the publishing of the state changes of transactions with an idempotency key.
+type StatusEvents interface {
+	Enabled() bool
+	WebhookURL() *url.URL
+	PollInterval() time.Duration
+	RetryInterval() time.Duration
+	MaxDeliveryAttempts() uint32
+	ReaperThreshold() time.Duration
+}
+
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
@@ -7,9 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
@@ -406,3 +409,85 @@ func TestClientErrorsConfig(t *testing.T) {
 }
 
 func ptr[T any](t T) *T { return &t }
+
+func TestPriorityLanesConfig(t *testing.T) {
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	lanes := cfg.EVM().Transactions().PriorityLanes()
+	require.Equal(t, uint64(0), lanes.CriticalMaxQueued())
+	require.Equal(t, uint64(0), lanes.NormalMaxQueued())
+	require.Equal(t, uint64(0), lanes.BulkMaxQueued())
+	require.Equal(t, uint64(0), lanes.CriticalBumpThreshold())
+
+	cfg = testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.Transactions.PriorityLanes.BulkMaxQueued = ptr[uint32](10)
+		c.Transactions.PriorityLanes.CriticalBumpThreshold = ptr[uint32](1)
+	})
+	lanes = cfg.EVM().Transactions().PriorityLanes()
+	require.Equal(t, uint64(10), lanes.BulkMaxQueued())
+	require.Equal(t, uint64(1), lanes.CriticalBumpThreshold())
+
+	evmCfg := &toml.EVMConfig{ChainID: ubig.NewI(1), Chain: toml.Defaults(ubig.NewI(1))}
+	evmCfg.Transactions.PriorityLanes.CriticalBumpThreshold = ptr(*evmCfg.GasEstimator.BumpThreshold)
+	require.ErrorContains(t, evmCfg.Chain.ValidateConfig(), "Transactions.PriorityLanes.CriticalBumpThreshold: invalid value (3): must be less than GasEstimator.BumpThreshold")
+}
+
+func TestBatchingConfig(t *testing.T) {
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	batching := cfg.EVM().Transactions().Batching()
+	require.Equal(t, common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"), batching.Multicall3Address())
+	require.Equal(t, uint32(10), batching.MaxCalls())
+
+	zkSync := toml.Defaults(ubig.NewI(324))
+	require.Equal(t, common.HexToAddress("0xF9cda624FBC7e059355ce98a31693d299FACd963"), zkSync.Transactions.Batching.Multicall3Address.Address())
+
+	batchingCfg := toml.BatchingConfig{MaxCalls: ptr[uint32](1)}
+	err := batchingCfg.ValidateConfig()
+	require.ErrorContains(t, err, "Multicall3Address: missing: must be set")
+	require.ErrorContains(t, err, "MaxCalls: invalid value (1): must be at least 2")
+}
+
+func TestSimulationConfig(t *testing.T) {
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	simulation := cfg.EVM().Transactions().Simulation()
+	require.False(t, simulation.Enabled())
+	require.Equal(t, "Send", simulation.OnRevert())
+
+	cfg = testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.Transactions.Simulation.Enabled = ptr(true)
+		c.Transactions.Simulation.OnRevert = ptr("FatalError")
+	})
+	simulation = cfg.EVM().Transactions().Simulation()
+	require.True(t, simulation.Enabled())
+	require.Equal(t, "FatalError", simulation.OnRevert())
+
+	simulationCfg := toml.SimulationConfig{OnRevert: ptr("Skip")}
+	require.ErrorContains(t, simulationCfg.ValidateConfig(), "OnRevert: invalid value (Skip): must be one of Send or FatalError")
+}
+
+func TestStatusEventsConfig(t *testing.T) {
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	statusEvents := cfg.EVM().Transactions().StatusEvents()
+	require.False(t, statusEvents.Enabled())
+	require.Nil(t, statusEvents.WebhookURL())
+	require.Equal(t, time.Second, statusEvents.PollInterval())
+	require.Equal(t, 10*time.Second, statusEvents.RetryInterval())
+	require.Equal(t, uint32(10), statusEvents.MaxDeliveryAttempts())
+	require.Equal(t, 168*time.Hour, statusEvents.ReaperThreshold())
+
+	cfg = testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.Transactions.StatusEvents.Enabled = ptr(true)
+		c.Transactions.StatusEvents.WebhookURL = commonconfig.MustParseURL("https://tx.status/events")
+	})
+	statusEvents = cfg.EVM().Transactions().StatusEvents()
+	require.True(t, statusEvents.Enabled())
+	require.Equal(t, "https://tx.status/events", statusEvents.WebhookURL().String())
+
+	statusEventsCfg := toml.StatusEventsConfig{PollInterval: commonconfig.MustNewDuration(0), MaxDeliveryAttempts: ptr[uint32](0)}
+	err := statusEventsCfg.ValidateConfig()
+	require.ErrorContains(t, err, "PollInterval: invalid value (0s): must be greater than zero")
+	require.ErrorContains(t, err, "MaxDeliveryAttempts: invalid value (0): must be greater than zero")
+}
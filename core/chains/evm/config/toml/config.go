@@ -397,6 +397,11 @@ func (c *Chain) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "GasEstimator.BumpTxDepth", Value: *c.GasEstimator.BumpTxDepth,
 			Msg: "must be less than or equal to Transactions.MaxInFlight"})
 	}
+	if t := c.Transactions.PriorityLanes.CriticalBumpThreshold; t != nil && *t > 0 &&
+		c.GasEstimator.BumpThreshold != nil && *c.GasEstimator.BumpThreshold > 0 && *t >= *c.GasEstimator.BumpThreshold {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Transactions.PriorityLanes.CriticalBumpThreshold", Value: *t,
+			Msg: "must be less than GasEstimator.BumpThreshold"})
+	}
 	if *c.FinalityDepth < 1 {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FinalityDepth", Value: *c.FinalityDepth,
 			Msg: "must be greater than or equal to 1"})
@@ -468,7 +473,11 @@ type Transactions struct {
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
 
-	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	AutoPurge     AutoPurgeConfig     `toml:",omitempty"`
+	Batching      BatchingConfig      `toml:",omitempty"`
+	PriorityLanes PriorityLanesConfig `toml:",omitempty"`
+	Simulation    SimulationConfig    `toml:",omitempty"`
+	StatusEvents  StatusEventsConfig  `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -491,6 +500,10 @@ func (t *Transactions) setFrom(f *Transactions) {
 		t.ResendAfterThreshold = v
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.Batching.setFrom(&f.Batching)
+	t.PriorityLanes.setFrom(&f.PriorityLanes)
+	t.Simulation.setFrom(&f.Simulation)
+	t.StatusEvents.setFrom(&f.StatusEvents)
 }
 
 type AutoPurgeConfig struct {
@@ -515,6 +528,121 @@ func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
 	}
 }
 
+type BatchingConfig struct {
+	Multicall3Address *types.EIP55Address
+	MaxCalls          *uint32
+}
+
+func (b *BatchingConfig) setFrom(f *BatchingConfig) {
+	if v := f.Multicall3Address; v != nil {
+		b.Multicall3Address = v
+	}
+	if v := f.MaxCalls; v != nil {
+		b.MaxCalls = v
+	}
+}
+
+func (b *BatchingConfig) ValidateConfig() (err error) {
+	if b.Multicall3Address == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Multicall3Address", Msg: "must be set"})
+	}
+	if b.MaxCalls != nil && *b.MaxCalls < 2 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "MaxCalls", Value: *b.MaxCalls, Msg: "must be at least 2"})
+	}
+	return
+}
+
+type PriorityLanesConfig struct {
+	CriticalMaxQueued     *uint32
+	NormalMaxQueued       *uint32
+	BulkMaxQueued         *uint32
+	CriticalBumpThreshold *uint32
+}
+
+func (p *PriorityLanesConfig) setFrom(f *PriorityLanesConfig) {
+	if v := f.CriticalMaxQueued; v != nil {
+		p.CriticalMaxQueued = v
+	}
+	if v := f.NormalMaxQueued; v != nil {
+		p.NormalMaxQueued = v
+	}
+	if v := f.BulkMaxQueued; v != nil {
+		p.BulkMaxQueued = v
+	}
+	if v := f.CriticalBumpThreshold; v != nil {
+		p.CriticalBumpThreshold = v
+	}
+}
+
+type SimulationConfig struct {
+	Enabled  *bool
+	OnRevert *string
+}
+
+func (s *SimulationConfig) setFrom(f *SimulationConfig) {
+	if v := f.Enabled; v != nil {
+		s.Enabled = v
+	}
+	if v := f.OnRevert; v != nil {
+		s.OnRevert = v
+	}
+}
+
+func (s *SimulationConfig) ValidateConfig() (err error) {
+	if s.OnRevert == nil {
+		return
+	}
+	switch *s.OnRevert {
+	case "Send", "FatalError":
+	default:
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "OnRevert", Value: *s.OnRevert,
+			Msg: "must be one of Send or FatalError"})
+	}
+	return
+}
+
+type StatusEventsConfig struct {
+	Enabled             *bool
+	WebhookURL          *commonconfig.URL
+	PollInterval        *commonconfig.Duration
+	RetryInterval       *commonconfig.Duration
+	MaxDeliveryAttempts *uint32
+	ReaperThreshold     *commonconfig.Duration
+}
+
+func (s *StatusEventsConfig) setFrom(f *StatusEventsConfig) {
+	if v := f.Enabled; v != nil {
+		s.Enabled = v
+	}
+	if v := f.WebhookURL; v != nil {
+		s.WebhookURL = v
+	}
+	if v := f.PollInterval; v != nil {
+		s.PollInterval = v
+	}
+	if v := f.RetryInterval; v != nil {
+		s.RetryInterval = v
+	}
+	if v := f.MaxDeliveryAttempts; v != nil {
+		s.MaxDeliveryAttempts = v
+	}
+	if v := f.ReaperThreshold; v != nil {
+		s.ReaperThreshold = v
+	}
+}
+
+func (s *StatusEventsConfig) ValidateConfig() (err error) {
+	if s.PollInterval != nil && s.PollInterval.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "PollInterval", Value: s.PollInterval.Duration(),
+			Msg: "must be greater than zero"})
+	}
+	if s.MaxDeliveryAttempts != nil && *s.MaxDeliveryAttempts == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "MaxDeliveryAttempts", Value: *s.MaxDeliveryAttempts,
+			Msg: "must be greater than zero"})
+	}
+	return
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -35,6 +35,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	clientmock "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
@@ -73,7 +74,7 @@ func NewTestEthBroadcaster(
 		return gas.NewFixedPriceEstimator(config.EVM().GasEstimator(), nil, ge.BlockHistory(), lggr, nil)
 	}, ge.EIP1559DynamicFees(), ge, ethClient)
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, keyStore, estimator)
-	ethBroadcaster := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(config.EVM().GasEstimator()), config.EVM().Transactions(), gconfig.Database().Listener(), keyStore, txBuilder, nonceTracker, lggr, checkerFactory, nonceAutoSync, "")
+	ethBroadcaster := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(config.EVM().GasEstimator()), txmgr.NewEvmTxmTxConfig(config.EVM().Transactions()), gconfig.Database().Listener(), keyStore, txBuilder, nonceTracker, lggr, checkerFactory, nonceAutoSync, "")
 
 	// Mark instance as test
 	ethBroadcaster.XXXTestDisableUnstartedTxAutoProcessing()
@@ -97,7 +98,7 @@ func TestEthBroadcaster_Lifecycle(t *testing.T) {
 		txmClient,
 		txmgr.NewEvmTxmConfig(evmcfg.EVM()),
 		txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()),
-		evmcfg.EVM().Transactions(),
+		txmgr.NewEvmTxmTxConfig(evmcfg.EVM().Transactions()),
 		cfg.Database().Listener(),
 		ethKeyStore,
 		txBuilder,
@@ -156,7 +157,7 @@ func TestEthBroadcaster_LoadNextSequenceMapFailure_StartupSuccess(t *testing.T)
 		txmClient,
 		txmgr.NewEvmTxmConfig(evmcfg.EVM()),
 		txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()),
-		evmcfg.EVM().Transactions(),
+		txmgr.NewEvmTxmTxConfig(evmcfg.EVM().Transactions()),
 		cfg.Database().Listener(),
 		ethKeyStore,
 		txBuilder,
@@ -629,6 +630,113 @@ func TestEthBroadcaster_TransmitChecking(t *testing.T) {
 	})
 }
 
+func TestEthBroadcaster_Simulation(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	ctx := tests.Context(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	revert := client.JsonError{Code: 3, Message: "execution reverted", Data: "0x4e487b710000000000000000000000000000000000000000000000000000000000000011"}
+
+	newBroadcaster := func(t *testing.T, onRevert string) (*txmgr.Broadcaster, *clientmock.Client, gethCommon.Address) {
+		cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].Transactions.Simulation.Enabled = ptr(true)
+			c.EVM[0].Transactions.Simulation.OnRevert = ptr(onRevert)
+		})
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+		nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+		return NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, &testCheckerFactory{}, false, nonceTracker), ethClient, fromAddress
+	}
+
+	t.Run("when predicted to revert with Send, sends tx and records the revert reason", func(t *testing.T) {
+		eb, ethClient, fromAddress := newBroadcaster(t, "Send")
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "pending", mock.Anything).Return(&revert).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, fromAddress).Return(commonclient.Successful, nil).Once()
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		ethTx, err = txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, ethTx.State)
+		assert.Equal(t, null.StringFrom("arithmetic underflow or overflow"), ethTx.SimulationRevertReason)
+	})
+
+	t.Run("when predicted to revert with FatalError, fatally errors tx with the revert reason", func(t *testing.T) {
+		eb, ethClient, fromAddress := newBroadcaster(t, "FatalError")
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "pending", mock.Anything).Return(&revert).Once()
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		ethTx, err = txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, ethTx.State)
+		assert.Equal(t, "transaction predicted to revert: arithmetic underflow or overflow", ethTx.Error.String)
+		assert.Equal(t, null.StringFrom("arithmetic underflow or overflow"), ethTx.SimulationRevertReason)
+
+		txs, _, err := txStore.TransactionsWithAttempts(ctx, 0, 100)
+		require.NoError(t, err)
+		require.NotEmpty(t, txs)
+		assert.Equal(t, ethTx.ID, txs[0].ID, "transactions predicted to revert are listed without attempts")
+	})
+
+	t.Run("when predicted to revert with FatalError, resumes the callback of the tx with the revert reason", func(t *testing.T) {
+		eb, ethClient, fromAddress := newBroadcaster(t, "FatalError")
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "pending", mock.Anything).Return(&revert).Once()
+
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		var resumed bool
+		eb.SetResumeCallback(func(ctx context.Context, id uuid.UUID, result interface{}, err error) error {
+			resumed = true
+			assert.Equal(t, tr.ID, id)
+			assert.Nil(t, result)
+			assert.EqualError(t, err, "fatal error while sending transaction: transaction predicted to revert: arithmetic underflow or overflow")
+			return nil
+		})
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, func(txRequest *txmgr.TxRequest) {
+			txRequest.PipelineTaskRunID = &tr.ID
+			txRequest.SignalCallback = true
+		})
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+		assert.True(t, resumed)
+
+		ethTx, err = txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, ethTx.State)
+		assert.Equal(t, "transaction predicted to revert: arithmetic underflow or overflow", ethTx.Error.String)
+		assert.Equal(t, null.StringFrom("arithmetic underflow or overflow"), ethTx.SimulationRevertReason)
+		assert.True(t, ethTx.CallbackCompleted)
+		assert.Empty(t, ethTx.TxAttempts)
+	})
+
+	t.Run("when simulation fails, sends tx as normal", func(t *testing.T) {
+		eb, ethClient, fromAddress := newBroadcaster(t, "FatalError")
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "pending", mock.Anything).Return(errors.New("connection refused")).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, fromAddress).Return(commonclient.Successful, nil).Once()
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		ethTx, err = txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, ethTx.State)
+		assert.False(t, ethTx.SimulationRevertReason.Valid)
+	})
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testing.T) {
 	// non-transactional DB needed because we deliberately test for FK violation
 	cfg, db := heavyweight.FullTestDBV2(t, nil)
@@ -655,7 +763,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testi
 		txmClient,
 		evmcfg,
 		txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()),
-		ccfg.EVM().Transactions(),
+		txmgr.NewEvmTxmTxConfig(ccfg.EVM().Transactions()),
 		cfg.Database().Listener(),
 		ethKeyStore,
 		txBuilder,
@@ -1182,7 +1290,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Errors(t *testing.T) {
 					}, evmcfg.EVM().GasEstimator().EIP1559DynamicFees(), evmcfg.EVM().GasEstimator(), ethClient)
 					txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), evmcfg.EVM().GasEstimator(), ethKeyStore, estimator)
 					localNextNonce = getLocalNextNonce(t, nonceTracker, fromAddress)
-					eb2 := txmgr.NewEvmBroadcaster(txStore, txmClient, txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()), evmcfg.EVM().Transactions(), cfg.Database().Listener(), ethKeyStore, txBuilder, lggr, &testCheckerFactory{}, false, "")
+					eb2 := txmgr.NewEvmBroadcaster(txStore, txmClient, txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()), txmgr.NewEvmTxmTxConfig(evmcfg.EVM().Transactions()), cfg.Database().Listener(), ethKeyStore, txBuilder, lggr, &testCheckerFactory{}, false, "")
 					retryable, err := eb2.ProcessUnstartedTxs(ctx, fromAddress)
 					assert.NoError(t, err)
 					assert.False(t, retryable)
@@ -1681,7 +1789,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_GasEstimationError(t *testing.T)
 		return gas.NewFixedPriceEstimator(ge, nil, ge.BlockHistory(), lggr, nil)
 	}, ge.EIP1559DynamicFees(), ge, ethClient)
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, estimator)
-	eb := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(config.EVM().GasEstimator()), config.EVM().Transactions(), cfg.Database().Listener(), ethKeyStore, txBuilder, nonceTracker, lggr, &testCheckerFactory{}, false, "")
+	eb := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(config.EVM().GasEstimator()), txmgr.NewEvmTxmTxConfig(config.EVM().Transactions()), cfg.Database().Listener(), ethKeyStore, txBuilder, nonceTracker, lggr, &testCheckerFactory{}, false, "")
 
 	// Mark instance as test
 	eb.XXXTestDisableUnstartedTxAutoProcessing()
@@ -1831,7 +1939,7 @@ func TestEthBroadcaster_SyncNonce(t *testing.T) {
 		kst.On("EnabledAddressesForChain", mock.Anything, testutils.FixtureChainID).Return(addresses, nil).Once()
 		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
 		txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
-		eb := txmgr.NewEvmBroadcaster(txStore, txmClient, evmTxmCfg, txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database().Listener(), kst, txBuilder, lggr, checkerFactory, false, "")
+		eb := txmgr.NewEvmBroadcaster(txStore, txmClient, evmTxmCfg, txmgr.NewEvmTxmFeeConfig(ge), txmgr.NewEvmTxmTxConfig(evmcfg.EVM().Transactions()), cfg.Database().Listener(), kst, txBuilder, lggr, checkerFactory, false, "")
 		err := eb.Start(ctx)
 		assert.NoError(t, err)
 
@@ -1908,7 +2016,7 @@ func TestEthBroadcaster_HederaBroadcastValidation(t *testing.T) {
 
 		mustInsertInProgressEthTxWithAttempt(t, txStore, evmtypes.Nonce(localNonce), fromAddress)
 		nonceTracker := txmgr.NewNonceTracker(lggr, txStore, txmgr.NewEvmTxmClient(ethClient, nil))
-		eb := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()), evmcfg.EVM().Transactions(), cfg.Database().Listener(), ethKeyStore, txBuilder, nonceTracker, lggr, checkerFactory, false, string(chaintype.ChainHedera))
+		eb := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()), txmgr.NewEvmTxmTxConfig(evmcfg.EVM().Transactions()), cfg.Database().Listener(), ethKeyStore, txBuilder, nonceTracker, lggr, checkerFactory, false, string(chaintype.ChainHedera))
 		// Mark instance as test
 		eb.XXXTestDisableUnstartedTxAutoProcessing()
 		servicetest.Run(t, eb)
@@ -1929,7 +2037,7 @@ func TestEthBroadcaster_HederaBroadcastValidation(t *testing.T) {
 
 		mustInsertInProgressEthTxWithAttempt(t, txStore, evmtypes.Nonce(localNonce), fromAddress)
 		nonceTracker := txmgr.NewNonceTracker(lggr, txStore, txmgr.NewEvmTxmClient(ethClient, nil))
-		eb := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()), evmcfg.EVM().Transactions(), cfg.Database().Listener(), ethKeyStore, txBuilder, nonceTracker, lggr, checkerFactory, false, string(chaintype.ChainHedera))
+		eb := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()), txmgr.NewEvmTxmTxConfig(evmcfg.EVM().Transactions()), cfg.Database().Listener(), ethKeyStore, txBuilder, nonceTracker, lggr, checkerFactory, false, string(chaintype.ChainHedera))
 		// Mark instance as test
 		eb.XXXTestDisableUnstartedTxAutoProcessing()
 		servicetest.Run(t, eb)
@@ -1950,7 +2058,7 @@ func TestEthBroadcaster_HederaBroadcastValidation(t *testing.T) {
 
 		etx := mustInsertInProgressEthTxWithAttempt(t, txStore, evmtypes.Nonce(localNonce), fromAddress)
 		nonceTracker := txmgr.NewNonceTracker(lggr, txStore, txmgr.NewEvmTxmClient(ethClient, nil))
-		eb := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()), evmcfg.EVM().Transactions(), cfg.Database().Listener(), ethKeyStore, txBuilder, nonceTracker, lggr, checkerFactory, false, string(chaintype.ChainHedera))
+		eb := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()), txmgr.NewEvmTxmTxConfig(evmcfg.EVM().Transactions()), cfg.Database().Listener(), ethKeyStore, txBuilder, nonceTracker, lggr, checkerFactory, false, string(chaintype.ChainHedera))
 		// Mark instance as test
 		eb.XXXTestDisableUnstartedTxAutoProcessing()
 		servicetest.Run(t, eb)
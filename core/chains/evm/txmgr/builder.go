@@ -52,20 +52,24 @@ func NewTxm(
 	// create tx attempt builder
 	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator)
 	txStore := NewTxStore(ds, lggr)
+	// the batch transactions of the chain are sent to its Multicall3 contract
+	txStore.multicall3Address = txConfig.Batching().Multicall3Address()
+	txStore.batchGasLimitMax = fCfg.LimitMax()
 	txmCfg := NewEvmTxmConfig(chainConfig)             // wrap Evm specific config
 	feeCfg := NewEvmTxmFeeConfig(fCfg)                 // wrap Evm specific config
+	txCfg := NewEvmTxmTxConfig(txConfig)               // wrap Evm specific config
 	txmClient := NewEvmTxmClient(client, clientErrors) // wrap Evm specific client
 	chainID := txmClient.ConfiguredChainID()
-	evmBroadcaster := NewEvmBroadcaster(txStore, txmClient, txmCfg, feeCfg, txConfig, listenerConfig, keyStore, txAttemptBuilder, lggr, checker, chainConfig.NonceAutoSync(), chainConfig.ChainType())
+	evmBroadcaster := NewEvmBroadcaster(txStore, txmClient, txmCfg, feeCfg, txCfg, listenerConfig, keyStore, txAttemptBuilder, lggr, checker, chainConfig.NonceAutoSync(), chainConfig.ChainType())
 	evmTracker := NewEvmTracker(txStore, keyStore, chainID, lggr)
 	stuckTxDetector := NewStuckTxDetector(lggr, client.ConfiguredChainID(), chainConfig.ChainType(), fCfg.PriceMax(), txConfig.AutoPurge(), estimator, txStore, client)
-	evmConfirmer := NewEvmConfirmer(txStore, txmClient, txmCfg, feeCfg, txConfig, dbConfig, keyStore, txAttemptBuilder, lggr, stuckTxDetector, headTracker)
+	evmConfirmer := NewEvmConfirmer(txStore, txmClient, txmCfg, feeCfg, txCfg, dbConfig, keyStore, txAttemptBuilder, lggr, stuckTxDetector, headTracker)
 	evmFinalizer := NewEvmFinalizer(lggr, client.ConfiguredChainID(), chainConfig.RPCDefaultBatchSize(), txStore, client, headTracker)
 	var evmResender *Resender
 	if txConfig.ResendAfterThreshold() > 0 {
 		evmResender = NewEvmResender(lggr, txStore, txmClient, evmTracker, keyStore, txmgr.DefaultResenderPollInterval, chainConfig, txConfig)
 	}
-	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer)
+	txm = NewEvmTxm(chainID, txmCfg, txCfg, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer)
 	return txm, nil
 }
 
@@ -108,6 +112,16 @@ func NewEvmReaper(lggr logger.Logger, store txmgrtypes.TxHistoryReaper[*big.Int]
 	return txmgr.NewReaper(lggr, store, txConfig, chainID)
 }
 
+// NewEvmStatusEventPublisher instantiates a new EVM-specific publisher of the state changes of transactions
+func NewEvmStatusEventPublisher(lggr logger.Logger, store TxStatusEventStore, config txmgrtypes.StatusEventsConfig, chainID *big.Int) *StatusEventPublisher {
+	return txmgr.NewStatusEventPublisher(lggr, store, config, chainID)
+}
+
+// NewEvmStatusEventReaper instantiates a new EVM-specific reaper of the state changes of transactions
+func NewEvmStatusEventReaper(lggr logger.Logger, store TxStatusEventStore, config txmgrtypes.StatusEventsConfig, chainID *big.Int) *StatusEventReaper {
+	return txmgr.NewStatusEventReaper(lggr, store, config, chainID)
+}
+
 // NewEvmConfirmer instantiates a new EVM confirmer
 func NewEvmConfirmer(
 	txStore TxStore,
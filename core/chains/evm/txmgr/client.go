@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -26,12 +29,13 @@ import (
 var _ TxmClient = (*evmTxmClient)(nil)
 
 type evmTxmClient struct {
-	client       client.Client
-	clientErrors config.ClientErrors
+	client        client.Client
+	clientErrors  config.ClientErrors
+	revertDecoder *RevertDecoder
 }
 
 func NewEvmTxmClient(c client.Client, clientErrors config.ClientErrors) *evmTxmClient {
-	return &evmTxmClient{client: c, clientErrors: clientErrors}
+	return &evmTxmClient{client: c, clientErrors: clientErrors, revertDecoder: NewRevertDecoder()}
 }
 
 func (c *evmTxmClient) PendingSequenceAt(ctx context.Context, addr common.Address) (evmtypes.Nonce, error) {
@@ -148,6 +152,37 @@ func (c *evmTxmClient) BatchGetReceipts(ctx context.Context, attempts []TxAttemp
 	return txReceipt, txErr, nil
 }
 
+// BatchCallOutcomes returns whether each of the calls of a mined Multicall3 batch transaction succeeded, decoded from
+// the data it returned. The data is traced with debug_traceTransaction, or replayed with eth_call on top of the block
+// before the one the batch was mined in when the RPC doesn't support tracing, missing the transactions mined before it
+// in its block.
+func (c *evmTxmClient) BatchCallOutcomes(ctx context.Context, etx Tx, receipt ChainReceipt) (succeeded []bool, err error) {
+	var trace struct {
+		Output hexutil.Bytes `json:"output"`
+	}
+	errTrace := c.client.CallContext(ctx, &trace, "debug_traceTransaction", receipt.GetTxHash(), map[string]interface{}{
+		"tracer":       "callTracer",
+		"tracerConfig": map[string]interface{}{"onlyTopCall": true},
+	})
+	if errTrace == nil {
+		return decodeMulticall3Results(trace.Output)
+	}
+
+	callArg := map[string]interface{}{
+		"from":  etx.FromAddress,
+		"to":    &etx.ToAddress,
+		"gas":   hexutil.Uint64(etx.FeeLimit),
+		"value": (*hexutil.Big)(&etx.Value),
+		"data":  hexutil.Bytes(etx.EncodedPayload),
+	}
+	blockNumber := new(big.Int).Sub(receipt.GetBlockNumber(), big.NewInt(1))
+	var b hexutil.Bytes
+	if err = c.client.CallContext(ctx, &b, "eth_call", callArg, hexutil.EncodeBig(blockNumber)); err != nil {
+		return nil, fmt.Errorf("failed to trace or replay batch transaction %s: %w", receipt.GetTxHash(), errors.Join(errTrace, err))
+	}
+	return decodeMulticall3Results(b)
+}
+
 // sendEmptyTransaction sends a transaction with 0 Eth and an empty payload to the burn address
 // May be useful for clearing stuck nonces
 func (c *evmTxmClient) SendEmptyTransaction(
@@ -189,6 +224,44 @@ func (c *evmTxmClient) CallContract(ctx context.Context, a TxAttempt, blockNumbe
 	return client.ExtractRPCError(errCall)
 }
 
+// simulationBalance is the balance of the sender when simulating a transaction, so that a key running low on funds
+// is not mistaken for a revert.
+var simulationBalance = new(big.Int).Lsh(big.NewInt(1), 128)
+
+func (c *evmTxmClient) SimulateTransaction(ctx context.Context, tx Tx, a TxAttempt) (revertReason string, reverted bool, err error) {
+	// Gas prices are deliberately left out, along with the balance of the sender, see SimulateChecker
+	callArg := map[string]interface{}{
+		"from":  tx.FromAddress,
+		"to":    &tx.ToAddress,
+		"gas":   hexutil.Uint64(a.ChainSpecificFeeLimit),
+		"value": (*hexutil.Big)(&tx.Value),
+		"data":  hexutil.Bytes(tx.EncodedPayload),
+	}
+	overrides := map[common.Address]map[string]interface{}{
+		tx.FromAddress: {"balance": (*hexutil.Big)(simulationBalance)},
+	}
+	var b hexutil.Bytes
+	// simulate on top of the transactions already sent, which the transaction is sequenced after
+	errCall := c.client.CallContext(ctx, &b, "eth_call", callArg, "pending", overrides)
+	if errCall == nil {
+		return "", false, nil
+	}
+	jErr := client.ExtractRPCErrorOrNil(errCall)
+	if jErr == nil || !isRevert(jErr) {
+		return "", false, errCall
+	}
+	return c.revertDecoder.decodeRPCRevert(tx.ToAddress, jErr), true, nil
+}
+
+func (c *evmTxmClient) RegisterRevertABI(to common.Address, abiJSON string) error {
+	contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return fmt.Errorf("failed to parse ABI of %s: %w", to, err)
+	}
+	c.revertDecoder.Register(to, contractABI)
+	return nil
+}
+
 func (c *evmTxmClient) HeadByHash(ctx context.Context, hash common.Hash) (*evmtypes.Head, error) {
 	return c.client.HeadByHash(ctx, hash)
 }
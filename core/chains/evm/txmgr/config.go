@@ -7,6 +7,7 @@ import (
 
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 )
 
@@ -26,6 +27,7 @@ type FeeConfig interface {
 	BumpThreshold() uint64
 	BumpTxDepth() uint32
 	LimitDefault() uint64
+	LimitMax() uint64
 	PriceDefault() *assets.Wei
 	TipCapMin() *assets.Wei
 	PriceMax() *assets.Wei
@@ -45,6 +47,7 @@ type ListenerConfig interface {
 type (
 	EvmTxmConfig         txmgrtypes.TransactionManagerChainConfig
 	EvmTxmFeeConfig      txmgrtypes.TransactionManagerFeeConfig
+	EvmTxmTxConfig       txmgrtypes.TransactionManagerTransactionsConfig
 	EvmBroadcasterConfig txmgrtypes.BroadcasterChainConfig
 	EvmConfirmerConfig   txmgrtypes.ConfirmerChainConfig
 	EvmResenderConfig    txmgrtypes.ResenderChainConfig
@@ -75,3 +78,48 @@ func NewEvmTxmFeeConfig(c FeeConfig) *evmTxmFeeConfig {
 func (c evmTxmFeeConfig) MaxFeePrice() string { return c.PriceMax().String() }
 
 func (c evmTxmFeeConfig) FeePriceDefault() string { return c.PriceDefault().String() }
+
+var _ EvmTxmTxConfig = (*evmTxmTxConfig)(nil)
+
+type evmTxmTxConfig struct {
+	config.Transactions
+}
+
+func NewEvmTxmTxConfig(c config.Transactions) *evmTxmTxConfig {
+	return &evmTxmTxConfig{c}
+}
+
+func (c evmTxmTxConfig) PriorityLanes() txmgrtypes.PriorityLanesConfig {
+	return evmPriorityLanesConfig{c.Transactions.PriorityLanes()}
+}
+
+func (c evmTxmTxConfig) Simulation() txmgrtypes.SimulationConfig {
+	return evmSimulationConfig{c.Transactions.Simulation()}
+}
+
+func (c evmTxmTxConfig) StatusEvents() txmgrtypes.StatusEventsConfig {
+	return c.Transactions.StatusEvents()
+}
+
+type evmPriorityLanesConfig struct {
+	config.PriorityLanes
+}
+
+func (c evmPriorityLanesConfig) MaxQueued(priority txmgrtypes.TxPriority) uint64 {
+	switch priority {
+	case txmgrtypes.TxPriorityCritical:
+		return c.CriticalMaxQueued()
+	case txmgrtypes.TxPriorityBulk:
+		return c.BulkMaxQueued()
+	default:
+		return c.NormalMaxQueued()
+	}
+}
+
+type evmSimulationConfig struct {
+	config.Simulation
+}
+
+func (c evmSimulationConfig) OnRevert() txmgrtypes.SimulationRevertAction {
+	return txmgrtypes.SimulationRevertAction(c.Simulation.OnRevert())
+}
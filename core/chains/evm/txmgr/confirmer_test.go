@@ -133,7 +133,7 @@ func TestEthConfirmer_Lifecycle(t *testing.T) {
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, feeEstimator)
 	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), config.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
 	ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
-	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, ht)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), txmgr.NewEvmTxmTxConfig(config.EVM().Transactions()), gconfig.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, ht)
 	ctx := tests.Context(t)
 
 	// Can't close unstarted instance
@@ -1637,6 +1637,57 @@ func TestEthConfirmer_FindTxsRequiringRebroadcast(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_FindTxsRequiringRebroadcast_CriticalLane(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.PriorityLanes.CriticalBumpThreshold = ptr[uint32](3)
+	})
+	txStore := cltest.NewTestTxStore(t, db)
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	lggr := logger.Test(t)
+	ec := newEthConfirmer(t, txStore, ethClient, cfg, evmcfg, ethKeyStore, nil)
+
+	currentHead := int64(30)
+	gasBumpThreshold := int64(10)
+	mustInsertBroadcastTx := func(nonce int64, priority txmgrtypes.TxPriority) txmgr.Tx {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, nonce, fromAddress)
+		_, err := db.Exec(`UPDATE evm.txes SET priority=$1 WHERE id=$2`, priority, etx.ID)
+		require.NoError(t, err)
+		_, err = db.Exec(`UPDATE evm.tx_attempts SET broadcast_before_block_num=$1 WHERE eth_tx_id=$2`, currentHead-5, etx.ID)
+		require.NoError(t, err)
+		return etx
+	}
+	etx0 := mustInsertBroadcastTx(0, txmgrtypes.TxPriorityNormal)
+
+	t.Run("bumps nothing before the threshold of the other lanes", func(t *testing.T) {
+		etxs, err := ec.FindTxsRequiringRebroadcast(tests.Context(t), lggr, fromAddress, currentHead, gasBumpThreshold, 10, 0, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Len(t, etxs, 0)
+	})
+
+	etx1 := mustInsertBroadcastTx(1, txmgrtypes.TxPriorityCritical)
+	mustInsertBroadcastTx(2, txmgrtypes.TxPriorityBulk)
+
+	t.Run("bumps critical transactions after the critical threshold, along with the transactions ahead of them", func(t *testing.T) {
+		etxs, err := ec.FindTxsRequiringRebroadcast(tests.Context(t), lggr, fromAddress, currentHead, gasBumpThreshold, 10, 0, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, etxs, 2)
+		assert.Equal(t, etx0.ID, etxs[0].ID)
+		assert.Equal(t, etx1.ID, etxs[1].ID)
+	})
+
+	t.Run("bumps all the transactions after the threshold of the other lanes", func(t *testing.T) {
+		etxs, err := ec.FindTxsRequiringRebroadcast(tests.Context(t), lggr, fromAddress, currentHead, 5, 10, 0, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Len(t, etxs, 3)
+	})
+}
+
 func TestEthConfirmer_RebroadcastWhereNecessary_WithConnectivityCheck(t *testing.T) {
 	t.Parallel()
 	lggr := logger.Test(t)
@@ -1669,7 +1720,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary_WithConnectivityCheck(t *testing
 		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), ccfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
 		ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
 		// Create confirmer with necessary state
-		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector, ht)
+		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), txmgr.NewEvmTxmTxConfig(ccfg.EVM().Transactions()), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector, ht)
 		servicetest.Run(t, ec)
 		currentHead := int64(30)
 		oldEnough := int64(15)
@@ -1718,7 +1769,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary_WithConnectivityCheck(t *testing
 		kst.On("EnabledAddressesForChain", mock.Anything, &cltest.FixtureChainID).Return(addresses, nil).Maybe()
 		stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), ccfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
 		ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
-		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector, ht)
+		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), txmgr.NewEvmTxmTxConfig(ccfg.EVM().Transactions()), cfg.Database(), kst, txBuilder, lggr, stuckTxDetector, ht)
 		servicetest.Run(t, ec)
 		currentHead := int64(30)
 		oldEnough := int64(15)
@@ -3230,7 +3281,7 @@ func TestEthConfirmer_ProcessStuckTransactions(t *testing.T) {
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, feeEstimator)
 	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), evmcfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
 	ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
-	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, ht)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(ge), txmgr.NewEvmTxmTxConfig(evmcfg.EVM().Transactions()), cfg.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, ht)
 	fn := func(ctx context.Context, id uuid.UUID, result interface{}, err error) error {
 		require.ErrorContains(t, err, client.TerminallyStuckMsg)
 		return nil
@@ -3324,7 +3375,7 @@ func newEthConfirmer(t testing.TB, txStore txmgr.EvmTxStore, ethClient client.Cl
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ks, estimator)
 	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), config.EVM().Transactions().AutoPurge(), estimator, txStore, ethClient)
 	ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
-	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ks, txBuilder, lggr, stuckTxDetector, ht)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), txmgr.NewEvmTxmTxConfig(config.EVM().Transactions()), gconfig.Database(), ks, txBuilder, lggr, stuckTxDetector, ht)
 	ec.SetResumeCallback(fn)
 	servicetest.Run(t, ec)
 	return ec
@@ -83,6 +83,10 @@ type evmTxStore struct {
 	q      sqlutil.DataSource
 	logger logger.SugaredLogger
 	stopCh services.StopChan
+	// multicall3Address is the destination of the batch transactions merged by BatchUnstartedTxQueue
+	multicall3Address common.Address
+	// batchGasLimitMax caps the gas limit of the batch transactions merged by BatchUnstartedTxQueue, if set
+	batchGasLimitMax uint64
 }
 
 var _ EvmTxStore = (*evmTxStore)(nil)
@@ -124,15 +128,21 @@ func (o *evmTxStore) Transact(ctx context.Context, readOnly bool, fn func(*evmTx
 }
 
 // new returns a NewORM like o, but backed by q.
-func (o *evmTxStore) new(q sqlutil.DataSource) *evmTxStore { return NewTxStore(q, o.logger) }
+func (o *evmTxStore) new(q sqlutil.DataSource) *evmTxStore {
+	orm := NewTxStore(q, o.logger)
+	orm.multicall3Address = o.multicall3Address
+	orm.batchGasLimitMax = o.batchGasLimitMax
+	return orm
+}
 
 // Directly maps to some columns of few database tables.
 // Does not map to a single database table.
 // It's comprised of fields from different tables.
 type dbReceiptPlus struct {
-	ID           uuid.UUID        `db:"pipeline_task_run_id"`
-	Receipt      evmtypes.Receipt `db:"receipt"`
-	FailOnRevert bool             `db:"FailOnRevert"`
+	ID            uuid.UUID        `db:"pipeline_task_run_id"`
+	Receipt       evmtypes.Receipt `db:"receipt"`
+	FailOnRevert  bool             `db:"FailOnRevert"`
+	CallSucceeded bool             `db:"call_succeeded"`
 }
 
 func fromDBReceipts(rs []DbReceipt) []*evmtypes.Receipt {
@@ -146,6 +156,10 @@ func fromDBReceipts(rs []DbReceipt) []*evmtypes.Receipt {
 func fromDBReceiptsPlus(rs []dbReceiptPlus) []ReceiptPlus {
 	receipts := make([]ReceiptPlus, len(rs))
 	for i := 0; i < len(rs); i++ {
+		// a call which failed within its batch transaction is resumed as a reverted transaction
+		if !rs[i].CallSucceeded {
+			rs[i].Receipt.Status = 0
+		}
 		receipts[i] = ReceiptPlus{
 			ID:           rs[i].ID,
 			Receipt:      &rs[i].Receipt,
@@ -198,7 +212,12 @@ type DbEthTx struct {
 	// Marks tx requiring callback
 	SignalCallback bool
 	// Marks tx callback as signaled
-	CallbackCompleted bool
+	CallbackCompleted      bool
+	Priority               txmgrtypes.TxPriority
+	SimulationRevertReason nullv4.String
+	SupersededByTxID       nullv4.Int
+	SupersededByCancel     bool
+	SupersedesTxID         nullv4.Int
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -221,6 +240,11 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.Priority = tx.Priority
+	db.SimulationRevertReason = tx.SimulationRevertReason
+	db.SupersededByTxID = tx.SupersededByTxID
+	db.SupersededByCancel = tx.SupersededByCancel
+	db.SupersedesTxID = tx.SupersedesTxID
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -256,6 +280,11 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.Priority = db.Priority
+	tx.SimulationRevertReason = db.SimulationRevertReason
+	tx.SupersededByTxID = db.SupersededByTxID
+	tx.SupersededByCancel = db.SupersededByCancel
+	tx.SupersedesTxID = db.SupersedesTxID
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -351,12 +380,17 @@ func NewTxStore(
 ) *evmTxStore {
 	namedLogger := logger.Named(lggr, "TxmStore")
 	return &evmTxStore{
-		q:      db,
-		logger: logger.Sugared(namedLogger),
-		stopCh: make(chan struct{}),
+		q:                 db,
+		logger:            logger.Sugared(namedLogger),
+		stopCh:            make(chan struct{}),
+		multicall3Address: Multicall3Address,
 	}
 }
 
+const insertIntoEthTxesQuery = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, priority, supersedes_tx_id) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :priority, :supersedes_tx_id
+) RETURNING *`
+
 const insertIntoEthTxAttemptsQuery = `
 INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt)
 VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt)
@@ -450,15 +484,16 @@ func (o *evmTxStore) Transactions(ctx context.Context, offset, limit int) (txs [
 	return
 }
 
-// TransactionsWithAttempts returns all eth transactions with at least one attempt
+// TransactionsWithAttempts returns all eth transactions with at least one attempt, along with the ones
+// predicted to revert when simulated, which may never have been broadcast,
 // limited by passed parameters. Attempts are sorted by id.
 func (o *evmTxStore) TransactionsWithAttempts(ctx context.Context, offset, limit int) (txs []Tx, count int, err error) {
-	sql := `SELECT count(*) FROM evm.txes WHERE id IN (SELECT DISTINCT eth_tx_id FROM evm.tx_attempts)`
+	sql := `SELECT count(*) FROM evm.txes WHERE id IN (SELECT DISTINCT eth_tx_id FROM evm.tx_attempts) OR simulation_revert_reason IS NOT NULL`
 	if err = o.q.GetContext(ctx, &count, sql); err != nil {
 		return
 	}
 
-	sql = `SELECT * FROM evm.txes WHERE id IN (SELECT DISTINCT eth_tx_id FROM evm.tx_attempts) ORDER BY id desc LIMIT $1 OFFSET $2`
+	sql = `SELECT * FROM evm.txes WHERE id IN (SELECT DISTINCT eth_tx_id FROM evm.tx_attempts) OR simulation_revert_reason IS NOT NULL ORDER BY id desc LIMIT $1 OFFSET $2`
 	var dbTxs []DbEthTx
 	if err = o.q.SelectContext(ctx, &dbTxs, sql, limit, offset); err != nil {
 		return
@@ -530,13 +565,10 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
-) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
 
-	query, args, err := o.q.BindNamed(insertEthTxSQL, &dbTx)
+	query, args, err := o.q.BindNamed(insertIntoEthTxesQuery, &dbTx)
 	if err != nil {
 		return pkgerrors.Wrap(err, "InsertTx failed to bind named")
 	}
@@ -1062,7 +1094,7 @@ func (o *evmTxStore) FindTxesPendingCallback(ctx context.Context, latest, finali
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	err = o.q.SelectContext(ctx, &rs, `
-	SELECT evm.txes.pipeline_task_run_id, evm.receipts.receipt, COALESCE((evm.txes.meta->>'FailOnRevert')::boolean, false) "FailOnRevert" FROM evm.txes
+	SELECT evm.txes.pipeline_task_run_id, evm.receipts.receipt, COALESCE((evm.txes.meta->>'FailOnRevert')::boolean, false) "FailOnRevert", TRUE AS call_succeeded FROM evm.txes
 	INNER JOIN evm.tx_attempts ON evm.txes.id = evm.tx_attempts.eth_tx_id
 	INNER JOIN evm.receipts ON evm.tx_attempts.hash = evm.receipts.tx_hash
 	WHERE evm.txes.pipeline_task_run_id IS NOT NULL AND evm.txes.signal_callback = TRUE AND evm.txes.callback_completed = FALSE
@@ -1071,6 +1103,18 @@ func (o *evmTxStore) FindTxesPendingCallback(ctx context.Context, latest, finali
 		OR (evm.txes.min_confirmations IS NULL AND evm.receipts.block_number <= $2)
 	) 
   	AND evm.txes.evm_chain_id = $3
+	UNION ALL
+	SELECT evm.tx_batch_items.pipeline_task_run_id, evm.receipts.receipt, COALESCE((evm.tx_batch_items.meta->>'FailOnRevert')::boolean, false) "FailOnRevert", evm.tx_batch_items.call_succeeded FROM evm.tx_batch_items
+	INNER JOIN evm.txes ON evm.txes.id = evm.tx_batch_items.batch_tx_id
+	INNER JOIN evm.tx_attempts ON evm.txes.id = evm.tx_attempts.eth_tx_id
+	INNER JOIN evm.receipts ON evm.tx_attempts.hash = evm.receipts.tx_hash
+	WHERE evm.tx_batch_items.pipeline_task_run_id IS NOT NULL AND evm.tx_batch_items.signal_callback = TRUE AND evm.tx_batch_items.callback_completed = FALSE
+	AND evm.tx_batch_items.call_succeeded IS NOT NULL
+	AND (
+	    (evm.txes.min_confirmations IS NOT NULL AND evm.receipts.block_number <= ($1 - evm.txes.min_confirmations))
+		OR (evm.txes.min_confirmations IS NULL AND evm.receipts.block_number <= $2)
+	)
+	AND evm.txes.evm_chain_id = $3
 	`, latest, finalized, chainID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve transactions pending pipeline resume callback: %w", err)
@@ -1084,7 +1128,12 @@ func (o *evmTxStore) UpdateTxCallbackCompleted(ctx context.Context, pipelineTask
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
-	_, err := o.q.ExecContext(ctx, `UPDATE evm.txes SET callback_completed = TRUE WHERE pipeline_task_run_id = $1 AND evm_chain_id = $2`, pipelineTaskRunId, chainId.String())
+	_, err := o.q.ExecContext(ctx, `
+WITH batched AS (
+	UPDATE evm.tx_batch_items SET callback_completed = TRUE FROM evm.txes
+	WHERE evm.txes.id = evm.tx_batch_items.batch_tx_id AND evm.tx_batch_items.pipeline_task_run_id = $1 AND evm.txes.evm_chain_id = $2
+)
+UPDATE evm.txes SET callback_completed = TRUE WHERE pipeline_task_run_id = $1 AND evm_chain_id = $2`, pipelineTaskRunId, chainId.String())
 	if err != nil {
 		return fmt.Errorf("failed to mark callback completed for transaction: %w", err)
 	}
@@ -1419,6 +1468,8 @@ func (o *evmTxStore) GetTxByID(ctx context.Context, id int64) (txe *Tx, err erro
 // limited by limit pending transactions
 //
 // It also returns evm.txes that are unconfirmed with no evm.tx_attempts
+//
+// The transactions superseded by an operator are not bumped, so as not to compete with the transactions superseding them
 func (o *evmTxStore) FindTxsRequiringGasBump(ctx context.Context, address common.Address, blockNum, gasBumpThreshold, depth int64, chainID *big.Int) (etxs []*Tx, err error) {
 	if gasBumpThreshold == 0 {
 		return
@@ -1431,6 +1482,7 @@ func (o *evmTxStore) FindTxsRequiringGasBump(ctx context.Context, address common
 SELECT evm.txes.* FROM evm.txes
 LEFT JOIN evm.tx_attempts ON evm.txes.id = evm.tx_attempts.eth_tx_id AND (broadcast_before_block_num > $4 OR broadcast_before_block_num IS NULL OR evm.tx_attempts.state != 'broadcast')
 WHERE evm.txes.state = 'unconfirmed' AND evm.tx_attempts.id IS NULL AND evm.txes.from_address = $1 AND evm.txes.evm_chain_id = $2
+	AND evm.txes.superseded_by_tx_id IS NULL
 	AND (($3 = 0) OR (evm.txes.id IN (SELECT id FROM evm.txes WHERE state = 'unconfirmed' AND from_address = $1 ORDER BY nonce ASC LIMIT $3)))
 ORDER BY nonce ASC
 `
@@ -1459,6 +1511,7 @@ func (o *evmTxStore) FindTxsRequiringResubmissionDueToInsufficientFunds(ctx cont
 SELECT DISTINCT evm.txes.* FROM evm.txes
 INNER JOIN evm.tx_attempts ON evm.txes.id = evm.tx_attempts.eth_tx_id AND evm.tx_attempts.state = 'insufficient_eth'
 WHERE evm.txes.from_address = $1 AND evm.txes.state = 'unconfirmed' AND evm.txes.evm_chain_id = $2
+	AND evm.txes.superseded_by_tx_id IS NULL
 ORDER BY nonce ASC
 `, address, chainID.String())
 		if err != nil {
@@ -1593,13 +1646,13 @@ func (o *evmTxStore) SaveReplacementInProgressAttempt(ctx context.Context, oldAt
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
+// Finds earliest saved transaction of the highest priority lane that has yet to be broadcast from the given address
 func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*Tx, error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	var dbEtx DbEthTx
-	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY priority DESC, value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
 	etx := new(Tx)
 	dbEtx.ToTx(etx)
 	if err != nil {
@@ -1629,7 +1682,7 @@ func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etx *Tx) error {
 		}
 		var dbEtx DbEthTx
 		dbEtx.FromTx(etx)
-		err := pkgerrors.Wrap(orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
+		err := pkgerrors.Wrap(orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL, simulation_revert_reason=$3 WHERE id=$4 RETURNING *`, etx.State, etx.Error, etx.SimulationRevertReason, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
 		dbEtx.ToTx(etx)
 		return err
 	})
@@ -1734,7 +1787,7 @@ func (o *evmTxStore) UpdateTxUnstartedToInProgress(ctx context.Context, etx *Tx,
 		dbAttempt.ToTxAttempt(attempt)
 		var dbEtx DbEthTx
 		dbEtx.FromTx(etx)
-		err = orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET nonce=$1, state=$2, broadcast_at=$3, initial_broadcast_at=$4 WHERE id=$5 RETURNING *`, etx.Sequence, etx.State, etx.BroadcastAt, etx.InitialBroadcastAt, etx.ID)
+		err = orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET nonce=$1, state=$2, broadcast_at=$3, initial_broadcast_at=$4, simulation_revert_reason=$5 WHERE id=$6 RETURNING *`, etx.Sequence, etx.State, etx.BroadcastAt, etx.InitialBroadcastAt, etx.SimulationRevertReason, etx.ID)
 		dbEtx.ToTx(etx)
 		return pkgerrors.Wrap(err, "UpdateTxUnstartedToInProgress failed to update eth_tx")
 	})
@@ -1835,6 +1888,26 @@ func (o *evmTxStore) CheckTxQueueCapacity(ctx context.Context, fromAddress commo
 	return
 }
 
+func (o *evmTxStore) CheckTxQueueLaneCapacity(ctx context.Context, fromAddress common.Address, priority txmgrtypes.TxPriority, maxQueuedTransactions uint64, chainID *big.Int) (err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if maxQueuedTransactions == 0 {
+		return nil
+	}
+	var count uint64
+	err = o.q.GetContext(ctx, &count, `SELECT count(*) FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 AND priority = $3`, fromAddress, chainID.String(), priority)
+	if err != nil {
+		err = pkgerrors.Wrap(err, "CheckTxQueueLaneCapacity query failed")
+		return
+	}
+
+	if count >= maxQueuedTransactions {
+		err = pkgerrors.Errorf("cannot create transaction; too many unstarted %s transactions in the queue (%v/%v). %s", priority, count, maxQueuedTransactions, label.MaxQueuedLaneTransactionsWarning)
+	}
+	return
+}
+
 func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest, chainID *big.Int) (tx Tx, err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1851,14 +1924,22 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 				// if a previous transaction for this task run exists, immediately return it
 				return nil
 			}
+			err = orm.q.GetContext(ctx, &dbEtx, `SELECT evm.txes.* FROM evm.txes INNER JOIN evm.tx_batch_items ON evm.txes.id = evm.tx_batch_items.batch_tx_id WHERE evm.tx_batch_items.pipeline_task_run_id = $1 AND evm.txes.evm_chain_id = $2`, txRequest.PipelineTaskRunID, chainID.String())
+			// if the transaction of this task run was merged into a batch, return the batch
+			if !errors.Is(err, sql.ErrNoRows) {
+				if err != nil {
+					return pkgerrors.Wrap(err, "CreateEthTransaction")
+				}
+				return nil
+			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, priority)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, txRequest.Priority)
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
@@ -1897,6 +1978,221 @@ id < (
 	return
 }
 
+// BatchUnstartedTxQueue merges the unstarted transactions of the subject into Multicall3 batch transactions of at most
+// batchSize calls, grouping them by sending key, lane and confirmations. The gas limit of a batch, which is the sum of
+// the gas limits of its calls, is kept within batchGasLimitMax by splitting the calls into more batches. The merged transactions are deleted and kept as
+// the items of their batch, so that their pipeline runs are resumed with the receipt of the batch.
+func (o *evmTxStore) BatchUnstartedTxQueue(ctx context.Context, batchSize uint32, subject uuid.UUID) (ids []int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if batchSize < 2 {
+		return nil, nil
+	}
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		// Transactions with a transmit checker, an idempotency key or a forwarder are sent on their own,
+		// and batches are never merged again.
+		var dbEtxs []DbEthTx
+		err := orm.q.SelectContext(ctx, &dbEtxs, `
+SELECT * FROM evm.txes
+WHERE state = 'unstarted' AND subject = $1
+AND (transmit_checker IS NULL OR transmit_checker = '{}'::jsonb)
+AND idempotency_key IS NULL
+AND meta->>'ForwarderDestAddress' IS NULL
+AND NOT EXISTS (SELECT 1 FROM evm.tx_batch_items WHERE evm.tx_batch_items.batch_tx_id = evm.txes.id)
+ORDER BY id ASC
+FOR UPDATE`, subject)
+		if err != nil {
+			return fmt.Errorf("BatchUnstartedTxQueue failed to load unstarted transactions: %w", err)
+		}
+
+		type batchKey struct {
+			fromAddress      common.Address
+			evmChainID       string
+			minConfirmations null.Uint32
+			priority         txmgrtypes.TxPriority
+		}
+		var keys []batchKey
+		groups := make(map[batchKey][]DbEthTx)
+		for _, dbEtx := range dbEtxs {
+			key := batchKey{dbEtx.FromAddress, dbEtx.EVMChainID.String(), dbEtx.MinConfirmations, dbEtx.Priority}
+			if _, exists := groups[key]; !exists {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], dbEtx)
+		}
+
+		for _, key := range keys {
+			group := groups[key]
+			for len(group) > 1 {
+				n := orm.batchLen(group, batchSize)
+				if n < 2 {
+					// the transaction has no room for another call, so it's sent on its own
+					group = group[1:]
+					continue
+				}
+				merged, err := orm.insertTxBatch(ctx, group[:n])
+				if err != nil {
+					return err
+				}
+				ids = append(ids, merged...)
+				group = group[n:]
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// batchLen returns the number of the leading transactions merged into the next batch, which are at most batchSize
+// and whose gas limits add up to at most batchGasLimitMax.
+func (o *evmTxStore) batchLen(txs []DbEthTx, batchSize uint32) int {
+	var gasLimit uint64
+	for i, tx := range txs {
+		gasLimit += tx.GasLimit
+		if i == int(batchSize) || (o.batchGasLimitMax > 0 && gasLimit > o.batchGasLimitMax) {
+			return i
+		}
+	}
+	return len(txs)
+}
+
+func (o *evmTxStore) insertTxBatch(ctx context.Context, txs []DbEthTx) (ids []int64, err error) {
+	payload, err := encodeMulticall3Batch(txs)
+	if err != nil {
+		return nil, err
+	}
+	value := new(big.Int)
+	var gasLimit uint64
+	createdAt := txs[0].CreatedAt
+	for _, tx := range txs {
+		value.Add(value, tx.Value.ToInt())
+		// the intrinsic gas of the merged transactions more than covers the overhead of Multicall3
+		gasLimit += tx.GasLimit
+		if tx.CreatedAt.Before(createdAt) {
+			createdAt = tx.CreatedAt
+		}
+	}
+
+	// the batch takes the place of its oldest transaction in the queue
+	first := txs[0]
+	var batchID int64
+	err = o.q.GetContext(ctx, &batchID, `
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, subject, evm_chain_id, min_confirmations, priority)
+VALUES (
+$1,$2,$3,$4,$5,'unstarted',$6,$7,$8,$9,$10
+)
+RETURNING id
+`, first.FromAddress, o.multicall3Address, payload, assets.Eth(*value), gasLimit, createdAt, first.Subject, first.EVMChainID.String(), first.MinConfirmations, first.Priority)
+	if err != nil {
+		return nil, fmt.Errorf("BatchUnstartedTxQueue failed to insert batch transaction: %w", err)
+	}
+
+	for i, tx := range txs {
+		_, err = o.q.ExecContext(ctx, `
+INSERT INTO evm.tx_batch_items (batch_tx_id, batch_index, tx_id, to_address, encoded_payload, value, gas_limit, meta, pipeline_task_run_id, signal_callback, created_at)
+VALUES (
+$1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11
+)`, batchID, i, tx.ID, tx.ToAddress, tx.EncodedPayload, tx.Value, tx.GasLimit, tx.Meta, tx.PipelineTaskRunID, tx.SignalCallback, tx.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("BatchUnstartedTxQueue failed to insert batch item: %w", err)
+		}
+		ids = append(ids, tx.ID)
+	}
+
+	_, err = o.q.ExecContext(ctx, `DELETE FROM evm.txes WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("BatchUnstartedTxQueue failed to delete merged transactions: %w", err)
+	}
+	return ids, nil
+}
+
+// FindBatchedTaskRunIDsPendingCallback returns the pipeline task runs of the transactions merged into the batch
+// transaction which are waiting for a callback.
+func (o *evmTxStore) FindBatchedTaskRunIDsPendingCallback(ctx context.Context, etxID int64) (ids []uuid.UUID, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.q.SelectContext(ctx, &ids, `
+SELECT pipeline_task_run_id FROM evm.tx_batch_items
+WHERE batch_tx_id = $1 AND pipeline_task_run_id IS NOT NULL AND signal_callback = TRUE AND callback_completed = FALSE
+ORDER BY batch_index ASC`, etxID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve batched transactions pending callback: %w", err)
+	}
+	return
+}
+
+// FindBatchTxsPendingCallOutcomes returns the batch transactions, with their attempts and receipts, which were mined as
+// deep as their pipeline runs are resumed at, and whose calls don't have their outcomes recorded yet.
+func (o *evmTxStore) FindBatchTxsPendingCallOutcomes(ctx context.Context, latest, finalized int64, chainID *big.Int) (etxs []*Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.Transact(ctx, true, func(orm *evmTxStore) error {
+		var dbEtxs []DbEthTx
+		err = orm.q.SelectContext(ctx, &dbEtxs, `
+SELECT * FROM evm.txes
+WHERE evm.txes.evm_chain_id = $3
+AND EXISTS (SELECT 1 FROM evm.tx_batch_items WHERE evm.tx_batch_items.batch_tx_id = evm.txes.id AND evm.tx_batch_items.call_succeeded IS NULL)
+AND EXISTS (
+	SELECT 1 FROM evm.tx_attempts
+	INNER JOIN evm.receipts ON evm.tx_attempts.hash = evm.receipts.tx_hash
+	WHERE evm.tx_attempts.eth_tx_id = evm.txes.id
+	AND (
+		(evm.txes.min_confirmations IS NOT NULL AND evm.receipts.block_number <= ($1 - evm.txes.min_confirmations))
+		OR (evm.txes.min_confirmations IS NULL AND evm.receipts.block_number <= $2)
+	)
+)
+ORDER BY evm.txes.id ASC`, latest, finalized, chainID.String())
+		if err != nil {
+			return fmt.Errorf("failed to load batch transactions: %w", err)
+		}
+		etxs = make([]*Tx, len(dbEtxs))
+		dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
+		if err = orm.LoadTxesAttempts(ctx, etxs); err != nil {
+			return fmt.Errorf("failed to load evm.tx_attempts for batch transactions: %w", err)
+		}
+		return orm.loadEthTxesAttemptsReceipts(ctx, etxs)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("FindBatchTxsPendingCallOutcomes failed: %w", err)
+	}
+	return
+}
+
+// SetBatchCallOutcomes records whether each of the calls of the batch transaction succeeded, in the order of the batch.
+// A nil succeeded records all of them as failed, as when the batch transaction itself reverted.
+func (o *evmTxStore) SetBatchCallOutcomes(ctx context.Context, etxID int64, succeeded []bool) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if succeeded == nil {
+		_, err := o.q.ExecContext(ctx, `UPDATE evm.tx_batch_items SET call_succeeded = FALSE WHERE batch_tx_id = $1`, etxID)
+		if err != nil {
+			return fmt.Errorf("failed to record outcomes of batch transaction %d: %w", etxID, err)
+		}
+		return nil
+	}
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		var count int
+		if err := orm.q.GetContext(ctx, &count, `SELECT count(*) FROM evm.tx_batch_items WHERE batch_tx_id = $1`, etxID); err != nil {
+			return fmt.Errorf("failed to count calls of batch transaction %d: %w", etxID, err)
+		}
+		if count != len(succeeded) {
+			return fmt.Errorf("batch transaction %d has %d calls, got %d outcomes", etxID, count, len(succeeded))
+		}
+		_, err := orm.q.ExecContext(ctx, `
+UPDATE evm.tx_batch_items SET call_succeeded = outcomes.succeeded
+FROM unnest($2::boolean[]) WITH ORDINALITY AS outcomes(succeeded, batch_index)
+WHERE evm.tx_batch_items.batch_tx_id = $1 AND evm.tx_batch_items.batch_index = outcomes.batch_index - 1`, etxID, pq.Array(succeeded))
+		if err != nil {
+			return fmt.Errorf("failed to record outcomes of batch transaction %d: %w", etxID, err)
+		}
+		return nil
+	})
+}
+
 func (o *evmTxStore) ReapTxHistory(ctx context.Context, timeThreshold time.Time, chainID *big.Int) error {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1995,6 +2291,293 @@ func (o *evmTxStore) Abandon(ctx context.Context, chainID *big.Int, addr common.
 	return err
 }
 
+// AbandonPipelineTaskRunTxs marks the unstarted transactions of the given pipeline task runs as fatally errored with
+// reason, and the callbacks of all of their transactions, batched or not, as completed.
+func (o *evmTxStore) AbandonPipelineTaskRunTxs(ctx context.Context, taskRunIDs []uuid.UUID, reason string, chainID *big.Int) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		_, err := orm.q.ExecContext(ctx, `UPDATE evm.txes SET state = 'fatal_error', nonce = NULL, error = $2, callback_completed = TRUE
+WHERE pipeline_task_run_id = ANY($1) AND state = 'unstarted' AND evm_chain_id = $3`, pq.Array(taskRunIDs), reason, chainID.String())
+		if err != nil {
+			return fmt.Errorf("failed to abandon unstarted transactions of pipeline task runs: %w", err)
+		}
+		_, err = orm.q.ExecContext(ctx, `UPDATE evm.txes SET callback_completed = TRUE
+WHERE pipeline_task_run_id = ANY($1) AND signal_callback = TRUE AND evm_chain_id = $2`, pq.Array(taskRunIDs), chainID.String())
+		if err != nil {
+			return fmt.Errorf("failed to complete callbacks of pipeline task run transactions: %w", err)
+		}
+		_, err = orm.q.ExecContext(ctx, `UPDATE evm.tx_batch_items SET callback_completed = TRUE FROM evm.txes
+WHERE evm.txes.id = evm.tx_batch_items.batch_tx_id AND evm.tx_batch_items.pipeline_task_run_id = ANY($1)
+AND evm.tx_batch_items.signal_callback = TRUE AND evm.txes.evm_chain_id = $2`, pq.Array(taskRunIDs), chainID.String())
+		if err != nil {
+			return fmt.Errorf("failed to complete callbacks of batched pipeline task run transactions: %w", err)
+		}
+		return nil
+	})
+}
+
+// SupersedeTx inserts newTx with its attempt as the in_progress transaction of the key at the nonce of the unconfirmed
+// transaction oldTx, for the Broadcaster to send, and marks oldTx as superseded by it.
+// oldTx keeps its nonce, attempts and callbacks, and stays tracked by the Confirmer until one of the two is mined, at which
+// point SettleSupersededTx settles the other one.
+func (o *evmTxStore) SupersedeTx(ctx context.Context, oldTx *Tx, newTx *Tx, attempt *TxAttempt) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if oldTx.Sequence == nil || newTx.Sequence == nil || *oldTx.Sequence != *newTx.Sequence {
+		return errors.New("superseding transaction must have the nonce of the superseded transaction")
+	}
+	if newTx.State != txmgr.TxInProgress {
+		return pkgerrors.Errorf("superseding transaction must be in_progress, it is %s", newTx.State)
+	}
+	if newTx.SupersedesTxID != nullv4.IntFrom(oldTx.ID) {
+		return errors.New("superseding transaction must reference the superseded transaction")
+	}
+	if newTx.PipelineTaskRunID.Valid || newTx.IdempotencyKey != nil {
+		return errors.New("superseding transaction must not take over the pipeline run or idempotency key before it is mined")
+	}
+	if attempt.State != txmgrtypes.TxAttemptInProgress {
+		return errors.New("attempt state must be in_progress")
+	}
+	if newTx.CreatedAt == (time.Time{}) {
+		newTx.CreatedAt = time.Now()
+	}
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		var dbEtx DbEthTx
+		dbEtx.FromTx(newTx)
+		query, args, err := orm.q.BindNamed(insertIntoEthTxesQuery, &dbEtx)
+		if err != nil {
+			return pkgerrors.Wrap(err, "SupersedeTx failed to BindNamed")
+		}
+		if err = orm.q.GetContext(ctx, &dbEtx, query, args...); err != nil {
+			return pkgerrors.Wrap(err, "SupersedeTx failed to insert evm.tx")
+		}
+		dbEtx.ToTx(newTx)
+
+		attempt.TxID = newTx.ID
+		var dbAttempt DbEthTxAttempt
+		dbAttempt.FromTxAttempt(attempt)
+		query, args, err = orm.q.BindNamed(insertIntoEthTxAttemptsQuery, &dbAttempt)
+		if err != nil {
+			return pkgerrors.Wrap(err, "SupersedeTx failed to BindNamed")
+		}
+		if err = orm.q.GetContext(ctx, &dbAttempt, query, args...); err != nil {
+			return pkgerrors.Wrap(err, "SupersedeTx failed to insert evm.tx_attempt")
+		}
+		dbAttempt.ToTxAttempt(attempt)
+
+		var dbOldEtx DbEthTx
+		err = orm.q.GetContext(ctx, &dbOldEtx, `UPDATE evm.txes SET superseded_by_tx_id = $1, superseded_by_cancel = $2
+WHERE id = $3 AND state = 'unconfirmed' AND superseded_by_tx_id IS NULL RETURNING *`, newTx.ID, oldTx.SupersededByCancel, oldTx.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return pkgerrors.Errorf("SupersedeTx: transaction %d is no longer unconfirmed or was already superseded", oldTx.ID)
+		} else if err != nil {
+			return pkgerrors.Wrap(err, "SupersedeTx failed to update superseded evm.tx")
+		}
+		dbOldEtx.ToTx(oldTx)
+		return nil
+	})
+}
+
+// FindSupersededTxsToSettle returns the transactions still pending of the superseded and superseding pairs of which the other
+// transaction was mined
+func (o *evmTxStore) FindSupersededTxsToSettle(ctx context.Context, chainID *big.Int) (etxs []*Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var dbEtxs []DbEthTx
+	err = o.q.SelectContext(ctx, &dbEtxs, `
+SELECT evm.txes.* FROM evm.txes
+INNER JOIN evm.txes superseding ON superseding.id = evm.txes.superseded_by_tx_id
+WHERE evm.txes.evm_chain_id = $1 AND evm.txes.state IN ('unconfirmed', 'confirmed_missing_receipt') AND superseding.state IN ('confirmed', 'finalized')
+UNION ALL
+SELECT evm.txes.* FROM evm.txes
+INNER JOIN evm.txes superseded ON superseded.id = evm.txes.supersedes_tx_id
+WHERE evm.txes.evm_chain_id = $1 AND evm.txes.state IN ('unconfirmed', 'confirmed_missing_receipt') AND superseded.state IN ('confirmed', 'finalized')
+ORDER BY id ASC`, chainID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find superseded transactions to settle: %w", err)
+	}
+	etxs = make([]*Tx, len(dbEtxs))
+	dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
+	return etxs, nil
+}
+
+// SettleSupersededTx marks as fatally errored a transaction returned by FindSupersededTxsToSettle, releasing its nonce.
+// If it was replaced, its pipeline run, idempotency key and batched transactions pending callback move to its mined
+// replacement, for the callbacks to resume with the receipt of the replacement.
+func (o *evmTxStore) SettleSupersededTx(ctx context.Context, etx *Tx) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var errMsg string
+	switch {
+	case etx.SupersededByTxID.Valid && etx.SupersededByCancel:
+		errMsg = fmt.Sprintf("cancelled by operator, superseded by transaction %d", etx.SupersededByTxID.Int64)
+	case etx.SupersededByTxID.Valid:
+		errMsg = fmt.Sprintf("replaced by operator, superseded by transaction %d", etx.SupersededByTxID.Int64)
+	case etx.SupersedesTxID.Valid:
+		errMsg = fmt.Sprintf("superseded transaction %d was mined first", etx.SupersedesTxID.Int64)
+	default:
+		return pkgerrors.Errorf("SettleSupersededTx: transaction %d neither supersedes nor is superseded", etx.ID)
+	}
+	takeOver := etx.SupersededByTxID.Valid && !etx.SupersededByCancel
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		// Release the unique keys moving to the replacement first
+		var dbEtx DbEthTx
+		err := orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state = 'fatal_error', nonce = NULL, error = $1,
+pipeline_task_run_id = CASE WHEN $2 THEN NULL ELSE pipeline_task_run_id END,
+idempotency_key = CASE WHEN $2 THEN NULL ELSE idempotency_key END
+WHERE id = $3 AND state IN ('unconfirmed', 'confirmed_missing_receipt') RETURNING *`, errMsg, takeOver, etx.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return pkgerrors.Errorf("SettleSupersededTx: transaction %d is no longer pending", etx.ID)
+		} else if err != nil {
+			return pkgerrors.Wrap(err, "SettleSupersededTx failed to update evm.tx")
+		}
+		if takeOver {
+			if _, err = orm.q.ExecContext(ctx, `UPDATE evm.txes SET pipeline_task_run_id = $1, idempotency_key = $2 WHERE id = $3`,
+				etx.PipelineTaskRunID, etx.IdempotencyKey, etx.SupersededByTxID.Int64); err != nil {
+				return pkgerrors.Wrap(err, "SettleSupersededTx failed to update replacement evm.tx")
+			}
+			if _, err = orm.q.ExecContext(ctx, `UPDATE evm.tx_batch_items SET batch_tx_id = $1 WHERE batch_tx_id = $2 AND callback_completed = FALSE`,
+				etx.SupersededByTxID.Int64, etx.ID); err != nil {
+				return pkgerrors.Wrap(err, "SettleSupersededTx failed to move batched transactions")
+			}
+		}
+		dbEtx.ToTx(etx)
+		return nil
+	})
+}
+
+// Directly maps to columns of database table "evm.tx_status_events", along with the hash of the transaction.
+type dbTxStatusEvent struct {
+	ID               int64
+	TxID             int64
+	EVMChainID       ubig.Big
+	IdempotencyKey   string
+	FromAddress      common.Address
+	State            txmgrtypes.TxState
+	Error            nullv4.String
+	TxHash           *common.Hash
+	CreatedAt        time.Time
+	DeliveryAttempts uint32
+	DeliverAfter     time.Time
+	DeliveredAt      *time.Time
+}
+
+func (db dbTxStatusEvent) toTxStatusEvent() TxStatusEvent {
+	return TxStatusEvent{
+		ID:               db.ID,
+		TxID:             db.TxID,
+		ChainID:          db.EVMChainID.ToInt(),
+		IdempotencyKey:   db.IdempotencyKey,
+		FromAddress:      db.FromAddress,
+		State:            db.State,
+		Error:            db.Error,
+		TxHash:           db.TxHash,
+		CreatedAt:        db.CreatedAt,
+		DeliveryAttempts: db.DeliveryAttempts,
+		DeliverAfter:     db.DeliverAfter,
+	}
+}
+
+// selectTxStatusEventsQuery selects the events along with the hash of the attempt of their transaction which was mined,
+// or else of its latest sent attempt
+const selectTxStatusEventsQuery = `
+SELECT evm.tx_status_events.*, (
+	SELECT evm.tx_attempts.hash FROM evm.tx_attempts
+	LEFT JOIN evm.receipts ON evm.receipts.tx_hash = evm.tx_attempts.hash
+	WHERE evm.tx_attempts.eth_tx_id = evm.tx_status_events.tx_id AND evm.tx_attempts.state <> 'in_progress'
+	ORDER BY evm.receipts.id IS NULL, evm.tx_attempts.id DESC
+	LIMIT 1
+) AS tx_hash
+FROM evm.tx_status_events`
+
+func (o *evmTxStore) FindLatestTxStatusEventID(ctx context.Context, chainID *big.Int) (id int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.q.GetContext(ctx, &id, `SELECT COALESCE(MAX(id), 0) FROM evm.tx_status_events WHERE evm_chain_id = $1`, chainID.String())
+	if err != nil {
+		return 0, fmt.Errorf("failed to find latest transaction status event: %w", err)
+	}
+	return
+}
+
+func (o *evmTxStore) FindTxStatusEventsAfter(ctx context.Context, chainID *big.Int, afterID int64, limit int) ([]TxStatusEvent, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var dbEvents []dbTxStatusEvent
+	err := o.q.SelectContext(ctx, &dbEvents, selectTxStatusEventsQuery+`
+WHERE evm.tx_status_events.evm_chain_id = $1 AND evm.tx_status_events.id > $2
+ORDER BY evm.tx_status_events.id ASC
+LIMIT $3`, chainID.String(), afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction status events: %w", err)
+	}
+	return dbTxStatusEventsToTxStatusEvents(dbEvents), nil
+}
+
+func (o *evmTxStore) FindTxStatusEventsToDeliver(ctx context.Context, chainID *big.Int, maxAttempts uint32, limit int) ([]TxStatusEvent, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var dbEvents []dbTxStatusEvent
+	err := o.q.SelectContext(ctx, &dbEvents, selectTxStatusEventsQuery+`
+WHERE evm.tx_status_events.evm_chain_id = $1 AND evm.tx_status_events.delivered_at IS NULL AND evm.tx_status_events.delivery_attempts < $2
+ORDER BY evm.tx_status_events.id ASC
+LIMIT $3`, chainID.String(), maxAttempts, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction status events to deliver: %w", err)
+	}
+	return dbTxStatusEventsToTxStatusEvents(dbEvents), nil
+}
+
+func (o *evmTxStore) ReapTxStatusEvents(ctx context.Context, timeThreshold time.Time, maxAttempts uint32, chainID *big.Int) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	_, err := o.q.ExecContext(ctx, `
+DELETE FROM evm.tx_status_events
+WHERE evm_chain_id = $1 AND (delivered_at < $2 OR (delivered_at IS NULL AND delivery_attempts >= $3 AND created_at < $2))`,
+		chainID.String(), timeThreshold, maxAttempts)
+	if err != nil {
+		return fmt.Errorf("failed to reap transaction status events: %w", err)
+	}
+	return nil
+}
+
+func dbTxStatusEventsToTxStatusEvents(dbEvents []dbTxStatusEvent) []TxStatusEvent {
+	events := make([]TxStatusEvent, len(dbEvents))
+	for i, dbEvent := range dbEvents {
+		events[i] = dbEvent.toTxStatusEvent()
+	}
+	return events
+}
+
+func (o *evmTxStore) MarkTxStatusEventDelivered(ctx context.Context, id int64) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if _, err := o.q.ExecContext(ctx, `UPDATE evm.tx_status_events SET delivered_at = NOW() WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to mark transaction status event delivered: %w", err)
+	}
+	return nil
+}
+
+func (o *evmTxStore) UpdateTxStatusEventDeliveryFailed(ctx context.Context, id int64, deliverAfter time.Time) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if _, err := o.q.ExecContext(ctx, `UPDATE evm.tx_status_events SET delivery_attempts = delivery_attempts + 1, deliver_after = $2 WHERE id = $1`, id, deliverAfter); err != nil {
+		return fmt.Errorf("failed to update transaction status event delivery: %w", err)
+	}
+	return nil
+}
+
 // Find transactions by a field in the TxMeta blob and transaction states
 func (o *evmTxStore) FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) ([]*Tx, error) {
 	var cancel context.CancelFunc
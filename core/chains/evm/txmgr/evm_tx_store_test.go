@@ -1269,6 +1269,32 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, resultEtx)
 	})
+
+	t.Run("finds unstarted tx of the highest priority first", func(t *testing.T) {
+		_, otherAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		request := func(priority txmgrtypes.TxPriority) txmgr.TxRequest {
+			return txmgr.TxRequest{
+				FromAddress:    otherAddress,
+				ToAddress:      testutils.NewAddress(),
+				EncodedPayload: []byte{1, 2, 3},
+				FeeLimit:       uint64(1000000000),
+				Strategy:       txmgrcommon.NewSendEveryStrategy(),
+				Priority:       priority,
+			}
+		}
+		bulkTx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, request(txmgrtypes.TxPriorityBulk), testutils.FixtureChainID)
+		normalTx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, request(txmgrtypes.TxPriorityNormal), testutils.FixtureChainID)
+		criticalTx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, request(txmgrtypes.TxPriorityCritical), testutils.FixtureChainID)
+
+		for _, expected := range []txmgr.Tx{criticalTx, normalTx, bulkTx} {
+			resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), otherAddress, ethClient.ConfiguredChainID())
+			require.NoError(t, err)
+			assert.Equal(t, expected.ID, resultEtx.ID)
+			assert.Equal(t, expected.Priority, resultEtx.Priority)
+			resultEtx.Error = null.StringFrom("sent")
+			require.NoError(t, txStore.UpdateTxFatalError(tests.Context(t), resultEtx))
+		}
+	})
 }
 
 func TestORM_UpdateTxFatalError(t *testing.T) {
@@ -1382,7 +1408,7 @@ func TestORM_UpdateTxUnstartedToInProgress(t *testing.T) {
 		ccfg := evmtest.NewChainScopedConfig(t, evmCfg)
 		evmTxmCfg := txmgr.NewEvmTxmConfig(ccfg.EVM())
 		ec := evmtest.NewEthClientMockWithDefaultChain(t)
-		txMgr := txmgr.NewEvmTxm(ec.ConfiguredChainID(), evmTxmCfg, ccfg.EVM().Transactions(), nil, logger.Test(t), nil, nil,
+		txMgr := txmgr.NewEvmTxm(ec.ConfiguredChainID(), evmTxmCfg, txmgr.NewEvmTxmTxConfig(ccfg.EVM().Transactions()), nil, logger.Test(t), nil, nil,
 			nil, txStore, nil, nil, nil, nil, nil)
 		err := txMgr.XXXTestAbandon(fromAddress) // mark transaction as abandoned
 		require.NoError(t, err)
@@ -1437,6 +1463,233 @@ func TestORM_GetTxInProgress(t *testing.T) {
 	})
 }
 
+func TestORM_SupersedeTx(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	// supersede inserts a superseding transaction of oldTx and broadcasts it, leaving no transaction in progress
+	supersede := func(t *testing.T, oldTx *txmgr.Tx, cancel bool) txmgr.Tx {
+		newTx := *oldTx
+		newTx.ID = 0
+		newTx.TxAttempts = nil
+		newTx.State = txmgrcommon.TxInProgress
+		newTx.EncodedPayload = []byte{4, 5, 6}
+		newTx.PipelineTaskRunID = uuid.NullUUID{}
+		newTx.IdempotencyKey = nil
+		newTx.SupersedesTxID = null.IntFrom(oldTx.ID)
+		attempt := cltest.NewLegacyEthTxAttempt(t, 0)
+		oldTx.SupersededByCancel = cancel
+
+		require.NoError(t, txStore.SupersedeTx(ctx, oldTx, &newTx, &attempt))
+		assert.Equal(t, newTx.ID, attempt.TxID)
+		require.NoError(t, txStore.UpdateTxAttemptInProgressToBroadcast(ctx, &newTx, attempt, txmgrtypes.TxAttemptBroadcast))
+		return newTx
+	}
+	mustConfirm := func(t *testing.T, etx txmgr.Tx) {
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET state = 'confirmed' WHERE id = $1`, etx.ID)
+	}
+
+	t.Run("supersedes unconfirmed transaction", func(t *testing.T) {
+		oldTx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+		newTx := supersede(t, &oldTx, false)
+
+		superseded, err := txStore.FindTxWithAttempts(ctx, oldTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, superseded.State)
+		assert.Equal(t, evmtypes.Nonce(1), *superseded.Sequence)
+		assert.Equal(t, null.IntFrom(newTx.ID), superseded.SupersededByTxID)
+		assert.False(t, superseded.SupersededByCancel)
+		assert.Len(t, superseded.TxAttempts, 1)
+
+		superseding, err := txStore.FindTxWithAttempts(ctx, newTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, superseding.State)
+		assert.Equal(t, evmtypes.Nonce(1), *superseding.Sequence)
+		assert.Equal(t, null.IntFrom(oldTx.ID), superseding.SupersedesTxID)
+		assert.Equal(t, []byte{4, 5, 6}, superseding.EncodedPayload)
+		require.Len(t, superseding.TxAttempts, 1)
+
+		// neither transaction is settled until one of them is mined
+		etxs, err := txStore.FindSupersededTxsToSettle(ctx, testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Empty(t, etxs)
+	})
+
+	t.Run("fails if the transaction is not unconfirmed", func(t *testing.T) {
+		oldTx := mustInsertConfirmedEthTxWithReceipt(t, txStore, fromAddress, 2, 42)
+		newTx := oldTx
+		newTx.ID = 0
+		newTx.TxAttempts = nil
+		newTx.State = txmgrcommon.TxInProgress
+		newTx.SupersedesTxID = null.IntFrom(oldTx.ID)
+		attempt := cltest.NewLegacyEthTxAttempt(t, 0)
+
+		err := txStore.SupersedeTx(ctx, &oldTx, &newTx, &attempt)
+		require.ErrorContains(t, err, fmt.Sprintf("transaction %d is no longer unconfirmed or was already superseded", oldTx.ID))
+
+		inProgress, err := txStore.GetTxInProgress(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.Nil(t, inProgress)
+	})
+
+	t.Run("settles cancelled transaction once the cancellation is mined", func(t *testing.T) {
+		oldTx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+		newTx := supersede(t, &oldTx, true)
+		mustConfirm(t, newTx)
+
+		etxs, err := txStore.FindSupersededTxsToSettle(ctx, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, etxs, 1)
+		assert.Equal(t, oldTx.ID, etxs[0].ID)
+		assert.True(t, etxs[0].SupersededByCancel)
+
+		require.NoError(t, txStore.SettleSupersededTx(ctx, etxs[0]))
+		settled, err := txStore.FindTxWithAttempts(ctx, oldTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, settled.State)
+		assert.Equal(t, fmt.Sprintf("cancelled by operator, superseded by transaction %d", newTx.ID), settled.Error.String)
+		assert.Nil(t, settled.Sequence)
+
+		etxs, err = txStore.FindSupersededTxsToSettle(ctx, testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Empty(t, etxs)
+	})
+
+	t.Run("moves pipeline run and idempotency key to replacement once it is mined", func(t *testing.T) {
+		oldTx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 4, fromAddress)
+		taskRunID := uuid.New()
+		idempotencyKey := uuid.New().String()
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET pipeline_task_run_id = $1, idempotency_key = $2 WHERE id = $3`, taskRunID, idempotencyKey, oldTx.ID)
+		oldTx.PipelineTaskRunID = uuid.NullUUID{UUID: taskRunID, Valid: true}
+		oldTx.IdempotencyKey = &idempotencyKey
+		newTx := supersede(t, &oldTx, false)
+		mustConfirm(t, newTx)
+
+		etxs, err := txStore.FindSupersededTxsToSettle(ctx, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, etxs, 1)
+		require.NoError(t, txStore.SettleSupersededTx(ctx, etxs[0]))
+
+		replaced, err := txStore.FindTxWithAttempts(ctx, oldTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, replaced.State)
+		assert.Equal(t, fmt.Sprintf("replaced by operator, superseded by transaction %d", newTx.ID), replaced.Error.String)
+		assert.False(t, replaced.PipelineTaskRunID.Valid)
+		assert.Nil(t, replaced.IdempotencyKey)
+
+		replacement, err := txStore.FindTxWithAttempts(ctx, newTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxConfirmed, replacement.State)
+		assert.Equal(t, oldTx.PipelineTaskRunID, replacement.PipelineTaskRunID)
+		require.NotNil(t, replacement.IdempotencyKey)
+		assert.Equal(t, idempotencyKey, *replacement.IdempotencyKey)
+	})
+
+	t.Run("settles superseding transaction if the superseded transaction is mined first", func(t *testing.T) {
+		oldTx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 5, fromAddress)
+		newTx := supersede(t, &oldTx, true)
+		mustConfirm(t, oldTx)
+
+		etxs, err := txStore.FindSupersededTxsToSettle(ctx, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, etxs, 1)
+		assert.Equal(t, newTx.ID, etxs[0].ID)
+
+		require.NoError(t, txStore.SettleSupersededTx(ctx, etxs[0]))
+		settled, err := txStore.FindTxWithAttempts(ctx, newTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, settled.State)
+		assert.Equal(t, fmt.Sprintf("superseded transaction %d was mined first", oldTx.ID), settled.Error.String)
+		assert.Nil(t, settled.Sequence)
+	})
+}
+
+func TestORM_TxStatusEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	chainID := big.NewInt(0)
+
+	latestID, err := txStore.FindLatestTxStatusEventID(ctx, chainID)
+	require.NoError(t, err)
+
+	// only the transactions with an idempotency key are reported
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, chainID)
+	etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, chainID, txRequestWithIdempotencyKey("status-events"))
+	_, err = db.ExecContext(ctx, "UPDATE evm.txes SET state = 'fatal_error', error = 'boom' WHERE id = $1", etx.ID)
+	require.NoError(t, err)
+
+	events, err := txStore.FindTxStatusEventsAfter(ctx, chainID, latestID, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, etx.ID, events[0].TxID)
+	assert.Equal(t, "status-events", events[0].IdempotencyKey)
+	assert.Equal(t, fromAddress, events[0].FromAddress)
+	assert.Equal(t, txmgrcommon.TxUnstarted, events[0].State)
+	assert.Equal(t, txmgrcommon.TxFatalError, events[1].State)
+	assert.Equal(t, "boom", events[1].Error.String)
+
+	newLatestID, err := txStore.FindLatestTxStatusEventID(ctx, chainID)
+	require.NoError(t, err)
+	assert.Equal(t, events[1].ID, newLatestID)
+
+	t.Run("tracks the deliveries", func(t *testing.T) {
+		require.NoError(t, txStore.MarkTxStatusEventDelivered(ctx, events[0].ID))
+		deliverAfter := time.Now().Add(time.Hour)
+		require.NoError(t, txStore.UpdateTxStatusEventDeliveryFailed(ctx, events[1].ID, deliverAfter))
+
+		toDeliver, err := txStore.FindTxStatusEventsToDeliver(ctx, chainID, 3, 10)
+		require.NoError(t, err)
+		require.Len(t, toDeliver, 1)
+		assert.Equal(t, events[1].ID, toDeliver[0].ID)
+		assert.Equal(t, uint32(1), toDeliver[0].DeliveryAttempts)
+		assert.WithinDuration(t, deliverAfter, toDeliver[0].DeliverAfter, time.Second)
+
+		// the events are given up on after the maximum number of attempts
+		toDeliver, err = txStore.FindTxStatusEventsToDeliver(ctx, chainID, 1, 10)
+		require.NoError(t, err)
+		assert.Empty(t, toDeliver)
+	})
+
+	t.Run("keeps the events of deleted transactions", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "DELETE FROM evm.txes WHERE id = $1", etx.ID)
+		require.NoError(t, err)
+
+		remaining, err := txStore.FindTxStatusEventsAfter(ctx, chainID, latestID, 10)
+		require.NoError(t, err)
+		assert.Len(t, remaining, 2)
+	})
+
+	t.Run("reaps the events done with", func(t *testing.T) {
+		// only the delivered event is done with while the other one can be retried
+		require.NoError(t, txStore.ReapTxStatusEvents(ctx, time.Now().Add(time.Minute), 3, chainID))
+		remaining, err := txStore.FindTxStatusEventsAfter(ctx, chainID, latestID, 10)
+		require.NoError(t, err)
+		require.Len(t, remaining, 1)
+		assert.Equal(t, events[1].ID, remaining[0].ID)
+
+		// nor are events reaped before the threshold
+		require.NoError(t, txStore.ReapTxStatusEvents(ctx, time.Now().Add(-time.Minute), 1, chainID))
+		remaining, err = txStore.FindTxStatusEventsAfter(ctx, chainID, latestID, 10)
+		require.NoError(t, err)
+		require.Len(t, remaining, 1)
+
+		require.NoError(t, txStore.ReapTxStatusEvents(ctx, time.Now().Add(time.Minute), 1, chainID))
+		remaining, err = txStore.FindTxStatusEventsAfter(ctx, chainID, latestID, 10)
+		require.NoError(t, err)
+		assert.Empty(t, remaining)
+	})
+}
+
 func TestORM_GetAbandonedTransactionsByBatch(t *testing.T) {
 	t.Parallel()
 
@@ -1717,6 +1970,49 @@ func TestORM_CheckTxQueueCapacity(t *testing.T) {
 	})
 }
 
+func TestORM_CheckTxQueueLaneCapacity(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	var maxQueuedTransactions uint64 = 2
+
+	request := func(priority txmgrtypes.TxPriority) txmgr.TxRequest {
+		return txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       uint64(1000000000),
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			Priority:       priority,
+		}
+	}
+	for i := 0; i < int(maxQueuedTransactions); i++ {
+		mustCreateUnstartedTxFromEvmTxRequest(t, txStore, request(txmgrtypes.TxPriorityBulk), testutils.FixtureChainID)
+	}
+
+	t.Run("with the lane full returns error", func(t *testing.T) {
+		err := txStore.CheckTxQueueLaneCapacity(tests.Context(t), fromAddress, txmgrtypes.TxPriorityBulk, maxQueuedTransactions, testutils.FixtureChainID)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), fmt.Sprintf("cannot create transaction; too many unstarted bulk transactions in the queue (2/%d)", maxQueuedTransactions))
+	})
+
+	t.Run("with other lanes full returns nil", func(t *testing.T) {
+		err := txStore.CheckTxQueueLaneCapacity(tests.Context(t), fromAddress, txmgrtypes.TxPriorityCritical, maxQueuedTransactions, testutils.FixtureChainID)
+		require.NoError(t, err)
+		err = txStore.CheckTxQueueLaneCapacity(tests.Context(t), fromAddress, txmgrtypes.TxPriorityNormal, maxQueuedTransactions, testutils.FixtureChainID)
+		require.NoError(t, err)
+	})
+
+	t.Run("disables check with 0 limit", func(t *testing.T) {
+		err := txStore.CheckTxQueueLaneCapacity(tests.Context(t), fromAddress, txmgrtypes.TxPriorityBulk, 0, testutils.FixtureChainID)
+		require.NoError(t, err)
+	})
+}
+
 func TestORM_CreateTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -1844,6 +2140,201 @@ func TestORM_PruneUnstartedTxQueue(t *testing.T) {
 	})
 }
 
+func TestORM_BatchUnstartedTxQueue(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ctx := tests.Context(t)
+
+	findBatches := func(t *testing.T) (batches []*txmgr.Tx) {
+		txes, err := txStore.GetAllTxes(ctx)
+		require.NoError(t, err)
+		for _, tx := range txes {
+			if tx.ToAddress == txmgr.Multicall3Address {
+				batches = append(batches, tx)
+			}
+		}
+		return
+	}
+
+	t.Run("merges the unstarted transactions of the subject into batches", func(t *testing.T) {
+		subject := uuid.New()
+		strategy := txmgrcommon.NewDropOldestStrategy(subject, 10)
+		for i := 0; i < 5; i++ {
+			mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(strategy))
+		}
+		// transactions with a checker are sent on their own
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(strategy),
+			txRequestWithChecker(txmgr.TransmitCheckerSpec{CheckerType: txmgr.TransmitCheckerTypeSimulate}))
+
+		ids, err := txStore.BatchUnstartedTxQueue(ctx, 2, subject)
+		require.NoError(t, err)
+		assert.Len(t, ids, 4)
+		// 2 batches, the last transaction and the one with a checker
+		AssertCountPerSubject(t, txStore, int64(4), subject)
+
+		batches := findBatches(t)
+		require.Len(t, batches, 2)
+		for _, batch := range batches {
+			assert.Equal(t, fromAddress, batch.FromAddress)
+			assert.Equal(t, big.NewInt(2*142), &batch.Value)
+			assert.Equal(t, uint64(2*1000000000), batch.FeeLimit)
+			// aggregate3Value((address,bool,uint256,bytes)[])
+			assert.Equal(t, []byte{0x17, 0x4d, 0xea, 0x71}, batch.EncodedPayload[:4])
+		}
+
+		// batches are never merged again, and the remaining transaction has nothing to be merged with
+		ids, err = txStore.BatchUnstartedTxQueue(ctx, 2, subject)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+		AssertCountPerSubject(t, txStore, int64(4), subject)
+		pgtest.MustExec(t, db, `DELETE FROM evm.txes WHERE subject = $1`, subject)
+	})
+
+	t.Run("resumes the task runs of the merged transactions with the receipt of the batch", func(t *testing.T) {
+		pgtest.MustExec(t, db, `SET CONSTRAINTS fk_pipeline_runs_pruning_key DEFERRED`)
+		pgtest.MustExec(t, db, `SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`)
+
+		subject := uuid.New()
+		strategy := txmgrcommon.NewDropOldestStrategy(subject, 10)
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr1 := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		tr2 := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		for _, tr := range []pipeline.TaskRun{tr1, tr2} {
+			mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(strategy), func(tx *txmgr.TxRequest) {
+				tx.PipelineTaskRunID = &tr.ID
+				tx.SignalCallback = true
+			})
+		}
+
+		ids, err := txStore.BatchUnstartedTxQueue(ctx, 10, subject)
+		require.NoError(t, err)
+		require.Len(t, ids, 2)
+		batches := findBatches(t)
+		require.Len(t, batches, 1)
+		batch := batches[0]
+
+		taskRunIDs, err := txStore.FindBatchedTaskRunIDsPendingCallback(ctx, batch.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{tr1.ID, tr2.ID}, taskRunIDs)
+
+		// the transaction of a task run isn't created again once merged
+		tx, err := txStore.CreateTransaction(ctx, txmgr.TxRequest{FromAddress: fromAddress, PipelineTaskRunID: &tr1.ID, Strategy: strategy}, testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, batch.ID, tx.ID)
+
+		attempt := cltest.NewLegacyEthTxAttempt(t, batch.ID)
+		attempt.State = txmgrtypes.TxAttemptBroadcast
+		require.NoError(t, txStore.InsertTxAttempt(ctx, &attempt))
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET state = 'confirmed', nonce = 0, broadcast_at = NOW(), initial_broadcast_at = NOW() WHERE id = $1`, batch.ID)
+		mustInsertEthReceipt(t, txStore, 1, utils.NewHash(), attempt.Hash)
+
+		// the task runs wait for the outcomes of their calls
+		receiptsPlus, err := txStore.FindTxesPendingCallback(ctx, 10, 1, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Empty(t, receiptsPlus)
+
+		etxs, err := txStore.FindBatchTxsPendingCallOutcomes(ctx, 10, 1, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, etxs, 1)
+		assert.Equal(t, batch.ID, etxs[0].ID)
+		require.Len(t, etxs[0].TxAttempts, 1)
+		require.Len(t, etxs[0].TxAttempts[0].Receipts, 1)
+		assert.Equal(t, attempt.Hash, etxs[0].TxAttempts[0].Receipts[0].GetTxHash())
+
+		require.ErrorContains(t, txStore.SetBatchCallOutcomes(ctx, batch.ID, []bool{true}), "has 2 calls, got 1 outcomes")
+		require.NoError(t, txStore.SetBatchCallOutcomes(ctx, batch.ID, []bool{true, false}))
+		etxs, err = txStore.FindBatchTxsPendingCallOutcomes(ctx, 10, 1, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Empty(t, etxs)
+
+		// each task run is resumed with the outcome of its own call
+		receiptsPlus, err = txStore.FindTxesPendingCallback(ctx, 10, 1, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, receiptsPlus, 2)
+		statuses := make(map[uuid.UUID]uint64)
+		for _, receiptPlus := range receiptsPlus {
+			assert.Equal(t, attempt.Hash, receiptPlus.Receipt.TxHash)
+			statuses[receiptPlus.ID] = receiptPlus.Receipt.GetStatus()
+		}
+		assert.Equal(t, map[uuid.UUID]uint64{tr1.ID: 1, tr2.ID: 0}, statuses)
+
+		require.NoError(t, txStore.UpdateTxCallbackCompleted(ctx, tr1.ID, testutils.FixtureChainID))
+		receiptsPlus, err = txStore.FindTxesPendingCallback(ctx, 10, 1, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, receiptsPlus, 1)
+		assert.Equal(t, tr2.ID, receiptsPlus[0].ID)
+
+		taskRunIDs, err = txStore.FindBatchedTaskRunIDsPendingCallback(ctx, batch.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{tr2.ID}, taskRunIDs)
+	})
+}
+
+func TestORM_AbandonPipelineTaskRunTxs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ctx := tests.Context(t)
+
+	pgtest.MustExec(t, db, `SET CONSTRAINTS fk_pipeline_runs_pruning_key DEFERRED`)
+	pgtest.MustExec(t, db, `SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`)
+	run := cltest.MustInsertPipelineRun(t, db)
+	withTaskRun := func(tr pipeline.TaskRun) func(*txmgr.TxRequest) {
+		return func(tx *txmgr.TxRequest) {
+			tx.PipelineTaskRunID = &tr.ID
+			tx.SignalCallback = true
+		}
+	}
+
+	// two task runs with their transactions merged into a batch
+	subject := uuid.New()
+	strategy := txmgrcommon.NewDropOldestStrategy(subject, 10)
+	batchedTr1 := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+	batchedTr2 := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+	for _, tr := range []pipeline.TaskRun{batchedTr1, batchedTr2} {
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(strategy), withTaskRun(tr))
+	}
+	ids, err := txStore.BatchUnstartedTxQueue(ctx, 10, subject)
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+	var batchID int64
+	require.NoError(t, db.GetContext(ctx, &batchID, `SELECT id FROM evm.txes WHERE subject = $1`, subject))
+
+	// two task runs with their own transactions
+	tr1 := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+	etx1 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, withTaskRun(tr1))
+	tr2 := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+	etx2 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, withTaskRun(tr2))
+
+	require.NoError(t, txStore.AbandonPipelineTaskRunTxs(ctx, []uuid.UUID{batchedTr1.ID, tr1.ID}, "run cancelled", testutils.FixtureChainID))
+
+	etx, err := txStore.FindTxWithAttempts(ctx, etx1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+	assert.Equal(t, "run cancelled", etx.Error.String)
+	assert.True(t, etx.CallbackCompleted)
+
+	etx, err = txStore.FindTxWithAttempts(ctx, etx2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnstarted, etx.State)
+	assert.False(t, etx.CallbackCompleted)
+
+	// the batch is still sent for the other task run
+	etx, err = txStore.FindTxWithAttempts(ctx, batchID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnstarted, etx.State)
+	taskRunIDs, err := txStore.FindBatchedTaskRunIDsPendingCallback(ctx, batchID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{batchedTr2.ID}, taskRunIDs)
+}
+
 func TestORM_FindTxesWithAttemptsAndReceiptsByIdsAndState(t *testing.T) {
 	t.Parallel()
 
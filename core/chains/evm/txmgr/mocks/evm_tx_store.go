@@ -86,6 +86,115 @@ func (_c *EvmTxStore_Abandon_Call) RunAndReturn(run func(context.Context, *big.I
 	return _c
 }
 
+// AbandonPipelineTaskRunTxs provides a mock function with given fields: ctx, taskRunIDs, reason, chainID
+func (_m *EvmTxStore) AbandonPipelineTaskRunTxs(ctx context.Context, taskRunIDs []uuid.UUID, reason string, chainID *big.Int) error {
+	ret := _m.Called(ctx, taskRunIDs, reason, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbandonPipelineTaskRunTxs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, string, *big.Int) error); ok {
+		r0 = rf(ctx, taskRunIDs, reason, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_AbandonPipelineTaskRunTxs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AbandonPipelineTaskRunTxs'
+type EvmTxStore_AbandonPipelineTaskRunTxs_Call struct {
+	*mock.Call
+}
+
+// AbandonPipelineTaskRunTxs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskRunIDs []uuid.UUID
+//   - reason string
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) AbandonPipelineTaskRunTxs(ctx interface{}, taskRunIDs interface{}, reason interface{}, chainID interface{}) *EvmTxStore_AbandonPipelineTaskRunTxs_Call {
+	return &EvmTxStore_AbandonPipelineTaskRunTxs_Call{Call: _e.mock.On("AbandonPipelineTaskRunTxs", ctx, taskRunIDs, reason, chainID)}
+}
+
+func (_c *EvmTxStore_AbandonPipelineTaskRunTxs_Call) Run(run func(ctx context.Context, taskRunIDs []uuid.UUID, reason string, chainID *big.Int)) *EvmTxStore_AbandonPipelineTaskRunTxs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(string), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_AbandonPipelineTaskRunTxs_Call) Return(_a0 error) *EvmTxStore_AbandonPipelineTaskRunTxs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_AbandonPipelineTaskRunTxs_Call) RunAndReturn(run func(context.Context, []uuid.UUID, string, *big.Int) error) *EvmTxStore_AbandonPipelineTaskRunTxs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchUnstartedTxQueue provides a mock function with given fields: ctx, batchSize, subject
+func (_m *EvmTxStore) BatchUnstartedTxQueue(ctx context.Context, batchSize uint32, subject uuid.UUID) ([]int64, error) {
+	ret := _m.Called(ctx, batchSize, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchUnstartedTxQueue")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uuid.UUID) ([]int64, error)); ok {
+		return rf(ctx, batchSize, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uuid.UUID) []int64); ok {
+		r0 = rf(ctx, batchSize, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uuid.UUID) error); ok {
+		r1 = rf(ctx, batchSize, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_BatchUnstartedTxQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchUnstartedTxQueue'
+type EvmTxStore_BatchUnstartedTxQueue_Call struct {
+	*mock.Call
+}
+
+// BatchUnstartedTxQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchSize uint32
+//   - subject uuid.UUID
+func (_e *EvmTxStore_Expecter) BatchUnstartedTxQueue(ctx interface{}, batchSize interface{}, subject interface{}) *EvmTxStore_BatchUnstartedTxQueue_Call {
+	return &EvmTxStore_BatchUnstartedTxQueue_Call{Call: _e.mock.On("BatchUnstartedTxQueue", ctx, batchSize, subject)}
+}
+
+func (_c *EvmTxStore_BatchUnstartedTxQueue_Call) Run(run func(ctx context.Context, batchSize uint32, subject uuid.UUID)) *EvmTxStore_BatchUnstartedTxQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_BatchUnstartedTxQueue_Call) Return(ids []int64, err error) *EvmTxStore_BatchUnstartedTxQueue_Call {
+	_c.Call.Return(ids, err)
+	return _c
+}
+
+func (_c *EvmTxStore_BatchUnstartedTxQueue_Call) RunAndReturn(run func(context.Context, uint32, uuid.UUID) ([]int64, error)) *EvmTxStore_BatchUnstartedTxQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckTxQueueCapacity provides a mock function with given fields: ctx, fromAddress, maxQueuedTransactions, chainID
 func (_m *EvmTxStore) CheckTxQueueCapacity(ctx context.Context, fromAddress common.Address, maxQueuedTransactions uint64, chainID *big.Int) error {
 	ret := _m.Called(ctx, fromAddress, maxQueuedTransactions, chainID)
@@ -135,6 +244,56 @@ func (_c *EvmTxStore_CheckTxQueueCapacity_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// CheckTxQueueLaneCapacity provides a mock function with given fields: ctx, fromAddress, priority, maxQueuedTransactions, chainID
+func (_m *EvmTxStore) CheckTxQueueLaneCapacity(ctx context.Context, fromAddress common.Address, priority types.TxPriority, maxQueuedTransactions uint64, chainID *big.Int) error {
+	ret := _m.Called(ctx, fromAddress, priority, maxQueuedTransactions, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckTxQueueLaneCapacity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, types.TxPriority, uint64, *big.Int) error); ok {
+		r0 = rf(ctx, fromAddress, priority, maxQueuedTransactions, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_CheckTxQueueLaneCapacity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckTxQueueLaneCapacity'
+type EvmTxStore_CheckTxQueueLaneCapacity_Call struct {
+	*mock.Call
+}
+
+// CheckTxQueueLaneCapacity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - priority types.TxPriority
+//   - maxQueuedTransactions uint64
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) CheckTxQueueLaneCapacity(ctx interface{}, fromAddress interface{}, priority interface{}, maxQueuedTransactions interface{}, chainID interface{}) *EvmTxStore_CheckTxQueueLaneCapacity_Call {
+	return &EvmTxStore_CheckTxQueueLaneCapacity_Call{Call: _e.mock.On("CheckTxQueueLaneCapacity", ctx, fromAddress, priority, maxQueuedTransactions, chainID)}
+}
+
+func (_c *EvmTxStore_CheckTxQueueLaneCapacity_Call) Run(run func(ctx context.Context, fromAddress common.Address, priority types.TxPriority, maxQueuedTransactions uint64, chainID *big.Int)) *EvmTxStore_CheckTxQueueLaneCapacity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(types.TxPriority), args[3].(uint64), args[4].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_CheckTxQueueLaneCapacity_Call) Return(err error) *EvmTxStore_CheckTxQueueLaneCapacity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EvmTxStore_CheckTxQueueLaneCapacity_Call) RunAndReturn(run func(context.Context, common.Address, types.TxPriority, uint64, *big.Int) error) *EvmTxStore_CheckTxQueueLaneCapacity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *EvmTxStore) Close() {
 	_m.Called()
@@ -446,6 +605,126 @@ func (_c *EvmTxStore_DeleteInProgressAttempt_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// FindBatchTxsPendingCallOutcomes provides a mock function with given fields: ctx, latest, finalized, chainID
+func (_m *EvmTxStore) FindBatchTxsPendingCallOutcomes(ctx context.Context, latest int64, finalized int64, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, latest, finalized, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBatchTxsPendingCallOutcomes")
+	}
+
+	var r0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, latest, finalized, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *big.Int) []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, latest, finalized, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, *big.Int) error); ok {
+		r1 = rf(ctx, latest, finalized, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindBatchTxsPendingCallOutcomes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBatchTxsPendingCallOutcomes'
+type EvmTxStore_FindBatchTxsPendingCallOutcomes_Call struct {
+	*mock.Call
+}
+
+// FindBatchTxsPendingCallOutcomes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - latest int64
+//   - finalized int64
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindBatchTxsPendingCallOutcomes(ctx interface{}, latest interface{}, finalized interface{}, chainID interface{}) *EvmTxStore_FindBatchTxsPendingCallOutcomes_Call {
+	return &EvmTxStore_FindBatchTxsPendingCallOutcomes_Call{Call: _e.mock.On("FindBatchTxsPendingCallOutcomes", ctx, latest, finalized, chainID)}
+}
+
+func (_c *EvmTxStore_FindBatchTxsPendingCallOutcomes_Call) Run(run func(ctx context.Context, latest int64, finalized int64, chainID *big.Int)) *EvmTxStore_FindBatchTxsPendingCallOutcomes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindBatchTxsPendingCallOutcomes_Call) Return(etxs []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_FindBatchTxsPendingCallOutcomes_Call {
+	_c.Call.Return(etxs, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindBatchTxsPendingCallOutcomes_Call) RunAndReturn(run func(context.Context, int64, int64, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindBatchTxsPendingCallOutcomes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBatchedTaskRunIDsPendingCallback provides a mock function with given fields: ctx, etxID
+func (_m *EvmTxStore) FindBatchedTaskRunIDsPendingCallback(ctx context.Context, etxID int64) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, etxID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBatchedTaskRunIDsPendingCallback")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]uuid.UUID, error)); ok {
+		return rf(ctx, etxID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []uuid.UUID); ok {
+		r0 = rf(ctx, etxID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, etxID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindBatchedTaskRunIDsPendingCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBatchedTaskRunIDsPendingCallback'
+type EvmTxStore_FindBatchedTaskRunIDsPendingCallback_Call struct {
+	*mock.Call
+}
+
+// FindBatchedTaskRunIDsPendingCallback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etxID int64
+func (_e *EvmTxStore_Expecter) FindBatchedTaskRunIDsPendingCallback(ctx interface{}, etxID interface{}) *EvmTxStore_FindBatchedTaskRunIDsPendingCallback_Call {
+	return &EvmTxStore_FindBatchedTaskRunIDsPendingCallback_Call{Call: _e.mock.On("FindBatchedTaskRunIDsPendingCallback", ctx, etxID)}
+}
+
+func (_c *EvmTxStore_FindBatchedTaskRunIDsPendingCallback_Call) Run(run func(ctx context.Context, etxID int64)) *EvmTxStore_FindBatchedTaskRunIDsPendingCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindBatchedTaskRunIDsPendingCallback_Call) Return(ids []uuid.UUID, err error) *EvmTxStore_FindBatchedTaskRunIDsPendingCallback_Call {
+	_c.Call.Return(ids, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindBatchedTaskRunIDsPendingCallback_Call) RunAndReturn(run func(context.Context, int64) ([]uuid.UUID, error)) *EvmTxStore_FindBatchedTaskRunIDsPendingCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindConfirmedTxesReceipts provides a mock function with given fields: ctx, finalizedBlockNum, chainID
 func (_m *EvmTxStore) FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) ([]txmgr.DbReceipt, error) {
 	ret := _m.Called(ctx, finalizedBlockNum, chainID)
@@ -678,6 +957,63 @@ func (_c *EvmTxStore_FindLatestSequence_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// FindLatestTxStatusEventID provides a mock function with given fields: ctx, chainID
+func (_m *EvmTxStore) FindLatestTxStatusEventID(ctx context.Context, chainID *big.Int) (int64, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLatestTxStatusEventID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) (int64, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) int64); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindLatestTxStatusEventID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLatestTxStatusEventID'
+type EvmTxStore_FindLatestTxStatusEventID_Call struct {
+	*mock.Call
+}
+
+// FindLatestTxStatusEventID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindLatestTxStatusEventID(ctx interface{}, chainID interface{}) *EvmTxStore_FindLatestTxStatusEventID_Call {
+	return &EvmTxStore_FindLatestTxStatusEventID_Call{Call: _e.mock.On("FindLatestTxStatusEventID", ctx, chainID)}
+}
+
+func (_c *EvmTxStore_FindLatestTxStatusEventID_Call) Run(run func(ctx context.Context, chainID *big.Int)) *EvmTxStore_FindLatestTxStatusEventID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindLatestTxStatusEventID_Call) Return(id int64, err error) *EvmTxStore_FindLatestTxStatusEventID_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindLatestTxStatusEventID_Call) RunAndReturn(run func(context.Context, *big.Int) (int64, error)) *EvmTxStore_FindLatestTxStatusEventID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindNextUnstartedTransactionFromAddress provides a mock function with given fields: ctx, fromAddress, chainID
 func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, fromAddress, chainID)
@@ -738,6 +1074,65 @@ func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(
 	return _c
 }
 
+// FindSupersededTxsToSettle provides a mock function with given fields: ctx, chainID
+func (_m *EvmTxStore) FindSupersededTxsToSettle(ctx context.Context, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSupersededTxsToSettle")
+	}
+
+	var r0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindSupersededTxsToSettle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSupersededTxsToSettle'
+type EvmTxStore_FindSupersededTxsToSettle_Call struct {
+	*mock.Call
+}
+
+// FindSupersededTxsToSettle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindSupersededTxsToSettle(ctx interface{}, chainID interface{}) *EvmTxStore_FindSupersededTxsToSettle_Call {
+	return &EvmTxStore_FindSupersededTxsToSettle_Call{Call: _e.mock.On("FindSupersededTxsToSettle", ctx, chainID)}
+}
+
+func (_c *EvmTxStore_FindSupersededTxsToSettle_Call) Run(run func(ctx context.Context, chainID *big.Int)) *EvmTxStore_FindSupersededTxsToSettle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindSupersededTxsToSettle_Call) Return(etxs []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_FindSupersededTxsToSettle_Call {
+	_c.Call.Return(etxs, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindSupersededTxsToSettle_Call) RunAndReturn(run func(context.Context, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindSupersededTxsToSettle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTransactionsConfirmedInBlockRange provides a mock function with given fields: ctx, highBlockNumber, lowBlockNumber, chainID
 func (_m *EvmTxStore) FindTransactionsConfirmedInBlockRange(ctx context.Context, highBlockNumber int64, lowBlockNumber int64, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, highBlockNumber, lowBlockNumber, chainID)
@@ -1025,39 +1420,160 @@ func (_c *EvmTxStore_FindTxAttemptsRequiringReceiptFetch_Call) Run(run func(ctx
 	return _c
 }
 
-func (_c *EvmTxStore_FindTxAttemptsRequiringReceiptFetch_Call) Return(attempts []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_FindTxAttemptsRequiringReceiptFetch_Call {
-	_c.Call.Return(attempts, err)
+func (_c *EvmTxStore_FindTxAttemptsRequiringReceiptFetch_Call) Return(attempts []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_FindTxAttemptsRequiringReceiptFetch_Call {
+	_c.Call.Return(attempts, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxAttemptsRequiringReceiptFetch_Call) RunAndReturn(run func(context.Context, *big.Int) ([]types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindTxAttemptsRequiringReceiptFetch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTxAttemptsRequiringResend provides a mock function with given fields: ctx, olderThan, maxInFlightTransactions, chainID, address
+func (_m *EvmTxStore) FindTxAttemptsRequiringResend(ctx context.Context, olderThan time.Time, maxInFlightTransactions uint32, chainID *big.Int, address common.Address) ([]types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, olderThan, maxInFlightTransactions, chainID, address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTxAttemptsRequiringResend")
+	}
+
+	var r0 []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint32, *big.Int, common.Address) ([]types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, olderThan, maxInFlightTransactions, chainID, address)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint32, *big.Int, common.Address) []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, olderThan, maxInFlightTransactions, chainID, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint32, *big.Int, common.Address) error); ok {
+		r1 = rf(ctx, olderThan, maxInFlightTransactions, chainID, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindTxAttemptsRequiringResend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxAttemptsRequiringResend'
+type EvmTxStore_FindTxAttemptsRequiringResend_Call struct {
+	*mock.Call
+}
+
+// FindTxAttemptsRequiringResend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - olderThan time.Time
+//   - maxInFlightTransactions uint32
+//   - chainID *big.Int
+//   - address common.Address
+func (_e *EvmTxStore_Expecter) FindTxAttemptsRequiringResend(ctx interface{}, olderThan interface{}, maxInFlightTransactions interface{}, chainID interface{}, address interface{}) *EvmTxStore_FindTxAttemptsRequiringResend_Call {
+	return &EvmTxStore_FindTxAttemptsRequiringResend_Call{Call: _e.mock.On("FindTxAttemptsRequiringResend", ctx, olderThan, maxInFlightTransactions, chainID, address)}
+}
+
+func (_c *EvmTxStore_FindTxAttemptsRequiringResend_Call) Run(run func(ctx context.Context, olderThan time.Time, maxInFlightTransactions uint32, chainID *big.Int, address common.Address)) *EvmTxStore_FindTxAttemptsRequiringResend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(uint32), args[3].(*big.Int), args[4].(common.Address))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxAttemptsRequiringResend_Call) Return(attempts []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_FindTxAttemptsRequiringResend_Call {
+	_c.Call.Return(attempts, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxAttemptsRequiringResend_Call) RunAndReturn(run func(context.Context, time.Time, uint32, *big.Int, common.Address) ([]types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindTxAttemptsRequiringResend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTxByHash provides a mock function with given fields: ctx, hash
+func (_m *EvmTxStore) FindTxByHash(ctx context.Context, hash common.Hash) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTxByHash")
+	}
+
+	var r0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindTxByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxByHash'
+type EvmTxStore_FindTxByHash_Call struct {
+	*mock.Call
+}
+
+// FindTxByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hash common.Hash
+func (_e *EvmTxStore_Expecter) FindTxByHash(ctx interface{}, hash interface{}) *EvmTxStore_FindTxByHash_Call {
+	return &EvmTxStore_FindTxByHash_Call{Call: _e.mock.On("FindTxByHash", ctx, hash)}
+}
+
+func (_c *EvmTxStore_FindTxByHash_Call) Run(run func(ctx context.Context, hash common.Hash)) *EvmTxStore_FindTxByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Hash))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxByHash_Call) Return(_a0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], _a1 error) *EvmTxStore_FindTxByHash_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *EvmTxStore_FindTxAttemptsRequiringReceiptFetch_Call) RunAndReturn(run func(context.Context, *big.Int) ([]types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindTxAttemptsRequiringReceiptFetch_Call {
+func (_c *EvmTxStore_FindTxByHash_Call) RunAndReturn(run func(context.Context, common.Hash) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindTxByHash_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindTxAttemptsRequiringResend provides a mock function with given fields: ctx, olderThan, maxInFlightTransactions, chainID, address
-func (_m *EvmTxStore) FindTxAttemptsRequiringResend(ctx context.Context, olderThan time.Time, maxInFlightTransactions uint32, chainID *big.Int, address common.Address) ([]types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
-	ret := _m.Called(ctx, olderThan, maxInFlightTransactions, chainID, address)
+// FindTxStatusEventsAfter provides a mock function with given fields: ctx, chainID, afterID, limit
+func (_m *EvmTxStore) FindTxStatusEventsAfter(ctx context.Context, chainID *big.Int, afterID int64, limit int) ([]types.TxStatusEvent[*big.Int, common.Address, common.Hash], error) {
+	ret := _m.Called(ctx, chainID, afterID, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindTxAttemptsRequiringResend")
+		panic("no return value specified for FindTxStatusEventsAfter")
 	}
 
-	var r0 []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r0 []types.TxStatusEvent[*big.Int, common.Address, common.Hash]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint32, *big.Int, common.Address) ([]types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
-		return rf(ctx, olderThan, maxInFlightTransactions, chainID, address)
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, int64, int) ([]types.TxStatusEvent[*big.Int, common.Address, common.Hash], error)); ok {
+		return rf(ctx, chainID, afterID, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint32, *big.Int, common.Address) []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
-		r0 = rf(ctx, olderThan, maxInFlightTransactions, chainID, address)
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, int64, int) []types.TxStatusEvent[*big.Int, common.Address, common.Hash]); ok {
+		r0 = rf(ctx, chainID, afterID, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+			r0 = ret.Get(0).([]types.TxStatusEvent[*big.Int, common.Address, common.Hash])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint32, *big.Int, common.Address) error); ok {
-		r1 = rf(ctx, olderThan, maxInFlightTransactions, chainID, address)
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, int64, int) error); ok {
+		r1 = rf(ctx, chainID, afterID, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1065,61 +1581,60 @@ func (_m *EvmTxStore) FindTxAttemptsRequiringResend(ctx context.Context, olderTh
 	return r0, r1
 }
 
-// EvmTxStore_FindTxAttemptsRequiringResend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxAttemptsRequiringResend'
-type EvmTxStore_FindTxAttemptsRequiringResend_Call struct {
+// EvmTxStore_FindTxStatusEventsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxStatusEventsAfter'
+type EvmTxStore_FindTxStatusEventsAfter_Call struct {
 	*mock.Call
 }
 
-// FindTxAttemptsRequiringResend is a helper method to define mock.On call
+// FindTxStatusEventsAfter is a helper method to define mock.On call
 //   - ctx context.Context
-//   - olderThan time.Time
-//   - maxInFlightTransactions uint32
 //   - chainID *big.Int
-//   - address common.Address
-func (_e *EvmTxStore_Expecter) FindTxAttemptsRequiringResend(ctx interface{}, olderThan interface{}, maxInFlightTransactions interface{}, chainID interface{}, address interface{}) *EvmTxStore_FindTxAttemptsRequiringResend_Call {
-	return &EvmTxStore_FindTxAttemptsRequiringResend_Call{Call: _e.mock.On("FindTxAttemptsRequiringResend", ctx, olderThan, maxInFlightTransactions, chainID, address)}
+//   - afterID int64
+//   - limit int
+func (_e *EvmTxStore_Expecter) FindTxStatusEventsAfter(ctx interface{}, chainID interface{}, afterID interface{}, limit interface{}) *EvmTxStore_FindTxStatusEventsAfter_Call {
+	return &EvmTxStore_FindTxStatusEventsAfter_Call{Call: _e.mock.On("FindTxStatusEventsAfter", ctx, chainID, afterID, limit)}
 }
 
-func (_c *EvmTxStore_FindTxAttemptsRequiringResend_Call) Run(run func(ctx context.Context, olderThan time.Time, maxInFlightTransactions uint32, chainID *big.Int, address common.Address)) *EvmTxStore_FindTxAttemptsRequiringResend_Call {
+func (_c *EvmTxStore_FindTxStatusEventsAfter_Call) Run(run func(ctx context.Context, chainID *big.Int, afterID int64, limit int)) *EvmTxStore_FindTxStatusEventsAfter_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(time.Time), args[2].(uint32), args[3].(*big.Int), args[4].(common.Address))
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(int64), args[3].(int))
 	})
 	return _c
 }
 
-func (_c *EvmTxStore_FindTxAttemptsRequiringResend_Call) Return(attempts []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_FindTxAttemptsRequiringResend_Call {
-	_c.Call.Return(attempts, err)
+func (_c *EvmTxStore_FindTxStatusEventsAfter_Call) Return(events []types.TxStatusEvent[*big.Int, common.Address, common.Hash], err error) *EvmTxStore_FindTxStatusEventsAfter_Call {
+	_c.Call.Return(events, err)
 	return _c
 }
 
-func (_c *EvmTxStore_FindTxAttemptsRequiringResend_Call) RunAndReturn(run func(context.Context, time.Time, uint32, *big.Int, common.Address) ([]types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindTxAttemptsRequiringResend_Call {
+func (_c *EvmTxStore_FindTxStatusEventsAfter_Call) RunAndReturn(run func(context.Context, *big.Int, int64, int) ([]types.TxStatusEvent[*big.Int, common.Address, common.Hash], error)) *EvmTxStore_FindTxStatusEventsAfter_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindTxByHash provides a mock function with given fields: ctx, hash
-func (_m *EvmTxStore) FindTxByHash(ctx context.Context, hash common.Hash) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
-	ret := _m.Called(ctx, hash)
+// FindTxStatusEventsToDeliver provides a mock function with given fields: ctx, chainID, maxAttempts, limit
+func (_m *EvmTxStore) FindTxStatusEventsToDeliver(ctx context.Context, chainID *big.Int, maxAttempts uint32, limit int) ([]types.TxStatusEvent[*big.Int, common.Address, common.Hash], error) {
+	ret := _m.Called(ctx, chainID, maxAttempts, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindTxByHash")
+		panic("no return value specified for FindTxStatusEventsToDeliver")
 	}
 
-	var r0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r0 []types.TxStatusEvent[*big.Int, common.Address, common.Hash]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, common.Hash) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
-		return rf(ctx, hash)
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, uint32, int) ([]types.TxStatusEvent[*big.Int, common.Address, common.Hash], error)); ok {
+		return rf(ctx, chainID, maxAttempts, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, common.Hash) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
-		r0 = rf(ctx, hash)
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, uint32, int) []types.TxStatusEvent[*big.Int, common.Address, common.Hash]); ok {
+		r0 = rf(ctx, chainID, maxAttempts, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+			r0 = ret.Get(0).([]types.TxStatusEvent[*big.Int, common.Address, common.Hash])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, common.Hash) error); ok {
-		r1 = rf(ctx, hash)
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, uint32, int) error); ok {
+		r1 = rf(ctx, chainID, maxAttempts, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1127,31 +1642,33 @@ func (_m *EvmTxStore) FindTxByHash(ctx context.Context, hash common.Hash) (*type
 	return r0, r1
 }
 
-// EvmTxStore_FindTxByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxByHash'
-type EvmTxStore_FindTxByHash_Call struct {
+// EvmTxStore_FindTxStatusEventsToDeliver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxStatusEventsToDeliver'
+type EvmTxStore_FindTxStatusEventsToDeliver_Call struct {
 	*mock.Call
 }
 
-// FindTxByHash is a helper method to define mock.On call
+// FindTxStatusEventsToDeliver is a helper method to define mock.On call
 //   - ctx context.Context
-//   - hash common.Hash
-func (_e *EvmTxStore_Expecter) FindTxByHash(ctx interface{}, hash interface{}) *EvmTxStore_FindTxByHash_Call {
-	return &EvmTxStore_FindTxByHash_Call{Call: _e.mock.On("FindTxByHash", ctx, hash)}
+//   - chainID *big.Int
+//   - maxAttempts uint32
+//   - limit int
+func (_e *EvmTxStore_Expecter) FindTxStatusEventsToDeliver(ctx interface{}, chainID interface{}, maxAttempts interface{}, limit interface{}) *EvmTxStore_FindTxStatusEventsToDeliver_Call {
+	return &EvmTxStore_FindTxStatusEventsToDeliver_Call{Call: _e.mock.On("FindTxStatusEventsToDeliver", ctx, chainID, maxAttempts, limit)}
 }
 
-func (_c *EvmTxStore_FindTxByHash_Call) Run(run func(ctx context.Context, hash common.Hash)) *EvmTxStore_FindTxByHash_Call {
+func (_c *EvmTxStore_FindTxStatusEventsToDeliver_Call) Run(run func(ctx context.Context, chainID *big.Int, maxAttempts uint32, limit int)) *EvmTxStore_FindTxStatusEventsToDeliver_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(common.Hash))
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(uint32), args[3].(int))
 	})
 	return _c
 }
 
-func (_c *EvmTxStore_FindTxByHash_Call) Return(_a0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], _a1 error) *EvmTxStore_FindTxByHash_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *EvmTxStore_FindTxStatusEventsToDeliver_Call) Return(events []types.TxStatusEvent[*big.Int, common.Address, common.Hash], err error) *EvmTxStore_FindTxStatusEventsToDeliver_Call {
+	_c.Call.Return(events, err)
 	return _c
 }
 
-func (_c *EvmTxStore_FindTxByHash_Call) RunAndReturn(run func(context.Context, common.Hash) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindTxByHash_Call {
+func (_c *EvmTxStore_FindTxStatusEventsToDeliver_Call) RunAndReturn(run func(context.Context, *big.Int, uint32, int) ([]types.TxStatusEvent[*big.Int, common.Address, common.Hash], error)) *EvmTxStore_FindTxStatusEventsToDeliver_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2264,6 +2781,53 @@ func (_c *EvmTxStore_MarkOldTxesMissingReceiptAsErrored_Call) RunAndReturn(run f
 	return _c
 }
 
+// MarkTxStatusEventDelivered provides a mock function with given fields: ctx, id
+func (_m *EvmTxStore) MarkTxStatusEventDelivered(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkTxStatusEventDelivered")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_MarkTxStatusEventDelivered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkTxStatusEventDelivered'
+type EvmTxStore_MarkTxStatusEventDelivered_Call struct {
+	*mock.Call
+}
+
+// MarkTxStatusEventDelivered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *EvmTxStore_Expecter) MarkTxStatusEventDelivered(ctx interface{}, id interface{}) *EvmTxStore_MarkTxStatusEventDelivered_Call {
+	return &EvmTxStore_MarkTxStatusEventDelivered_Call{Call: _e.mock.On("MarkTxStatusEventDelivered", ctx, id)}
+}
+
+func (_c *EvmTxStore_MarkTxStatusEventDelivered_Call) Run(run func(ctx context.Context, id int64)) *EvmTxStore_MarkTxStatusEventDelivered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_MarkTxStatusEventDelivered_Call) Return(_a0 error) *EvmTxStore_MarkTxStatusEventDelivered_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_MarkTxStatusEventDelivered_Call) RunAndReturn(run func(context.Context, int64) error) *EvmTxStore_MarkTxStatusEventDelivered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PreloadTxes provides a mock function with given fields: ctx, attempts
 func (_m *EvmTxStore) PreloadTxes(ctx context.Context, attempts []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, attempts)
@@ -2419,6 +2983,55 @@ func (_c *EvmTxStore_ReapTxHistory_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ReapTxStatusEvents provides a mock function with given fields: ctx, timeThreshold, maxAttempts, chainID
+func (_m *EvmTxStore) ReapTxStatusEvents(ctx context.Context, timeThreshold time.Time, maxAttempts uint32, chainID *big.Int) error {
+	ret := _m.Called(ctx, timeThreshold, maxAttempts, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReapTxStatusEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint32, *big.Int) error); ok {
+		r0 = rf(ctx, timeThreshold, maxAttempts, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_ReapTxStatusEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReapTxStatusEvents'
+type EvmTxStore_ReapTxStatusEvents_Call struct {
+	*mock.Call
+}
+
+// ReapTxStatusEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - timeThreshold time.Time
+//   - maxAttempts uint32
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) ReapTxStatusEvents(ctx interface{}, timeThreshold interface{}, maxAttempts interface{}, chainID interface{}) *EvmTxStore_ReapTxStatusEvents_Call {
+	return &EvmTxStore_ReapTxStatusEvents_Call{Call: _e.mock.On("ReapTxStatusEvents", ctx, timeThreshold, maxAttempts, chainID)}
+}
+
+func (_c *EvmTxStore_ReapTxStatusEvents_Call) Run(run func(ctx context.Context, timeThreshold time.Time, maxAttempts uint32, chainID *big.Int)) *EvmTxStore_ReapTxStatusEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(uint32), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_ReapTxStatusEvents_Call) Return(_a0 error) *EvmTxStore_ReapTxStatusEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_ReapTxStatusEvents_Call) RunAndReturn(run func(context.Context, time.Time, uint32, *big.Int) error) *EvmTxStore_ReapTxStatusEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveConfirmedMissingReceiptAttempt provides a mock function with given fields: ctx, timeout, attempt, broadcastAt
 func (_m *EvmTxStore) SaveConfirmedMissingReceiptAttempt(ctx context.Context, timeout time.Duration, attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], broadcastAt time.Time) error {
 	ret := _m.Called(ctx, timeout, attempt, broadcastAt)
@@ -2711,6 +3324,54 @@ func (_c *EvmTxStore_SaveSentAttempt_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// SetBatchCallOutcomes provides a mock function with given fields: ctx, etxID, succeeded
+func (_m *EvmTxStore) SetBatchCallOutcomes(ctx context.Context, etxID int64, succeeded []bool) error {
+	ret := _m.Called(ctx, etxID, succeeded)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBatchCallOutcomes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []bool) error); ok {
+		r0 = rf(ctx, etxID, succeeded)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_SetBatchCallOutcomes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBatchCallOutcomes'
+type EvmTxStore_SetBatchCallOutcomes_Call struct {
+	*mock.Call
+}
+
+// SetBatchCallOutcomes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etxID int64
+//   - succeeded []bool
+func (_e *EvmTxStore_Expecter) SetBatchCallOutcomes(ctx interface{}, etxID interface{}, succeeded interface{}) *EvmTxStore_SetBatchCallOutcomes_Call {
+	return &EvmTxStore_SetBatchCallOutcomes_Call{Call: _e.mock.On("SetBatchCallOutcomes", ctx, etxID, succeeded)}
+}
+
+func (_c *EvmTxStore_SetBatchCallOutcomes_Call) Run(run func(ctx context.Context, etxID int64, succeeded []bool)) *EvmTxStore_SetBatchCallOutcomes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]bool))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_SetBatchCallOutcomes_Call) Return(_a0 error) *EvmTxStore_SetBatchCallOutcomes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_SetBatchCallOutcomes_Call) RunAndReturn(run func(context.Context, int64, []bool) error) *EvmTxStore_SetBatchCallOutcomes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetBroadcastBeforeBlockNum provides a mock function with given fields: ctx, blockNum, chainID
 func (_m *EvmTxStore) SetBroadcastBeforeBlockNum(ctx context.Context, blockNum int64, chainID *big.Int) error {
 	ret := _m.Called(ctx, blockNum, chainID)
@@ -2759,6 +3420,102 @@ func (_c *EvmTxStore_SetBroadcastBeforeBlockNum_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// SettleSupersededTx provides a mock function with given fields: ctx, etx
+func (_m *EvmTxStore) SettleSupersededTx(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
+	ret := _m.Called(ctx, etx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SettleSupersededTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error); ok {
+		r0 = rf(ctx, etx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_SettleSupersededTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SettleSupersededTx'
+type EvmTxStore_SettleSupersededTx_Call struct {
+	*mock.Call
+}
+
+// SettleSupersededTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+func (_e *EvmTxStore_Expecter) SettleSupersededTx(ctx interface{}, etx interface{}) *EvmTxStore_SettleSupersededTx_Call {
+	return &EvmTxStore_SettleSupersededTx_Call{Call: _e.mock.On("SettleSupersededTx", ctx, etx)}
+}
+
+func (_c *EvmTxStore_SettleSupersededTx_Call) Run(run func(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])) *EvmTxStore_SettleSupersededTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_SettleSupersededTx_Call) Return(_a0 error) *EvmTxStore_SettleSupersededTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_SettleSupersededTx_Call) RunAndReturn(run func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error) *EvmTxStore_SettleSupersededTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SupersedeTx provides a mock function with given fields: ctx, oldTx, newTx, attempt
+func (_m *EvmTxStore) SupersedeTx(ctx context.Context, oldTx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], newTx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
+	ret := _m.Called(ctx, oldTx, newTx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SupersedeTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error); ok {
+		r0 = rf(ctx, oldTx, newTx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_SupersedeTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SupersedeTx'
+type EvmTxStore_SupersedeTx_Call struct {
+	*mock.Call
+}
+
+// SupersedeTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldTx *types.Tx[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+//   - newTx *types.Tx[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+//   - attempt *types.TxAttempt[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+func (_e *EvmTxStore_Expecter) SupersedeTx(ctx interface{}, oldTx interface{}, newTx interface{}, attempt interface{}) *EvmTxStore_SupersedeTx_Call {
+	return &EvmTxStore_SupersedeTx_Call{Call: _e.mock.On("SupersedeTx", ctx, oldTx, newTx, attempt)}
+}
+
+func (_c *EvmTxStore_SupersedeTx_Call) Run(run func(ctx context.Context, oldTx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], newTx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])) *EvmTxStore_SupersedeTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]), args[2].(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]), args[3].(*types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_SupersedeTx_Call) Return(_a0 error) *EvmTxStore_SupersedeTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_SupersedeTx_Call) RunAndReturn(run func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error) *EvmTxStore_SupersedeTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Transactions provides a mock function with given fields: ctx, offset, limit
 func (_m *EvmTxStore) Transactions(ctx context.Context, offset int, limit int) ([]types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], int, error) {
 	ret := _m.Called(ctx, offset, limit)
@@ -3248,6 +4005,54 @@ func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) RunAndReturn
 	return _c
 }
 
+// UpdateTxStatusEventDeliveryFailed provides a mock function with given fields: ctx, id, deliverAfter
+func (_m *EvmTxStore) UpdateTxStatusEventDeliveryFailed(ctx context.Context, id int64, deliverAfter time.Time) error {
+	ret := _m.Called(ctx, id, deliverAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTxStatusEventDeliveryFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, id, deliverAfter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_UpdateTxStatusEventDeliveryFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTxStatusEventDeliveryFailed'
+type EvmTxStore_UpdateTxStatusEventDeliveryFailed_Call struct {
+	*mock.Call
+}
+
+// UpdateTxStatusEventDeliveryFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - deliverAfter time.Time
+func (_e *EvmTxStore_Expecter) UpdateTxStatusEventDeliveryFailed(ctx interface{}, id interface{}, deliverAfter interface{}) *EvmTxStore_UpdateTxStatusEventDeliveryFailed_Call {
+	return &EvmTxStore_UpdateTxStatusEventDeliveryFailed_Call{Call: _e.mock.On("UpdateTxStatusEventDeliveryFailed", ctx, id, deliverAfter)}
+}
+
+func (_c *EvmTxStore_UpdateTxStatusEventDeliveryFailed_Call) Run(run func(ctx context.Context, id int64, deliverAfter time.Time)) *EvmTxStore_UpdateTxStatusEventDeliveryFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxStatusEventDeliveryFailed_Call) Return(_a0 error) *EvmTxStore_UpdateTxStatusEventDeliveryFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxStatusEventDeliveryFailed_Call) RunAndReturn(run func(context.Context, int64, time.Time) error) *EvmTxStore_UpdateTxStatusEventDeliveryFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTxUnstartedToInProgress provides a mock function with given fields: ctx, etx, attempt
 func (_m *EvmTxStore) UpdateTxUnstartedToInProgress(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, etx, attempt)
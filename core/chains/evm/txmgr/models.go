@@ -22,8 +22,11 @@ type (
 	Resender               = txmgr.Resender[*big.Int, common.Address, common.Hash, common.Hash, *evmtypes.Receipt, evmtypes.Nonce, gas.EvmFee]
 	Tracker                = txmgr.Tracker[*big.Int, common.Address, common.Hash, common.Hash, *evmtypes.Receipt, evmtypes.Nonce, gas.EvmFee]
 	Reaper                 = txmgr.Reaper[*big.Int]
+	StatusEventPublisher   = txmgr.StatusEventPublisher[*big.Int, common.Address, common.Hash]
+	StatusEventReaper      = txmgr.StatusEventReaper[*big.Int, common.Address, common.Hash]
 	TxStore                = txmgrtypes.TxStore[common.Address, *big.Int, common.Hash, common.Hash, *evmtypes.Receipt, evmtypes.Nonce, gas.EvmFee]
 	TransactionStore       = txmgrtypes.TransactionStore[common.Address, *big.Int, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	TxStatusEventStore     = txmgrtypes.TxStatusEventStore[common.Address, *big.Int, common.Hash]
 	KeyStore               = txmgrtypes.KeyStore[common.Address, *big.Int, evmtypes.Nonce]
 	TxAttemptBuilder       = txmgrtypes.TxAttemptBuilder[*big.Int, *evmtypes.Head, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	NonceTracker           = txmgrtypes.SequenceTracker[common.Address, evmtypes.Nonce]
@@ -36,6 +39,7 @@ type (
 	Tx                     = txmgrtypes.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	TxMeta                 = txmgrtypes.TxMeta[common.Address, common.Hash]
 	TxAttempt              = txmgrtypes.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	TxStatusEvent          = txmgrtypes.TxStatusEvent[*big.Int, common.Address, common.Hash]
 	Receipt                = DbReceipt // DbReceipt is the exported DB table model for receipts
 	ReceiptPlus            = txmgrtypes.ReceiptPlus[*evmtypes.Receipt]
 	StuckTxDetector        = txmgrtypes.StuckTxDetector[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
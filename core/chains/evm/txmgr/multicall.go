@@ -0,0 +1,66 @@
+package txmgr
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// Multicall3Address is the address of the Multicall3 contract on most chains, where it is deployed at the same address.
+// The batch transactions of txmgr.BatchStrategy are sent to the address configured for the chain, which defaults to it.
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+const multicall3Aggregate3ValueABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3Value[]","name":"calls","type":"tuple[]"}],"name":"aggregate3Value","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var multicall3ABI = evmtypes.MustGetABI(multicall3Aggregate3ValueABI)
+
+// multicall3Call is a Multicall3.Call3Value
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	Value        *big.Int
+	CallData     []byte
+}
+
+// multicall3Result is a Multicall3.Result
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// encodeMulticall3Batch returns the payload of a Multicall3 aggregate3Value transaction making the given calls.
+// Each of the calls is allowed to fail without reverting the others, so their outcomes are decoded from the results of
+// the batch with decodeMulticall3Results.
+func encodeMulticall3Batch(txs []DbEthTx) ([]byte, error) {
+	calls := make([]multicall3Call, len(txs))
+	for i, tx := range txs {
+		calls[i] = multicall3Call{
+			Target:       tx.ToAddress,
+			AllowFailure: true,
+			Value:        tx.Value.ToInt(),
+			CallData:     tx.EncodedPayload,
+		}
+	}
+	payload, err := multicall3ABI.Pack("aggregate3Value", calls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Multicall3 batch: %w", err)
+	}
+	return payload, nil
+}
+
+// decodeMulticall3Results returns whether each of the calls of a Multicall3 aggregate3Value transaction succeeded, from
+// the data it returned.
+func decodeMulticall3Results(returnData []byte) ([]bool, error) {
+	var results []multicall3Result
+	if err := multicall3ABI.UnpackIntoInterface(&results, "aggregate3Value", returnData); err != nil {
+		return nil, fmt.Errorf("failed to decode Multicall3 batch results: %w", err)
+	}
+	succeeded := make([]bool, len(results))
+	for i, result := range results {
+		succeeded[i] = result.Success
+	}
+	return succeeded, nil
+}
@@ -0,0 +1,87 @@
+package txmgr_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+)
+
+func mustEncodeMulticall3Results(t *testing.T, succeeded ...bool) hexutil.Bytes {
+	resultsType, err := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+		{Name: "success", Type: "bool"},
+		{Name: "returnData", Type: "bytes"},
+	})
+	require.NoError(t, err)
+	results := make([]struct {
+		Success    bool
+		ReturnData []byte
+	}, len(succeeded))
+	for i := range succeeded {
+		results[i].Success = succeeded[i]
+	}
+	b, err := abi.Arguments{{Type: resultsType}}.Pack(results)
+	require.NoError(t, err)
+	return b
+}
+
+func TestEvmTxmClient_BatchCallOutcomes(t *testing.T) {
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+	ctx := tests.Context(t)
+
+	etx := txmgr.Tx{
+		FromAddress:    testutils.NewAddress(),
+		ToAddress:      txmgr.Multicall3Address,
+		EncodedPayload: []byte{0x17, 0x4d, 0xea, 0x71},
+		FeeLimit:       100_000,
+	}
+	receipt := &evmtypes.Receipt{TxHash: utils.NewHash(), BlockNumber: big.NewInt(42), Status: 1}
+
+	t.Run("decodes the outcomes from the trace of the batch", func(t *testing.T) {
+		output := mustEncodeMulticall3Results(t, true, false, true)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "debug_traceTransaction", receipt.TxHash, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			b, err := json.Marshal(map[string]interface{}{"output": output})
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(b, args.Get(1)))
+		}).Once()
+
+		succeeded, err := txmClient.BatchCallOutcomes(ctx, etx, receipt)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, false, true}, succeeded)
+	})
+
+	t.Run("replays the batch on top of the previous block if the RPC doesn't support tracing", func(t *testing.T) {
+		output := mustEncodeMulticall3Results(t, false, true)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "debug_traceTransaction", receipt.TxHash, mock.Anything).Return(errors.New("the method debug_traceTransaction does not exist")).Once()
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "0x29").Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(1).(*hexutil.Bytes) = output
+		}).Once()
+
+		succeeded, err := txmClient.BatchCallOutcomes(ctx, etx, receipt)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{false, true}, succeeded)
+	})
+
+	t.Run("fails if the batch can neither be traced nor replayed", func(t *testing.T) {
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "debug_traceTransaction", receipt.TxHash, mock.Anything).Return(errors.New("the method debug_traceTransaction does not exist")).Once()
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "0x29").Return(errors.New("missing trie node")).Once()
+
+		_, err := txmClient.BatchCallOutcomes(ctx, etx, receipt)
+		require.ErrorContains(t, err, "failed to trace or replay batch transaction")
+		require.ErrorContains(t, err, "missing trie node")
+	})
+}
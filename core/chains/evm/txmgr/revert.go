@@ -0,0 +1,82 @@
+package txmgr
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+)
+
+// RevertDecoder decodes the revert data of calls into human readable reasons.
+// It decodes Error(string) and Panic(uint256) reverts, and the custom errors of the contract ABIs registered for the
+// destination of the call.
+type RevertDecoder struct {
+	mu   sync.RWMutex
+	abis map[common.Address]abi.ABI
+}
+
+func NewRevertDecoder() *RevertDecoder {
+	return &RevertDecoder{abis: make(map[common.Address]abi.ABI)}
+}
+
+// Register sets the ABI of the contract at the given address, replacing the one registered before if any.
+func (d *RevertDecoder) Register(to common.Address, contractABI abi.ABI) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.abis[to] = contractABI
+}
+
+// Decode returns the reason of a revert of a call to the given address with the given data.
+func (d *RevertDecoder) Decode(to common.Address, data []byte) string {
+	if len(data) < 4 {
+		return "execution reverted"
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	d.mu.RLock()
+	contractABI, ok := d.abis[to]
+	d.mu.RUnlock()
+	if ok {
+		var id [4]byte
+		copy(id[:], data[:4])
+		if abiErr, err := contractABI.ErrorByID(id); err == nil {
+			if unpacked, err := abiErr.Unpack(data); err == nil {
+				args, _ := unpacked.([]interface{})
+				strs := make([]string, len(args))
+				for i, arg := range args {
+					strs[i] = fmt.Sprintf("%v", arg)
+				}
+				return fmt.Sprintf("%s(%s)", abiErr.Name, strings.Join(strs, ", "))
+			}
+		}
+	}
+	return fmt.Sprintf("unknown error %s", hexutil.Encode(data))
+}
+
+// decodeRPCRevert returns the reason of the revert of a call to the given address, given the error of the RPC.
+// RPCs either return the revert data in the error data, possibly prefixed with "Reverted " (parity), or only a message.
+func (d *RevertDecoder) decodeRPCRevert(to common.Address, jErr *evmclient.JsonError) string {
+	if s, ok := jErr.Data.(string); ok {
+		if data, err := hexutil.Decode(strings.TrimPrefix(s, "Reverted ")); err == nil && len(data) > 0 {
+			return d.Decode(to, data)
+		}
+	}
+	return jErr.Message
+}
+
+// isRevert reports whether the error of an eth_call means that the call fails on chain,
+// as opposed to the RPC failing to run the call.
+func isRevert(jErr *evmclient.JsonError) bool {
+	// geth returns code 3 for the reverts with data
+	if jErr.Code == 3 {
+		return true
+	}
+	msg := strings.ToLower(jErr.Message)
+	return strings.Contains(msg, "revert") || strings.Contains(msg, "vm execution error") || strings.Contains(msg, "out of gas")
+}
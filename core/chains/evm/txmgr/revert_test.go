@@ -0,0 +1,146 @@
+package txmgr_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+const revertTestABI = `[{"inputs":[{"internalType":"uint256","name":"have","type":"uint256"},{"internalType":"uint256","name":"want","type":"uint256"}],"name":"InsufficientBalance","type":"error"}]`
+
+func TestRevertDecoder_Decode(t *testing.T) {
+	contractABI := evmtypes.MustGetABI(revertTestABI)
+	to := testutils.NewAddress()
+	decoder := txmgr.NewRevertDecoder()
+	decoder.Register(to, contractABI)
+
+	t.Run("Error(string)", func(t *testing.T) {
+		stringType, err := abi.NewType("string", "", nil)
+		require.NoError(t, err)
+		data, err := abi.Arguments{{Type: stringType}}.Pack("not enough LINK")
+		require.NoError(t, err)
+		data = append(hexutil.MustDecode("0x08c379a0"), data...)
+		assert.Equal(t, "not enough LINK", decoder.Decode(to, data))
+	})
+
+	t.Run("Panic(uint256)", func(t *testing.T) {
+		data := append(hexutil.MustDecode("0x4e487b71"), common.LeftPadBytes([]byte{0x11}, 32)...)
+		assert.Equal(t, "arithmetic underflow or overflow", decoder.Decode(to, data))
+	})
+
+	t.Run("custom error", func(t *testing.T) {
+		args, err := contractABI.Errors["InsufficientBalance"].Inputs.Pack(big.NewInt(1), big.NewInt(2))
+		require.NoError(t, err)
+		data := append(crypto.Keccak256([]byte("InsufficientBalance(uint256,uint256)"))[:4], args...)
+		assert.Equal(t, "InsufficientBalance(1, 2)", decoder.Decode(to, data))
+	})
+
+	t.Run("custom error of another contract", func(t *testing.T) {
+		args, err := contractABI.Errors["InsufficientBalance"].Inputs.Pack(big.NewInt(1), big.NewInt(2))
+		require.NoError(t, err)
+		data := append(crypto.Keccak256([]byte("InsufficientBalance(uint256,uint256)"))[:4], args...)
+		assert.Equal(t, "unknown error "+hexutil.Encode(data), decoder.Decode(testutils.NewAddress(), data))
+	})
+
+	t.Run("unknown error", func(t *testing.T) {
+		assert.Equal(t, "unknown error 0xdeadbeef", decoder.Decode(to, hexutil.MustDecode("0xdeadbeef")))
+	})
+
+	t.Run("no data", func(t *testing.T) {
+		assert.Equal(t, "execution reverted", decoder.Decode(to, nil))
+	})
+}
+
+func TestEvmTxmClient_SimulateTransaction(t *testing.T) {
+	ctx := tests.Context(t)
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+
+	tx := txmgr.Tx{
+		FromAddress:    testutils.NewAddress(),
+		ToAddress:      testutils.NewAddress(),
+		EncodedPayload: []byte{42, 0, 0},
+		Value:          big.Int(assets.NewEthValue(642)),
+		FeeLimit:       1e9,
+	}
+	attempt := txmgr.TxAttempt{Tx: tx, ChainSpecificFeeLimit: tx.FeeLimit}
+	overridesSenderBalance := mock.MatchedBy(func(overrides map[common.Address]map[string]interface{}) bool {
+		_, ok := overrides[tx.FromAddress]["balance"]
+		return ok
+	})
+
+	t.Run("success", func(t *testing.T) {
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "pending", overridesSenderBalance).Return(nil).Once()
+
+		revertReason, reverted, err := txmClient.SimulateTransaction(ctx, tx, attempt)
+		require.NoError(t, err)
+		assert.False(t, reverted)
+		assert.Empty(t, revertReason)
+	})
+
+	t.Run("revert with data", func(t *testing.T) {
+		jErr := evmclient.JsonError{Code: 3, Message: "execution reverted", Data: "0x4e487b710000000000000000000000000000000000000000000000000000000000000012"}
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "pending", overridesSenderBalance).Return(&jErr).Once()
+
+		revertReason, reverted, err := txmClient.SimulateTransaction(ctx, tx, attempt)
+		require.NoError(t, err)
+		assert.True(t, reverted)
+		assert.Equal(t, "division or modulo by zero", revertReason)
+	})
+
+	t.Run("revert with custom error of registered ABI", func(t *testing.T) {
+		require.Error(t, txmClient.RegisterRevertABI(tx.ToAddress, "not an ABI"))
+		require.NoError(t, txmClient.RegisterRevertABI(tx.ToAddress, revertTestABI))
+		data := append(crypto.Keccak256([]byte("InsufficientBalance(uint256,uint256)"))[:4], append(common.LeftPadBytes([]byte{1}, 32), common.LeftPadBytes([]byte{2}, 32)...)...)
+		jErr := evmclient.JsonError{Code: 3, Message: "execution reverted", Data: hexutil.Encode(data)}
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "pending", overridesSenderBalance).Return(&jErr).Once()
+
+		revertReason, reverted, err := txmClient.SimulateTransaction(ctx, tx, attempt)
+		require.NoError(t, err)
+		assert.True(t, reverted)
+		assert.Equal(t, "InsufficientBalance(1, 2)", revertReason)
+	})
+
+	t.Run("revert without data", func(t *testing.T) {
+		jErr := evmclient.JsonError{Code: -32000, Message: "execution reverted"}
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "pending", overridesSenderBalance).Return(&jErr).Once()
+
+		revertReason, reverted, err := txmClient.SimulateTransaction(ctx, tx, attempt)
+		require.NoError(t, err)
+		assert.True(t, reverted)
+		assert.Equal(t, "execution reverted", revertReason)
+	})
+
+	t.Run("rpc error", func(t *testing.T) {
+		jErr := evmclient.JsonError{Code: -32602, Message: "too many arguments, want at most 2"}
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "pending", overridesSenderBalance).Return(&jErr).Once()
+
+		_, reverted, err := txmClient.SimulateTransaction(ctx, tx, attempt)
+		require.Error(t, err)
+		assert.False(t, reverted)
+	})
+
+	t.Run("network error", func(t *testing.T) {
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "pending", overridesSenderBalance).Return(errors.New("connection refused")).Once()
+
+		_, reverted, err := txmClient.SimulateTransaction(ctx, tx, attempt)
+		require.EqualError(t, err, "connection refused")
+		assert.False(t, reverted)
+	})
+}
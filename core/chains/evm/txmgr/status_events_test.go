@@ -0,0 +1,203 @@
+package txmgr_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+)
+
+type statusEventsConfig struct {
+	disabled        bool
+	webhookURL      *url.URL
+	reaperThreshold time.Duration
+}
+
+func (c *statusEventsConfig) Enabled() bool                  { return !c.disabled }
+func (c *statusEventsConfig) WebhookURL() *url.URL           { return c.webhookURL }
+func (*statusEventsConfig) PollInterval() time.Duration      { return 10 * time.Millisecond }
+func (*statusEventsConfig) RetryInterval() time.Duration     { return time.Minute }
+func (*statusEventsConfig) MaxDeliveryAttempts() uint32      { return 3 }
+func (c *statusEventsConfig) ReaperThreshold() time.Duration { return c.reaperThreshold }
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	u, err := url.Parse(s)
+	require.NoError(t, err)
+	return u
+}
+
+func newTxStatusEvent(id int64, state txmgrtypes.TxState) txmgr.TxStatusEvent {
+	return txmgr.TxStatusEvent{
+		ID:             id,
+		TxID:           42,
+		ChainID:        testutils.FixtureChainID,
+		IdempotencyKey: "key",
+		FromAddress:    common.HexToAddress("0x1"),
+		State:          state,
+		CreatedAt:      time.Unix(1700000000, 0).UTC(),
+	}
+}
+
+func TestStatusEventPublisher_Webhook(t *testing.T) {
+	t.Parallel()
+
+	t.Run("delivers the events in order", func(t *testing.T) {
+		bodies := make(chan []byte, 2)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			bodies <- body
+		}))
+		t.Cleanup(server.Close)
+
+		unconfirmed := newTxStatusEvent(1, txmgrcommon.TxUnconfirmed)
+		hash := common.HexToHash("0x2")
+		unconfirmed.TxHash = &hash
+		fatal := newTxStatusEvent(2, txmgrcommon.TxFatalError)
+		fatal.Error = null.StringFrom("reverted")
+
+		txStore := mocks.NewEvmTxStore(t)
+		txStore.On("FindTxStatusEventsToDeliver", mock.Anything, testutils.FixtureChainID, uint32(3), 100).Return([]txmgr.TxStatusEvent{unconfirmed, fatal}, nil).Once()
+		txStore.On("FindTxStatusEventsToDeliver", mock.Anything, testutils.FixtureChainID, uint32(3), 100).Return(nil, nil).Maybe()
+		txStore.On("MarkTxStatusEventDelivered", mock.Anything, int64(1)).Return(nil).Once()
+		delivered := make(chan struct{})
+		txStore.On("MarkTxStatusEventDelivered", mock.Anything, int64(2)).Return(nil).Once().Run(func(mock.Arguments) { close(delivered) })
+
+		p := txmgr.NewEvmStatusEventPublisher(logger.Test(t), txStore, &statusEventsConfig{webhookURL: mustParseURL(t, server.URL)}, testutils.FixtureChainID)
+		p.Start()
+		t.Cleanup(p.Stop)
+
+		select {
+		case <-delivered:
+		case <-time.After(tests.WaitTimeout(t)):
+			t.Fatal("timed out waiting for the events to be delivered")
+		}
+		assert.JSONEq(t, `{"id":1,"txID":42,"chainID":"0","idempotencyKey":"key","fromAddress":"0x0000000000000000000000000000000000000001","state":"unconfirmed","txHash":"0x0000000000000000000000000000000000000000000000000000000000000002","createdAt":"2023-11-14T22:13:20Z"}`, string(<-bodies))
+		var payload map[string]interface{}
+		require.NoError(t, json.Unmarshal(<-bodies, &payload))
+		assert.Equal(t, "fatal_error", payload["state"])
+		assert.Equal(t, "reverted", payload["error"])
+	})
+
+	t.Run("retries a failed delivery before the events after it", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(server.Close)
+
+		first := newTxStatusEvent(1, txmgrcommon.TxUnconfirmed)
+		first.DeliveryAttempts = 1
+		second := newTxStatusEvent(2, txmgrcommon.TxConfirmed)
+
+		txStore := mocks.NewEvmTxStore(t)
+		txStore.On("FindTxStatusEventsToDeliver", mock.Anything, testutils.FixtureChainID, uint32(3), 100).Return([]txmgr.TxStatusEvent{first, second}, nil).Once()
+		failed := make(chan time.Time)
+		before := time.Now()
+		txStore.On("UpdateTxStatusEventDeliveryFailed", mock.Anything, int64(1), mock.Anything).Return(nil).Once().Run(func(args mock.Arguments) {
+			failed <- args.Get(2).(time.Time)
+		})
+		// the first event waits for its retry, and the second one behind it
+		first.DeliveryAttempts, first.DeliverAfter = 2, time.Now().Add(time.Hour)
+		txStore.On("FindTxStatusEventsToDeliver", mock.Anything, testutils.FixtureChainID, uint32(3), 100).Return([]txmgr.TxStatusEvent{first, second}, nil).Maybe()
+
+		p := txmgr.NewEvmStatusEventPublisher(logger.Test(t), txStore, &statusEventsConfig{webhookURL: mustParseURL(t, server.URL)}, testutils.FixtureChainID)
+		p.Start()
+		t.Cleanup(p.Stop)
+
+		select {
+		case deliverAfter := <-failed:
+			// the retry interval is doubled for the second attempt
+			assert.WithinDuration(t, before.Add(2*time.Minute), deliverAfter, 10*time.Second)
+		case <-time.After(tests.WaitTimeout(t)):
+			t.Fatal("timed out waiting for the delivery to fail")
+		}
+	})
+}
+
+func TestStatusEventPublisher_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	txStore := mocks.NewEvmTxStore(t)
+	txStore.On("FindLatestTxStatusEventID", mock.Anything, testutils.FixtureChainID).Return(int64(7), nil).Once()
+	txStore.On("FindTxStatusEventsAfter", mock.Anything, testutils.FixtureChainID, int64(7), 100).Return([]txmgr.TxStatusEvent{newTxStatusEvent(8, txmgrcommon.TxInProgress), newTxStatusEvent(9, txmgrcommon.TxUnconfirmed)}, nil).Once()
+	txStore.On("FindTxStatusEventsAfter", mock.Anything, testutils.FixtureChainID, int64(9), 100).Return(nil, nil).Maybe()
+
+	// without a webhook, the events are only streamed
+	p := txmgr.NewEvmStatusEventPublisher(logger.Test(t), txStore, &statusEventsConfig{}, testutils.FixtureChainID)
+	p.Start()
+	t.Cleanup(p.Stop)
+
+	events, unsub, err := p.Subscribe(-1)
+	require.NoError(t, err)
+	for _, id := range []int64{8, 9} {
+		select {
+		case event := <-events:
+			assert.Equal(t, id, event.ID)
+		case <-time.After(tests.WaitTimeout(t)):
+			t.Fatalf("timed out waiting for event %d", id)
+		}
+	}
+
+	unsub()
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(tests.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the subscription to end")
+	}
+}
+
+func TestStatusEventReaper(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name        string
+		disabled    bool
+		webhookURL  *url.URL
+		maxAttempts uint32
+	}{
+		{"reaps the delivered events and the ones given up on", false, mustParseURL(t, "https://tx.status/events"), 3},
+		{"reaps all the events without a webhook", false, nil, 0},
+		{"reaps all the events when they aren't published", true, mustParseURL(t, "https://tx.status/events"), 0},
+	} {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			txStore := mocks.NewEvmTxStore(t)
+			reaped := make(chan time.Time)
+			before := time.Now()
+			txStore.On("ReapTxStatusEvents", mock.Anything, mock.Anything, test.maxAttempts, testutils.FixtureChainID).Return(nil).Once().Run(func(args mock.Arguments) {
+				reaped <- args.Get(1).(time.Time)
+			})
+
+			r := txmgr.NewEvmStatusEventReaper(logger.Test(t), txStore, &statusEventsConfig{disabled: test.disabled, webhookURL: test.webhookURL, reaperThreshold: 24 * time.Hour}, testutils.FixtureChainID)
+			r.Start()
+			t.Cleanup(r.Stop)
+
+			select {
+			case timeThreshold := <-reaped:
+				assert.WithinDuration(t, before.Add(-24*time.Hour), timeThreshold, 10*time.Second)
+			case <-time.After(tests.WaitTimeout(t)):
+				t.Fatal("timed out waiting for the events to be reaped")
+			}
+		})
+	}
+}
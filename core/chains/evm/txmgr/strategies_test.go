@@ -50,3 +50,28 @@ func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
 		assert.Equal(t, []int64{1, 2}, ids)
 	})
 }
+
+func Test_BatchStrategy_Subject(t *testing.T) {
+	t.Parallel()
+
+	subject := uuid.New()
+	s := txmgrcommon.NewBatchStrategy(subject, 10)
+
+	assert.True(t, s.Subject().Valid)
+	assert.Equal(t, subject, s.Subject().UUID)
+}
+
+func Test_BatchStrategy_PruneQueue(t *testing.T) {
+	t.Parallel()
+	subject := uuid.New()
+	batchSize := uint32(10)
+	mockTxStore := mocks.NewEvmTxStore(t)
+
+	t.Run("calls BatchUnstartedTxQueue for the given subject and batchSize", func(t *testing.T) {
+		strategy := txmgrcommon.NewBatchStrategy(subject, batchSize)
+		mockTxStore.On("BatchUnstartedTxQueue", mock.Anything, batchSize, subject).Once().Return([]int64{1, 2}, nil)
+		ids, err := strategy.PruneQueue(tests.Context(t), mockTxStore)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, ids)
+	})
+}
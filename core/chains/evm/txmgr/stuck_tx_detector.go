@@ -162,6 +162,10 @@ func (d *stuckTxDetector) FindUnconfirmedTxWithLowestNonce(ctx context.Context,
 			d.lggr.AssumptionViolationw("encountered an unconfirmed transaction without an attempt", "tx", tx)
 			continue
 		}
+		// The sequence of a transaction superseded by an operator is already being purged or replaced
+		if tx.SupersededByTxID.Valid || tx.SupersedesTxID.Valid {
+			continue
+		}
 		// Check the transaction's attempts in case any are already marked for purge or if any are not broadcasted
 		// We can only have one non-broadcasted attempt for a transaction at a time
 		// Skip purge detection until all attempts are broadcasted to avoid conflicts with the purge attempt
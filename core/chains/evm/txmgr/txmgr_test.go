@@ -26,6 +26,7 @@ import (
 	commonutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
@@ -602,6 +603,96 @@ func TestTxm_Reset(t *testing.T) {
 	})
 }
 
+func TestTxm_CancelAndReplaceTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	gcfg := configtest.NewTestGeneralConfig(t)
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	kst := cltest.NewKeyStore(t, db)
+	_, addr := cltest.RandomKey{}.MustInsert(t, kst.Eth())
+	txStore := cltest.NewTestTxStore(t, db)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, nil)
+	ethClient.On("BatchCallContextAll", mock.Anything, mock.Anything).Return(nil).Maybe()
+	ethClient.On("PendingNonceAt", mock.Anything, addr).Return(uint64(2), nil).Maybe()
+	// the Broadcaster sends the superseding transactions once restarted
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, addr).Return(commonclient.Successful, nil).Maybe()
+
+	estimator, err := gas.NewEstimator(logger.Test(t), ethClient, cfg.EVM().ChainType(), cfg.EVM().GasEstimator())
+	require.NoError(t, err)
+	txm, err := makeTestEvmTxm(t, db, ethClient, estimator, cfg.EVM(), cfg.EVM().GasEstimator(), cfg.EVM().Transactions(), gcfg.Database(), gcfg.Database().Listener(), kst.Eth())
+	require.NoError(t, err)
+
+	unconfirmed := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, addr)
+
+	t.Run("returns error if not started", func(t *testing.T) {
+		_, err := txm.CancelTransaction(ctx, unconfirmed.ID)
+		require.EqualError(t, err, "not started")
+	})
+
+	servicetest.Run(t, txm)
+
+	var cancellation txmgr.Tx
+	t.Run("cancels unconfirmed transaction", func(t *testing.T) {
+		etx, err := txm.CancelTransaction(ctx, unconfirmed.ID)
+		require.NoError(t, err)
+		assert.Equal(t, addr, etx.ToAddress)
+		assert.Empty(t, etx.EncodedPayload)
+		assert.Equal(t, int64(0), etx.Value.Int64())
+		assert.Equal(t, evmtypes.Nonce(0), *etx.Sequence)
+		assert.Equal(t, null.IntFrom(unconfirmed.ID), etx.SupersedesTxID)
+		require.Len(t, etx.TxAttempts, 1)
+		assert.True(t, etx.TxAttempts[0].TxFee.GasPrice.Cmp(unconfirmed.TxAttempts[0].TxFee.GasPrice) > 0, "expected bumped gas price")
+
+		cancelled, err := txStore.FindTxWithAttempts(ctx, unconfirmed.ID)
+		require.NoError(t, err)
+		// the cancelled transaction is tracked until either transaction is mined
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, cancelled.State)
+		assert.Equal(t, evmtypes.Nonce(0), *cancelled.Sequence)
+		assert.Equal(t, null.IntFrom(etx.ID), cancelled.SupersededByTxID)
+		assert.True(t, cancelled.SupersededByCancel)
+		cancellation = etx
+
+		require.Eventually(t, func() bool {
+			inProgress, err := txStore.GetTxInProgress(ctx, addr)
+			return err == nil && inProgress == nil
+		}, tests.WaitTimeout(t), 100*time.Millisecond)
+	})
+
+	t.Run("replaces unconfirmed transaction", func(t *testing.T) {
+		unconfirmed := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, addr)
+
+		etx, err := txm.ReplaceTransaction(ctx, unconfirmed.ID, []byte{4, 5, 6}, 100_000)
+		require.NoError(t, err)
+		assert.Equal(t, unconfirmed.ToAddress, etx.ToAddress)
+		assert.Equal(t, []byte{4, 5, 6}, etx.EncodedPayload)
+		assert.Equal(t, uint64(100_000), etx.FeeLimit)
+		assert.Equal(t, evmtypes.Nonce(1), *etx.Sequence)
+		require.Len(t, etx.TxAttempts, 1)
+		assert.Equal(t, uint64(100_000), etx.TxAttempts[0].ChainSpecificFeeLimit)
+
+		replaced, err := txStore.FindTxWithAttempts(ctx, unconfirmed.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, replaced.State)
+		assert.Equal(t, null.IntFrom(etx.ID), replaced.SupersededByTxID)
+		assert.False(t, replaced.SupersededByCancel)
+	})
+
+	t.Run("returns error for transactions which are already superseded", func(t *testing.T) {
+		_, err := txm.CancelTransaction(ctx, unconfirmed.ID)
+		require.ErrorContains(t, err, fmt.Sprintf("transaction %d is already superseded by transaction %d", unconfirmed.ID, cancellation.ID))
+	})
+
+	t.Run("returns error for transactions which are not unconfirmed", func(t *testing.T) {
+		confirmed := mustInsertConfirmedEthTxWithReceipt(t, txStore, addr, 2, 42)
+		_, err := txm.ReplaceTransaction(ctx, confirmed.ID, nil, 0)
+		require.ErrorContains(t, err, fmt.Sprintf("only unconfirmed transactions can be replaced, transaction %d is confirmed", confirmed.ID))
+	})
+}
+
 func TestTxm_GetTransactionStatus(t *testing.T) {
 	t.Parallel()
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
@@ -62,6 +63,26 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "cancel",
+				Usage:  "Cancel the unconfirmed Ethereum Transaction with the given ID, by sending a transaction with no value from its sender to itself at its nonce with a bumped fee",
+				Action: s.CancelTransaction,
+			},
+			{
+				Name:   "replace",
+				Usage:  "Replace the unconfirmed Ethereum Transaction with the given ID, by sending a transaction with new data and gas limit at its nonce with a bumped fee",
+				Action: s.ReplaceTransaction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "data",
+						Usage: "hex encoded data of the replacement transaction, defaults to the data of the replaced transaction",
+					},
+					cli.Uint64Flag{
+						Name:  "gas-limit",
+						Usage: "gas limit of the replacement transaction, defaults to the gas limit of the replaced transaction",
+					},
+				},
+			},
 		},
 	}
 }
@@ -73,8 +94,9 @@ type EthTxPresenter struct {
 
 // RenderTable implements TableRenderer
 func (p *EthTxPresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"From", "Nonce", "To", "State"})
+	table := rt.newTable([]string{"ID", "From", "Nonce", "To", "State"})
 	table.Append([]string{
+		p.TxID,
 		p.From.Hex(),
 		p.Nonce,
 		p.To.Hex(),
@@ -89,9 +111,10 @@ type EthTxPresenters []EthTxPresenter
 
 // RenderTable implements TableRenderer
 func (ps EthTxPresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Hash", "Nonce", "From", "GasPrice", "SentAt", "State"})
+	table := rt.newTable([]string{"ID", "Hash", "Nonce", "From", "GasPrice", "SentAt", "State"})
 	for _, p := range ps {
 		table.Append([]string{
+			p.TxID,
 			p.Hash.Hex(),
 			p.Nonce,
 			p.From.Hex(),
@@ -131,6 +154,59 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction cancels the unconfirmed transaction with the given ID
+func (s *Shell) CancelTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the transaction"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+c.Args().First()+"/cancel", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
+// ReplaceTransaction replaces the unconfirmed transaction with the given ID
+func (s *Shell) ReplaceTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the transaction"))
+	}
+	request := models.ReplaceTxRequest{
+		GasLimit: c.Uint64("gas-limit"),
+	}
+	if c.IsSet("data") {
+		request.Data, err = hexutil.Decode(c.String("data"))
+		if err != nil {
+			return s.errorOut(multierr.Combine(errors.New("while parsing transaction data"), err))
+		}
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+c.Args().First()+"/replace", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
@@ -50,6 +50,33 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Dry run the pipeline of a job spec without saving the job, using fixtures for HTTP, bridge and EVM tasks",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixtures, f",
+					Usage: "`FILE` containing JSON fixtures, e.g. {\"vars\": {...}, \"tasks\": {\"fetch\": {\"value\": \"...\"}}}",
+				},
+			},
+		},
+		{
+			Name:   "replay-run",
+			Usage:  "Re-execute a recorded pipeline run from its replay bundle and compare every task against the original run",
+			Action: s.ReplayPipelineRun,
+		},
+		{
+			Name:  "runs",
+			Usage: "Commands for managing pipeline runs",
+			Subcommands: []cli.Command{
+				{
+					Name:   "cancel",
+					Usage:  "Cancel an unfinished pipeline run, marking it and its pending tasks as errored",
+					Action: s.CancelPipelineRun,
+				},
+			},
+		},
 	}
 }
 
@@ -294,6 +321,155 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// SimulatedRunPresenter wraps the JSONAPI pipeline run resource of a simulated
+// run and renders the result of each task.
+type SimulatedRunPresenter struct {
+	presenters.PipelineRunResource
+}
+
+// RenderTable implements TableRenderer
+func (p *SimulatedRunPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Output", "Error"})
+	for _, tr := range p.TaskRuns {
+		var output, errString string
+		if tr.Output != nil {
+			output = *tr.Output
+		}
+		if tr.Error != nil {
+			errString = *tr.Error
+		}
+		table.Append([]string{tr.DotID, tr.Type.String(), output, errString})
+	}
+	render("Simulated Pipeline Run", table)
+
+	outputs := rt.newTable([]string{"Output", "Fatal Error"})
+	for i, out := range p.Outputs {
+		var output, errString string
+		if out != nil {
+			output = *out
+		}
+		if i < len(p.FatalErrors) && p.FatalErrors[i] != nil {
+			errString = *p.FatalErrors[i]
+		}
+		outputs.Append([]string{output, errString})
+	}
+	render("Final Results", outputs)
+	return nil
+}
+
+// SimulateJob dry runs the pipeline of a job spec against fixtures.
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	var fixtures pipeline.SimulationFixtures
+	if c.IsSet("fixtures") {
+		buf, ferr := fromFile(c.String("fixtures"))
+		if ferr != nil {
+			return s.errorOut(errors.Wrap(ferr, "failed to read fixtures"))
+		}
+		if ferr = json.Unmarshal(buf.Bytes(), &fixtures); ferr != nil {
+			return s.errorOut(errors.Wrap(ferr, "failed to parse fixtures"))
+		}
+	}
+
+	request, err := json.Marshal(web.SimulateJobRequest{
+		TOML:     tomlString,
+		Fixtures: fixtures,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/simulate", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &SimulatedRunPresenter{})
+}
+
+// ReplayedRunPresenter wraps the JSONAPI replay resource of a pipeline run and
+// renders the original and replayed result of each task.
+type ReplayedRunPresenter struct {
+	presenters.PipelineRunReplayResource
+}
+
+// RenderTable implements TableRenderer
+func (p *ReplayedRunPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Original", "Replayed", "Match"})
+	for _, task := range p.Tasks {
+		table.Append([]string{
+			task.DotID,
+			task.Type.String(),
+			replayedTaskResultString(task.OriginalOutput, task.OriginalError),
+			replayedTaskResultString(task.ReplayedOutput, task.ReplayedError),
+			fmt.Sprintf("%v", task.Match),
+		})
+	}
+	render(fmt.Sprintf("Replayed Pipeline Run %s (%s)", p.ID, p.State), table)
+	return nil
+}
+
+func replayedTaskResultString(output, errString *string) string {
+	if errString != nil {
+		return "error: " + *errString
+	}
+	if output != nil {
+		return *output
+	}
+	return ""
+}
+
+// ReplayPipelineRun re-executes a recorded pipeline run and diffs it against the original
+func (s *Shell) ReplayPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the run id to replay"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/runs/"+c.Args().First()+"/replay", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &ReplayedRunPresenter{})
+}
+
+// CancelPipelineRun cancels an unfinished pipeline run
+func (s *Shell) CancelPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the run id to cancel"))
+	}
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/pipeline/runs/"+c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var run presenters.PipelineRunResource
+	return s.renderAPIResponse(resp, &run, "Pipeline run successfully cancelled")
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
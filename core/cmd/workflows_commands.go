@@ -0,0 +1,504 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initWorkflowsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "simulate",
+			Usage:  "Run a YAML or WASM workflow locally, feeding its triggers from a fixtures file and stubbing its other capabilities, and print the trace of each execution",
+			Action: s.SimulateWorkflow,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "events, e",
+					Usage: "`FILE` containing JSON fixtures, e.g. {\"events\": [{\"id\": \"1\", \"outputs\": {...}}], \"steps\": {\"consensus\": {\"outputs\": {...}}}}",
+				},
+				cli.StringFlag{
+					Name:  "workflow-config",
+					Usage: "`FILE` containing the config of the workflow, required for WASM workflows",
+				},
+				cli.StringFlag{
+					Name:  "spec-type",
+					Usage: "type of the workflow, yaml or wasm_file; inferred from the file extension by default",
+				},
+				cli.DurationFlag{
+					Name:  "timeout",
+					Usage: "maximum duration of the simulation",
+					Value: time.Minute,
+				},
+			},
+		},
+		{
+			Name:   "upgrade",
+			Usage:  "Deploy a new version of the workflow run by the job with the given ID, from a TOML job spec or a path to one; the previous version takes no new trigger events, and runs its executions in progress to completion",
+			Action: s.UpgradeWorkflow,
+		},
+		{
+			Name:   "rollback",
+			Usage:  "Reinstate the previous version of the workflow run by the job with the given ID, retiring the active one",
+			Action: s.RollbackWorkflow,
+		},
+		{
+			Name:   "versions",
+			Usage:  "List the versions of the workflow run by the job with the given ID, the active one first",
+			Action: s.ListWorkflowVersions,
+		},
+		{
+			Name:  "executions",
+			Usage: "Commands for operating on workflow executions",
+			Subcommands: cli.Commands{
+				{
+					Name:   "resume",
+					Usage:  "Resume a failed or timed out execution from the steps which didn't complete, reusing the outputs of the completed ones",
+					Action: s.ResumeWorkflowExecution,
+				},
+				{
+					Name:   "rerun",
+					Usage:  "Re-run an execution from scratch with its original trigger event",
+					Action: s.RerunWorkflowExecution,
+				},
+			},
+		},
+		{
+			Name:  "secrets",
+			Usage: "Commands for managing the secrets which workflow specs reference as $(secrets.NAME)",
+			Subcommands: cli.Commands{
+				{
+					Name:   "create",
+					Usage:  "Store a secret under the given name, encrypted with the keystore password",
+					Action: s.CreateWorkflowSecret,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "value-file",
+							Usage: "`FILE` containing the value of the secret; prompted for if omitted",
+						},
+					},
+				},
+				{
+					Name:   "list",
+					Usage:  "List the names of the stored secrets",
+					Action: s.ListWorkflowSecrets,
+				},
+				{
+					Name:  "delete",
+					Usage: format(`Delete the secret with the given name (irreversible!)`),
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "yes, y",
+							Usage: "skip the confirmation prompt",
+						},
+					},
+					Action: s.DeleteWorkflowSecret,
+				},
+			},
+		},
+	}
+}
+
+// WorkflowExecutionPresenter wraps the JSONAPI workflow execution resource.
+type WorkflowExecutionPresenter struct {
+	presenters.WorkflowExecutionResource
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowExecutionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Workflow ID", "Trigger Event ID", "Status"})
+	table.Append([]string{p.ID, p.WorkflowID, p.TriggerEventID, p.Status})
+	render("Workflow Execution", table)
+
+	steps := rt.newTable([]string{"Step", "Status", "Attempts", "Error"})
+	for _, step := range p.Steps {
+		var stepErr string
+		if step.Error != nil {
+			stepErr = *step.Error
+		}
+		steps.Append([]string{step.Ref, step.Status, strconv.Itoa(len(step.Attempts)), stepErr})
+	}
+	render("Steps", steps)
+	return nil
+}
+
+// ResumeWorkflowExecution resumes a failed or timed out workflow execution
+func (s *Shell) ResumeWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the execution id to resume"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflows/executions/"+c.Args().First()+"/resume", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{}, "Workflow execution successfully resumed")
+}
+
+// RerunWorkflowExecution starts a new execution with the trigger event of the given workflow execution
+func (s *Shell) RerunWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the execution id to re-run"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflows/executions/"+c.Args().First()+"/rerun", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{}, "Workflow execution successfully re-run")
+}
+
+// WorkflowVersionPresenter wraps the JSONAPI workflow version resource.
+type WorkflowVersionPresenter struct {
+	presenters.WorkflowVersionResource
+}
+
+// ToRow presents the WorkflowVersionPresenter as a slice of strings.
+func (p *WorkflowVersionPresenter) ToRow() []string {
+	return []string{p.ID, p.WorkflowID, p.WorkflowVersion, strconv.FormatBool(p.Retired), strconv.Itoa(p.ExecutionsInProgress)}
+}
+
+var workflowVersionHeaders = []string{"Job ID", "Workflow ID", "Version", "Retired", "Executions In Progress"}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowVersionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(workflowVersionHeaders)
+	table.Append(p.ToRow())
+	render("Workflow Version", table)
+	return nil
+}
+
+// WorkflowVersionPresenters implements TableRenderer for a slice of WorkflowVersionPresenter.
+type WorkflowVersionPresenters []WorkflowVersionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowVersionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(workflowVersionHeaders)
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+	render("Workflow Versions", table)
+	return nil
+}
+
+// UpgradeWorkflow deploys a new version of a workflow, from a TOML job spec
+func (s *Shell) UpgradeWorkflow(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the job ID of the workflow, and the TOML or filepath of its new version"))
+	}
+	tomlString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	body, err := json.Marshal(web.UpgradeWorkflowRequest{TOML: tomlString})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflows/"+c.Args().First()+"/upgrade", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, "Workflow upgraded")
+}
+
+// RollbackWorkflow reinstates the previous version of a workflow
+func (s *Shell) RollbackWorkflow(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job ID of the workflow to roll back"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflows/"+c.Args().First()+"/rollback", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, "Workflow rolled back")
+}
+
+// ListWorkflowVersions lists the versions of a workflow
+func (s *Shell) ListWorkflowVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job ID of the workflow"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/workflows/"+c.Args().First()+"/versions", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowVersionPresenters{})
+}
+
+// WorkflowSecretPresenter wraps the JSONAPI workflow secret resource.
+type WorkflowSecretPresenter struct {
+	presenters.WorkflowSecretResource
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowSecretPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name"})
+	table.Append([]string{p.Name})
+	render("Workflow Secret", table)
+	return nil
+}
+
+// WorkflowSecretPresenters implements TableRenderer for a slice of WorkflowSecretPresenter.
+type WorkflowSecretPresenters []WorkflowSecretPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowSecretPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name"})
+	for _, p := range ps {
+		table.Append([]string{p.Name})
+	}
+	render("Workflow Secrets", table)
+	return nil
+}
+
+// CreateWorkflowSecret stores a workflow secret, reading its value from a file or the terminal
+func (s *Shell) CreateWorkflowSecret(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the secret"))
+	}
+
+	var value string
+	if file := c.String("value-file"); file != "" {
+		b, rerr := os.ReadFile(file)
+		if rerr != nil {
+			return s.errorOut(errors.Wrapf(rerr, "failed to read %s", file))
+		}
+		value = strings.TrimRight(string(b), "\r\n")
+	} else {
+		prompter := NewTerminalPrompter()
+		if !prompter.IsTerminal() {
+			return s.errorOut(errors.New("must specify --value-file when not running in a terminal"))
+		}
+		value = prompter.PasswordPrompt("Secret value: ")
+	}
+
+	body, err := json.Marshal(web.CreateWorkflowSecretRequest{Name: c.Args().First(), Value: value})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflows/secrets", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowSecretPresenter{}, "Workflow secret created")
+}
+
+// ListWorkflowSecrets lists the names of the workflow secrets
+func (s *Shell) ListWorkflowSecrets(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/workflows/secrets", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowSecretPresenters{})
+}
+
+// DeleteWorkflowSecret deletes a workflow secret, by name
+func (s *Shell) DeleteWorkflowSecret(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the secret to be deleted"))
+	}
+	name := c.Args().First()
+
+	if !confirmAction(c) {
+		return nil
+	}
+
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/workflows/secrets/"+name)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	_, err = s.parseResponse(resp)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Workflow secret %s deleted\n", name)
+	return nil
+}
+
+// WorkflowSimulationPresenter renders the trace of a workflow simulation.
+type WorkflowSimulationPresenter struct {
+	workflows.SimulationResult
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowSimulationPresenter) RenderTable(rt RendererTable) error {
+	for _, execution := range p.Executions {
+		table := rt.newTable([]string{"Step", "Status", "Attempts", "Inputs", "Outputs", "Error"})
+		for _, step := range execution.Steps {
+			table.Append([]string{
+				step.Ref,
+				step.Status,
+				strconv.Itoa(len(step.Attempts)),
+				simulatedValueString(step.Inputs),
+				simulatedValueString(step.Outputs),
+				step.Error,
+			})
+		}
+		render(fmt.Sprintf("Execution %s (event %s): %s", execution.ExecutionID, execution.TriggerEventID, execution.Status), table)
+	}
+
+	calls := rt.newTable([]string{"Execution", "Step", "Capability", "Inputs"})
+	for _, call := range p.TargetCalls {
+		calls.Append([]string{call.ExecutionID, call.Ref, call.CapabilityID, simulatedValueString(call.Inputs)})
+	}
+	render("Target Calls", calls)
+	return nil
+}
+
+func simulatedValueString(v any) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+// SimulateWorkflow runs a workflow locally against stub capabilities, without a node or a database.
+// Valid input is a path to a YAML workflow or a WASM binary.
+func (s *Shell) SimulateWorkflow(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the path to a YAML or WASM workflow"))
+	}
+	if !c.IsSet("events") {
+		return s.errorOut(errors.New("must pass the path to a fixtures file with --events"))
+	}
+	path := c.Args().First()
+
+	specType := job.WorkflowSpecType(c.String("spec-type"))
+	if specType == job.DefaultSpecType {
+		specType = job.YamlSpec
+		if ext := strings.ToLower(filepath.Ext(path)); ext == ".wasm" || ext == ".br" {
+			specType = job.WASMFile
+		}
+	}
+
+	spec := job.WorkflowSpec{SpecType: specType}
+	var config []byte
+	if c.IsSet("workflow-config") {
+		buf, cerr := fromFile(c.String("workflow-config"))
+		if cerr != nil {
+			return s.errorOut(errors.Wrap(cerr, "failed to read workflow config"))
+		}
+		config = buf.Bytes()
+	}
+	switch specType {
+	case job.YamlSpec:
+		buf, werr := fromFile(path)
+		if werr != nil {
+			return s.errorOut(errors.Wrap(werr, "failed to read workflow"))
+		}
+		spec.Workflow = buf.String()
+		spec.Config = string(config)
+	case job.WASMFile:
+		// The WASM spec factory reads the binary and its config from disk.
+		if !c.IsSet("workflow-config") {
+			return s.errorOut(errors.New("must pass the path to the workflow config with --workflow-config for WASM workflows"))
+		}
+		spec.Workflow = path
+		spec.Config = c.String("workflow-config")
+	default:
+		return s.errorOut(fmt.Errorf("unknown spec type %s, expected %s or %s", specType, job.YamlSpec, job.WASMFile))
+	}
+
+	var fixtures workflows.SimulationFixtures
+	buf, err := fromFile(c.String("events"))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to read fixtures"))
+	}
+	if err = json.Unmarshal(buf.Bytes(), &fixtures); err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to parse fixtures"))
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx(), c.Duration("timeout"))
+	defer cancel()
+
+	// Like for workflow jobs, this sets the ID, the owner and the name of the workflow
+	if err = spec.Validate(ctx); err != nil {
+		return s.errorOut(errors.Wrap(err, "invalid workflow"))
+	}
+	sdkSpec, err := spec.SDKSpec(ctx)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to load workflow"))
+	}
+	var binary []byte
+	if specType == job.WASMFile {
+		binary, err = spec.RawSpec(ctx)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to load workflow"))
+		}
+	}
+
+	result, err := workflows.Simulate(ctx, workflows.SimulationConfig{
+		Lggr:          s.Logger,
+		Workflow:      sdkSpec,
+		WorkflowID:    spec.WorkflowID,
+		WorkflowOwner: spec.WorkflowOwner,
+		WorkflowName:  spec.WorkflowName,
+		Config:        config,
+		Binary:        binary,
+		Fixtures:      fixtures,
+	})
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to simulate workflow"))
+	}
+	return s.errorOut(s.Render(&WorkflowSimulationPresenter{result}))
+}
@@ -0,0 +1,189 @@
+package cmd_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const simulatedWorkflow = `
+triggers:
+  - id: "mercury-trigger@1.0.0"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000"
+
+consensus:
+  - id: "offchain_reporting@1.0.0"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+
+targets:
+  - id: "write_ethereum-testnet-sepolia@1.0.0"
+    ref: "write"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+`
+
+func TestShell_SimulateWorkflow(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	workflowFile := filepath.Join(dir, "workflow.yaml")
+	require.NoError(t, os.WriteFile(workflowFile, []byte(simulatedWorkflow), 0600))
+	eventsFile := filepath.Join(dir, "events.json")
+	require.NoError(t, os.WriteFile(eventsFile, []byte(`{
+		"events": [{"id": "1", "outputs": {"price": 100}}],
+		"steps": {"evm_median": {"outputs": {"report": "0xabcd"}}}
+	}`), 0600))
+
+	buffer := bytes.NewBufferString("")
+	client := &cmd.Shell{Logger: logger.TestLogger(t), Renderer: cmd.RendererJSON{Writer: buffer}}
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateWorkflow, set, "")
+	require.NoError(t, set.Set("events", eventsFile))
+	require.NoError(t, set.Parse([]string{workflowFile}))
+	c := cli.NewContext(nil, set, nil)
+
+	require.NoError(t, client.SimulateWorkflow(c))
+
+	var result workflows.SimulationResult
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &result))
+	// the ID the workflow would have as a job
+	assert.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte(simulatedWorkflow))), result.WorkflowID)
+	require.Len(t, result.Executions, 1)
+	execution := result.Executions[0]
+	assert.Equal(t, store.StatusCompleted, execution.Status)
+	require.Len(t, execution.Steps, 3)
+	assert.Equal(t, map[string]any{"price": float64(100)}, execution.Steps[0].Outputs)
+	require.Len(t, result.TargetCalls, 1)
+	assert.Equal(t, "write", result.TargetCalls[0].Ref)
+	assert.Equal(t, map[string]any{"report": "0xabcd"}, result.TargetCalls[0].Inputs)
+}
+
+func TestShell_SimulateWorkflow_Errors(t *testing.T) {
+	t.Parallel()
+
+	client := &cmd.Shell{Logger: logger.TestLogger(t), Renderer: cmd.RendererJSON{Writer: bytes.NewBufferString("")}}
+	for _, tc := range []struct {
+		name  string
+		flags map[string]string
+		args  []string
+		err   string
+	}{
+		{"no workflow", nil, nil, "must pass the path to a YAML or WASM workflow"},
+		{"no events", nil, []string{"workflow.yaml"}, "--events"},
+		{"WASM without config", map[string]string{"events": "events.json"}, []string{"workflow.wasm"}, "--workflow-config"},
+		{"unknown spec type", map[string]string{"events": "events.json", "spec-type": "json"}, []string{"workflow.json"}, "unknown spec type json"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", 0)
+			flagSetApplyFromAction(client.SimulateWorkflow, set, "")
+			for k, v := range tc.flags {
+				require.NoError(t, set.Set(k, v))
+			}
+			require.NoError(t, set.Parse(tc.args))
+
+			err := client.SimulateWorkflow(cli.NewContext(nil, set, nil))
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestShell_WorkflowExecutionActions_Errors(t *testing.T) {
+	t.Parallel()
+
+	client := &cmd.Shell{Logger: logger.TestLogger(t), Renderer: cmd.RendererJSON{Writer: bytes.NewBufferString("")}}
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	assert.ErrorContains(t, client.ResumeWorkflowExecution(c), "must pass the execution id to resume")
+	assert.ErrorContains(t, client.RerunWorkflowExecution(c), "must pass the execution id to re-run")
+}
+
+func TestShell_WorkflowSecrets(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	valueFile := filepath.Join(t.TempDir(), "secret.txt")
+	require.NoError(t, os.WriteFile(valueFile, []byte("hunter2\n"), 0600))
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.CreateWorkflowSecret, set, "")
+	require.NoError(t, set.Set("value-file", valueFile))
+	require.NoError(t, set.Parse([]string{"API_KEY"}))
+	require.NoError(t, client.CreateWorkflowSecret(cli.NewContext(nil, set, nil)))
+
+	secret, err := app.GetKeyStore().WorkflowSecrets().Get("API_KEY")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", secret.Value())
+
+	require.NoError(t, client.ListWorkflowSecrets(cltest.EmptyCLIContext()))
+	secrets := *r.Renders[len(r.Renders)-1].(*cmd.WorkflowSecretPresenters)
+	require.Len(t, secrets, 1)
+	assert.Equal(t, "API_KEY", secrets[0].Name)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.DeleteWorkflowSecret, set, "")
+	require.NoError(t, set.Set("yes", "true"))
+	require.NoError(t, set.Parse([]string{"API_KEY"}))
+	require.NoError(t, client.DeleteWorkflowSecret(cli.NewContext(nil, set, nil)))
+
+	_, err = app.GetKeyStore().WorkflowSecrets().Get("API_KEY")
+	require.Error(t, err)
+}
+
+func TestShell_WorkflowSecrets_Errors(t *testing.T) {
+	t.Parallel()
+
+	client := &cmd.Shell{Logger: logger.TestLogger(t), Renderer: cmd.RendererJSON{Writer: bytes.NewBufferString("")}}
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	assert.ErrorContains(t, client.CreateWorkflowSecret(c), "must pass the name of the secret")
+	assert.ErrorContains(t, client.DeleteWorkflowSecret(c), "must pass the name of the secret to be deleted")
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.CreateWorkflowSecret, set, "")
+	require.NoError(t, set.Set("value-file", filepath.Join(t.TempDir(), "missing.txt")))
+	require.NoError(t, set.Parse([]string{"API_KEY"}))
+	assert.ErrorContains(t, client.CreateWorkflowSecret(cli.NewContext(nil, set, nil)), "failed to read")
+}
+
+func TestShell_WorkflowVersions_Errors(t *testing.T) {
+	t.Parallel()
+
+	client := &cmd.Shell{Logger: logger.TestLogger(t), Renderer: cmd.RendererJSON{Writer: bytes.NewBufferString("")}}
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	assert.ErrorContains(t, client.RollbackWorkflow(c), "must pass the job ID of the workflow to roll back")
+	assert.ErrorContains(t, client.ListWorkflowVersions(c), "must pass the job ID of the workflow")
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"1"}))
+	assert.ErrorContains(t, client.UpgradeWorkflow(cli.NewContext(nil, set, nil)), "must pass the job ID of the workflow, and the TOML or filepath of its new version")
+}
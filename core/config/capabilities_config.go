@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 )
 
@@ -26,9 +28,15 @@ type ConnectorGateway interface {
 	URL() string
 }
 
+type Workflows interface {
+	ExecutionsReaperInterval() time.Duration
+	ExecutionsReaperThreshold() time.Duration
+}
+
 type Capabilities interface {
 	Peering() P2P
 	Dispatcher() Dispatcher
 	ExternalRegistry() CapabilitiesExternalRegistry
 	GatewayConnector() GatewayConnector
+	Workflows() Workflows
 }
@@ -97,6 +97,9 @@ func TestDoc(t *testing.T) {
 		docDefaults.Transactions.AutoPurge.Threshold = nil
 		docDefaults.Transactions.AutoPurge.MinAttempts = nil
 
+		// Transactions.StatusEvents.WebhookURL has no default, the events are only streamed without it
+		docDefaults.Transactions.StatusEvents.WebhookURL = nil
+
 		// GasEstimator.DAOracle.OracleAddress is only set if DA oracle config is used
 		docDefaults.GasEstimator.DAOracle.OracleAddress = nil
 
@@ -7,12 +7,16 @@ import (
 )
 
 type JobPipeline interface {
+	BridgeCircuitBreakerFailureThreshold() uint32
+	BridgeCircuitBreakerOpenTimeout() time.Duration
 	DefaultHTTPLimit() int64
 	DefaultHTTPTimeout() commonconfig.Duration
 	MaxRunDuration() time.Duration
 	MaxSuccessfulRuns() uint64
+	MaxSuspendDuration() time.Duration
 	ReaperInterval() time.Duration
 	ReaperThreshold() time.Duration
+	ReplayBundlesEnabled() bool
 	ResultWriteQueueDepth() uint64
 	ExternalInitiatorsEnabled() bool
 	VerboseLogging() bool
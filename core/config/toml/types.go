@@ -868,12 +868,15 @@ type JobPipeline struct {
 	ExternalInitiatorsEnabled *bool
 	MaxRunDuration            *commonconfig.Duration
 	MaxSuccessfulRuns         *uint64
+	MaxSuspendDuration        *commonconfig.Duration
 	ReaperInterval            *commonconfig.Duration
 	ReaperThreshold           *commonconfig.Duration
+	ReplayBundlesEnabled      *bool
 	ResultWriteQueueDepth     *uint32
 	VerboseLogging            *bool
 
-	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	HTTPRequest          JobPipelineHTTPRequest          `toml:",omitempty"`
+	BridgeCircuitBreaker JobPipelineBridgeCircuitBreaker `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -886,12 +889,18 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 	if v := f.MaxSuccessfulRuns; v != nil {
 		j.MaxSuccessfulRuns = v
 	}
+	if v := f.MaxSuspendDuration; v != nil {
+		j.MaxSuspendDuration = v
+	}
 	if v := f.ReaperInterval; v != nil {
 		j.ReaperInterval = v
 	}
 	if v := f.ReaperThreshold; v != nil {
 		j.ReaperThreshold = v
 	}
+	if v := f.ReplayBundlesEnabled; v != nil {
+		j.ReplayBundlesEnabled = v
+	}
 	if v := f.ResultWriteQueueDepth; v != nil {
 		j.ResultWriteQueueDepth = v
 	}
@@ -899,6 +908,7 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 		j.VerboseLogging = v
 	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
+	j.BridgeCircuitBreaker.setFrom(&f.BridgeCircuitBreaker)
 }
 
 type JobPipelineHTTPRequest struct {
@@ -915,6 +925,20 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	}
 }
 
+type JobPipelineBridgeCircuitBreaker struct {
+	FailureThreshold *uint32
+	OpenTimeout      *commonconfig.Duration
+}
+
+func (j *JobPipelineBridgeCircuitBreaker) setFrom(f *JobPipelineBridgeCircuitBreaker) {
+	if v := f.FailureThreshold; v != nil {
+		j.FailureThreshold = v
+	}
+	if v := f.OpenTimeout; v != nil {
+		j.OpenTimeout = v
+	}
+}
+
 type FluxMonitor struct {
 	DefaultTransactionQueueDepth *uint32
 	SimulateTransactions         *bool
@@ -1538,6 +1562,7 @@ type Capabilities struct {
 	Dispatcher       Dispatcher       `toml:",omitempty"`
 	ExternalRegistry ExternalRegistry `toml:",omitempty"`
 	GatewayConnector GatewayConnector `toml:",omitempty"`
+	Workflows        Workflows        `toml:",omitempty"`
 }
 
 func (c *Capabilities) setFrom(f *Capabilities) {
@@ -1545,6 +1570,21 @@ func (c *Capabilities) setFrom(f *Capabilities) {
 	c.ExternalRegistry.setFrom(&f.ExternalRegistry)
 	c.Dispatcher.setFrom(&f.Dispatcher)
 	c.GatewayConnector.setFrom(&f.GatewayConnector)
+	c.Workflows.setFrom(&f.Workflows)
+}
+
+type Workflows struct {
+	ExecutionsReaperInterval  *commonconfig.Duration
+	ExecutionsReaperThreshold *commonconfig.Duration
+}
+
+func (w *Workflows) setFrom(f *Workflows) {
+	if f.ExecutionsReaperInterval != nil {
+		w.ExecutionsReaperInterval = f.ExecutionsReaperInterval
+	}
+	if f.ExecutionsReaperThreshold != nil {
+		w.ExecutionsReaperThreshold = f.ExecutionsReaperThreshold
+	}
 }
 
 type ThresholdKeyShareSecrets struct {
@@ -37,6 +37,8 @@ import (
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	store "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -249,6 +251,53 @@ func (_c *Application_BridgeORM_Call) RunAndReturn(run func() bridges.ORM) *Appl
 	return _c
 }
 
+// CancelRunV2 provides a mock function with given fields: ctx, runID
+func (_m *Application) CancelRunV2(ctx context.Context, runID int64) error {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelRunV2")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_CancelRunV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelRunV2'
+type Application_CancelRunV2_Call struct {
+	*mock.Call
+}
+
+// CancelRunV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+func (_e *Application_Expecter) CancelRunV2(ctx interface{}, runID interface{}) *Application_CancelRunV2_Call {
+	return &Application_CancelRunV2_Call{Call: _e.mock.On("CancelRunV2", ctx, runID)}
+}
+
+func (_c *Application_CancelRunV2_Call) Run(run func(ctx context.Context, runID int64)) *Application_CancelRunV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Application_CancelRunV2_Call) Return(_a0 error) *Application_CancelRunV2_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_CancelRunV2_Call) RunAndReturn(run func(context.Context, int64) error) *Application_CancelRunV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -1153,6 +1202,52 @@ func (_c *Application_JobSpawner_Call) RunAndReturn(run func() job.Spawner) *App
 	return _c
 }
 
+// JobStatsV2 provides a mock function with given fields: jobID
+func (_m *Application) JobStatsV2(jobID int32) pipeline.JobStats {
+	ret := _m.Called(jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobStatsV2")
+	}
+
+	var r0 pipeline.JobStats
+	if rf, ok := ret.Get(0).(func(int32) pipeline.JobStats); ok {
+		r0 = rf(jobID)
+	} else {
+		r0 = ret.Get(0).(pipeline.JobStats)
+	}
+
+	return r0
+}
+
+// Application_JobStatsV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobStatsV2'
+type Application_JobStatsV2_Call struct {
+	*mock.Call
+}
+
+// JobStatsV2 is a helper method to define mock.On call
+//   - jobID int32
+func (_e *Application_Expecter) JobStatsV2(jobID interface{}) *Application_JobStatsV2_Call {
+	return &Application_JobStatsV2_Call{Call: _e.mock.On("JobStatsV2", jobID)}
+}
+
+func (_c *Application_JobStatsV2_Call) Run(run func(jobID int32)) *Application_JobStatsV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_JobStatsV2_Call) Return(_a0 pipeline.JobStats) *Application_JobStatsV2_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_JobStatsV2_Call) RunAndReturn(run func(int32) pipeline.JobStats) *Application_JobStatsV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -1248,6 +1343,131 @@ func (_c *Application_ReplayFromBlock_Call) RunAndReturn(run func(*big.Int, uint
 	return _c
 }
 
+// ReplayRunV2 provides a mock function with given fields: ctx, runID
+func (_m *Application) ReplayRunV2(ctx context.Context, runID int64) (*pipeline.Run, []pipeline.ReplayTaskDiff, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayRunV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 []pipeline.ReplayTaskDiff
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*pipeline.Run, []pipeline.ReplayTaskDiff, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *pipeline.Run); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) []pipeline.ReplayTaskDiff); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]pipeline.ReplayTaskDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64) error); ok {
+		r2 = rf(ctx, runID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Application_ReplayRunV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayRunV2'
+type Application_ReplayRunV2_Call struct {
+	*mock.Call
+}
+
+// ReplayRunV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+func (_e *Application_Expecter) ReplayRunV2(ctx interface{}, runID interface{}) *Application_ReplayRunV2_Call {
+	return &Application_ReplayRunV2_Call{Call: _e.mock.On("ReplayRunV2", ctx, runID)}
+}
+
+func (_c *Application_ReplayRunV2_Call) Run(run func(ctx context.Context, runID int64)) *Application_ReplayRunV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Application_ReplayRunV2_Call) Return(_a0 *pipeline.Run, _a1 []pipeline.ReplayTaskDiff, _a2 error) *Application_ReplayRunV2_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Application_ReplayRunV2_Call) RunAndReturn(run func(context.Context, int64) (*pipeline.Run, []pipeline.ReplayTaskDiff, error)) *Application_ReplayRunV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RerunWorkflowExecution provides a mock function with given fields: ctx, executionID
+func (_m *Application) RerunWorkflowExecution(ctx context.Context, executionID string) (string, error) {
+	ret := _m.Called(ctx, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RerunWorkflowExecution")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, executionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, executionID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, executionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_RerunWorkflowExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RerunWorkflowExecution'
+type Application_RerunWorkflowExecution_Call struct {
+	*mock.Call
+}
+
+// RerunWorkflowExecution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - executionID string
+func (_e *Application_Expecter) RerunWorkflowExecution(ctx interface{}, executionID interface{}) *Application_RerunWorkflowExecution_Call {
+	return &Application_RerunWorkflowExecution_Call{Call: _e.mock.On("RerunWorkflowExecution", ctx, executionID)}
+}
+
+func (_c *Application_RerunWorkflowExecution_Call) Run(run func(ctx context.Context, executionID string)) *Application_RerunWorkflowExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Application_RerunWorkflowExecution_Call) Return(_a0 string, _a1 error) *Application_RerunWorkflowExecution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_RerunWorkflowExecution_Call) RunAndReturn(run func(context.Context, string) (string, error)) *Application_RerunWorkflowExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -1296,6 +1516,110 @@ func (_c *Application_ResumeJobV2_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// ResumeWorkflowExecution provides a mock function with given fields: ctx, executionID
+func (_m *Application) ResumeWorkflowExecution(ctx context.Context, executionID string) error {
+	ret := _m.Called(ctx, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeWorkflowExecution")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, executionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_ResumeWorkflowExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeWorkflowExecution'
+type Application_ResumeWorkflowExecution_Call struct {
+	*mock.Call
+}
+
+// ResumeWorkflowExecution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - executionID string
+func (_e *Application_Expecter) ResumeWorkflowExecution(ctx interface{}, executionID interface{}) *Application_ResumeWorkflowExecution_Call {
+	return &Application_ResumeWorkflowExecution_Call{Call: _e.mock.On("ResumeWorkflowExecution", ctx, executionID)}
+}
+
+func (_c *Application_ResumeWorkflowExecution_Call) Run(run func(ctx context.Context, executionID string)) *Application_ResumeWorkflowExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Application_ResumeWorkflowExecution_Call) Return(_a0 error) *Application_ResumeWorkflowExecution_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_ResumeWorkflowExecution_Call) RunAndReturn(run func(context.Context, string) error) *Application_ResumeWorkflowExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackWorkflow provides a mock function with given fields: ctx, jobID
+func (_m *Application) RollbackWorkflow(ctx context.Context, jobID int32) (job.Job, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackWorkflow")
+	}
+
+	var r0 job.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (job.Job, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) job.Job); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Get(0).(job.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_RollbackWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackWorkflow'
+type Application_RollbackWorkflow_Call struct {
+	*mock.Call
+}
+
+// RollbackWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Application_Expecter) RollbackWorkflow(ctx interface{}, jobID interface{}) *Application_RollbackWorkflow_Call {
+	return &Application_RollbackWorkflow_Call{Call: _e.mock.On("RollbackWorkflow", ctx, jobID)}
+}
+
+func (_c *Application_RollbackWorkflow_Call) Run(run func(ctx context.Context, jobID int32)) *Application_RollbackWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_RollbackWorkflow_Call) Return(_a0 job.Job, _a1 error) *Application_RollbackWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_RollbackWorkflow_Call) RunAndReturn(run func(context.Context, int32) (job.Job, error)) *Application_RollbackWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunJobV2 provides a mock function with given fields: ctx, jobID, meta
 func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error) {
 	ret := _m.Called(ctx, jobID, meta)
@@ -1506,6 +1830,66 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, fixtures
+func (_m *Application) SimulateJobV2(ctx context.Context, jb *job.Job, fixtures pipeline.SimulationFixtures) (*pipeline.Run, error) {
+	ret := _m.Called(ctx, jb, fixtures)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job, pipeline.SimulationFixtures) (*pipeline.Run, error)); ok {
+		return rf(ctx, jb, fixtures)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job, pipeline.SimulationFixtures) *pipeline.Run); ok {
+		r0 = rf(ctx, jb, fixtures)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *job.Job, pipeline.SimulationFixtures) error); ok {
+		r1 = rf(ctx, jb, fixtures)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_SimulateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobV2'
+type Application_SimulateJobV2_Call struct {
+	*mock.Call
+}
+
+// SimulateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb *job.Job
+//   - fixtures pipeline.SimulationFixtures
+func (_e *Application_Expecter) SimulateJobV2(ctx interface{}, jb interface{}, fixtures interface{}) *Application_SimulateJobV2_Call {
+	return &Application_SimulateJobV2_Call{Call: _e.mock.On("SimulateJobV2", ctx, jb, fixtures)}
+}
+
+func (_c *Application_SimulateJobV2_Call) Run(run func(ctx context.Context, jb *job.Job, fixtures pipeline.SimulationFixtures)) *Application_SimulateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*job.Job), args[2].(pipeline.SimulationFixtures))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) Return(_a0 *pipeline.Run, _a1 error) *Application_SimulateJobV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) RunAndReturn(run func(context.Context, *job.Job, pipeline.SimulationFixtures) (*pipeline.Run, error)) *Application_SimulateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1644,6 +2028,54 @@ func (_c *Application_TxmStorageService_Call) RunAndReturn(run func() txmgr.EvmT
 	return _c
 }
 
+// UpgradeWorkflow provides a mock function with given fields: ctx, jobID, jb
+func (_m *Application) UpgradeWorkflow(ctx context.Context, jobID int32, jb *job.Job) error {
+	ret := _m.Called(ctx, jobID, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpgradeWorkflow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, *job.Job) error); ok {
+		r0 = rf(ctx, jobID, jb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_UpgradeWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpgradeWorkflow'
+type Application_UpgradeWorkflow_Call struct {
+	*mock.Call
+}
+
+// UpgradeWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - jb *job.Job
+func (_e *Application_Expecter) UpgradeWorkflow(ctx interface{}, jobID interface{}, jb interface{}) *Application_UpgradeWorkflow_Call {
+	return &Application_UpgradeWorkflow_Call{Call: _e.mock.On("UpgradeWorkflow", ctx, jobID, jb)}
+}
+
+func (_c *Application_UpgradeWorkflow_Call) Run(run func(ctx context.Context, jobID int32, jb *job.Job)) *Application_UpgradeWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(*job.Job))
+	})
+	return _c
+}
+
+func (_c *Application_UpgradeWorkflow_Call) Return(_a0 error) *Application_UpgradeWorkflow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_UpgradeWorkflow_Call) RunAndReturn(run func(context.Context, int32, *job.Job) error) *Application_UpgradeWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
@@ -1676,6 +2108,112 @@ func (_c *Application_WakeSessionReaper_Call) RunAndReturn(run func()) *Applicat
 	return _c
 }
 
+// WorkflowORM provides a mock function with given fields:
+func (_m *Application) WorkflowORM() store.Store {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowORM")
+	}
+
+	var r0 store.Store
+	if rf, ok := ret.Get(0).(func() store.Store); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.Store)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowORM'
+type Application_WorkflowORM_Call struct {
+	*mock.Call
+}
+
+// WorkflowORM is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowORM() *Application_WorkflowORM_Call {
+	return &Application_WorkflowORM_Call{Call: _e.mock.On("WorkflowORM")}
+}
+
+func (_c *Application_WorkflowORM_Call) Run(run func()) *Application_WorkflowORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) Return(_a0 store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) RunAndReturn(run func() store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WorkflowVersions provides a mock function with given fields: ctx, jobID
+func (_m *Application) WorkflowVersions(ctx context.Context, jobID int32) ([]job.Job, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowVersions")
+	}
+
+	var r0 []job.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]job.Job, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []job.Job); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_WorkflowVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowVersions'
+type Application_WorkflowVersions_Call struct {
+	*mock.Call
+}
+
+// WorkflowVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Application_Expecter) WorkflowVersions(ctx interface{}, jobID interface{}) *Application_WorkflowVersions_Call {
+	return &Application_WorkflowVersions_Call{Call: _e.mock.On("WorkflowVersions", ctx, jobID)}
+}
+
+func (_c *Application_WorkflowVersions_Call) Run(run func(ctx context.Context, jobID int32)) *Application_WorkflowVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowVersions_Call) Return(_a0 []job.Job, _a1 error) *Application_WorkflowVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_WorkflowVersions_Call) RunAndReturn(run func(context.Context, int32) ([]job.Job, error)) *Application_WorkflowVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
@@ -49,6 +49,8 @@ const (
 	KeyDeleted  EventID = "KEY_DELETED"
 
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionCancelled  EventID = "ETH_TRANSACTION_CANCELLED"
+	EthTransactionReplaced   EventID = "ETH_TRANSACTION_REPLACED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
@@ -82,8 +84,16 @@ const (
 	ConfigSqlLoggingDisabled EventID = "CONFIG_SQL_LOGGING_DISABLED"
 	GlobalLogLevelSet        EventID = "GLOBAL_LOG_LEVEL_SET"
 
-	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
-	JobRunSet         EventID = "JOB_RUN_SET"
+	JobErrorDismissed    EventID = "JOB_ERROR_DISMISSED"
+	JobRunSet            EventID = "JOB_RUN_SET"
+	PipelineRunCancelled EventID = "PIPELINE_RUN_CANCELLED"
+
+	WorkflowExecutionResumed EventID = "WORKFLOW_EXECUTION_RESUMED"
+	WorkflowExecutionRerun   EventID = "WORKFLOW_EXECUTION_RERUN"
+	WorkflowSecretCreated    EventID = "WORKFLOW_SECRET_CREATED"
+	WorkflowSecretDeleted    EventID = "WORKFLOW_SECRET_DELETED"
+	WorkflowUpgraded         EventID = "WORKFLOW_UPGRADED"
+	WorkflowRolledBack       EventID = "WORKFLOW_ROLLED_BACK"
 
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
 
@@ -15,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/blockhash_store"
@@ -37,6 +38,7 @@ type bpBHSDatabaseConfig interface {
 type BulletproofBHS struct {
 	config        bpBHSConfig
 	dbConfig      bpBHSDatabaseConfig
+	maxBatchCalls uint32
 	jobID         uuid.UUID
 	fromAddresses []types.EIP55Address
 	txm           txmgr.TxManager
@@ -49,9 +51,13 @@ type BulletproofBHS struct {
 }
 
 // NewBulletproofBHS creates a new instance with the given transaction manager and blockhash store.
+// If maxBatchCalls isn't 0, the stores are merged into Multicall3 batch transactions of at most
+// maxBatchCalls stores each.
 func NewBulletproofBHS(
 	config bpBHSConfig,
 	dbConfig bpBHSDatabaseConfig,
+	maxBatchCalls uint32,
+	jobID uuid.UUID,
 	fromAddresses []types.EIP55Address,
 	txm txmgr.TxManager,
 	bhs blockhash_store.BlockhashStoreInterface,
@@ -73,6 +79,8 @@ func NewBulletproofBHS(
 	return &BulletproofBHS{
 		config:        config,
 		dbConfig:      dbConfig,
+		maxBatchCalls: maxBatchCalls,
+		jobID:         jobID,
 		fromAddresses: fromAddresses,
 		txm:           txm,
 		abi:           bhsABI,
@@ -96,15 +104,21 @@ func (c *BulletproofBHS) Store(ctx context.Context, blockNum uint64) error {
 		return errors.Wrap(err, "getting next from address")
 	}
 
+	// Set a queue size of 256. At most we store the blockhash of every block, and only the
+	// latest 256 can possibly be stored.
+	strategy := txmgrcommon.NewQueueingTxStrategy(c.jobID, 256)
+	if c.maxBatchCalls > 0 {
+		// anyone can store a blockhash, so the stores can be sent from the Multicall3 contract
+		strategy = txmgrcommon.NewBatchStrategy(c.jobID, c.maxBatchCalls)
+	}
+
 	_, err = c.txm.CreateTransaction(ctx, txmgr.TxRequest{
 		FromAddress:    fromAddress,
 		ToAddress:      c.bhs.Address(),
 		EncodedPayload: payload,
 		FeeLimit:       c.config.LimitDefault(),
-
-		// Set a queue size of 256. At most we store the blockhash of every block, and only the
-		// latest 256 can possibly be stored.
-		Strategy: txmgrcommon.NewQueueingTxStrategy(c.jobID, 256),
+		Strategy:       strategy,
+		Priority:       txmgrtypes.TxPriorityBulk,
 	})
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
@@ -143,6 +157,7 @@ func (c *BulletproofBHS) StoreTrusted(
 		FeeLimit:       c.config.LimitDefault(),
 
 		Strategy: txmgrcommon.NewSendEveryStrategy(),
+		Priority: txmgrtypes.TxPriorityBulk,
 	})
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
@@ -197,6 +212,7 @@ func (c *BulletproofBHS) StoreEarliest(ctx context.Context) error {
 		EncodedPayload: payload,
 		FeeLimit:       c.config.LimitDefault(),
 		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		Priority:       txmgrtypes.TxPriorityBulk,
 	})
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -48,6 +51,8 @@ func TestStoreRotatesFromAddresses(t *testing.T) {
 	bhs, err := blockhashstore.NewBulletproofBHS(
 		chain.Config().EVM().GasEstimator(),
 		cfg.Database(),
+		0,
+		uuid.New(),
 		fromAddresses,
 		txm,
 		store,
@@ -58,7 +63,7 @@ func TestStoreRotatesFromAddresses(t *testing.T) {
 	require.NoError(t, err)
 
 	txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(tx txmgr.TxRequest) bool {
-		return tx.FromAddress.String() == k1.Address.String()
+		return tx.FromAddress.String() == k1.Address.String() && tx.Priority == txmgrtypes.TxPriorityBulk
 	})).Once().Return(txmgr.Tx{}, nil)
 
 	txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(tx txmgr.TxRequest) bool {
@@ -71,3 +76,41 @@ func TestStoreRotatesFromAddresses(t *testing.T) {
 	err = bhs.Store(ctx, 2)
 	require.NoError(t, err)
 }
+
+func TestStoreBatches(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	kst := cltest.NewKeyStore(t, db)
+	require.NoError(t, kst.Unlock(ctx, cltest.Password))
+	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, KeyStore: kst.Eth(), GeneralConfig: cfg, Client: ethClient})
+	chain, err := legacyChains.Get(cltest.FixtureChainID.String())
+	require.NoError(t, err)
+	k, err := kst.Eth().Create(ctx, &cltest.FixtureChainID)
+	require.NoError(t, err)
+	txm := txmmocks.NewMockEvmTxManager(t)
+	jobID := uuid.New()
+
+	store, err := blockhash_store.NewBlockhashStore(common.HexToAddress("0x31Ca8bf590360B3198749f852D5c516c642846F6"), chain.Client())
+	require.NoError(t, err)
+	bhs, err := blockhashstore.NewBulletproofBHS(
+		chain.Config().EVM().GasEstimator(),
+		cfg.Database(),
+		5,
+		jobID,
+		[]types.EIP55Address{k.EIP55Address},
+		txm,
+		store,
+		nil,
+		&cltest.FixtureChainID,
+		kst.Eth(),
+	)
+	require.NoError(t, err)
+
+	txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(tx txmgr.TxRequest) bool {
+		return tx.Strategy == txmgrcommon.NewBatchStrategy(jobID, 5)
+	})).Once().Return(txmgr.Tx{}, nil)
+
+	require.NoError(t, bhs.Store(ctx, 1))
+}
@@ -154,9 +154,16 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		coordinators = append(coordinators, coord)
 	}
 
+	var maxBatchCalls uint32
+	if jb.BlockhashStoreSpec.BatchTransactions {
+		maxBatchCalls = chain.Config().EVM().Transactions().Batching().MaxCalls()
+	}
+
 	bpBHS, err := NewBulletproofBHS(
 		chain.Config().EVM().GasEstimator(),
 		d.cfg.Database(),
+		maxBatchCalls,
+		jb.ExternalJobID,
 		fromAddresses,
 		chain.TxManager(),
 		bhs,
@@ -103,6 +103,7 @@ type Application interface {
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	WorkflowORM() workflowstore.Store
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
@@ -110,6 +111,27 @@ type Application interface {
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// SimulateJobV2 executes the pipeline of an unsaved job in-memory, resolving external tasks against fixtures.
+	SimulateJobV2(ctx context.Context, jb *job.Job, fixtures pipeline.SimulationFixtures) (*pipeline.Run, error)
+	// ReplayRunV2 re-executes a recorded pipeline run from its replay bundle and diffs it against the original.
+	ReplayRunV2(ctx context.Context, runID int64) (*pipeline.Run, []pipeline.ReplayTaskDiff, error)
+	// CancelRunV2 fails an unfinished pipeline run and stops executing it.
+	CancelRunV2(ctx context.Context, runID int64) error
+	// ResumeWorkflowExecution resumes a failed or timed out workflow execution from the steps which didn't complete.
+	ResumeWorkflowExecution(ctx context.Context, executionID string) error
+	// RerunWorkflowExecution starts a new execution of a workflow with the trigger event of the given one, returning its ID.
+	RerunWorkflowExecution(ctx context.Context, executionID string) (string, error)
+	// UpgradeWorkflow deploys jb as a new version of the workflow of the given job, retiring the version of that job,
+	// which takes no new trigger events, but runs its executions in progress to completion.
+	UpgradeWorkflow(ctx context.Context, jobID int32, jb *job.Job) error
+	// RollbackWorkflow retires the version of the workflow of the given job, and reinstates the most recently retired
+	// version of that workflow, whose job is returned.
+	RollbackWorkflow(ctx context.Context, jobID int32) (job.Job, error)
+	// WorkflowVersions returns the jobs of all the versions of the workflow of the given job,
+	// the active one first, followed by the retired ones, most recently retired first.
+	WorkflowVersions(ctx context.Context, jobID int32) ([]job.Job, error)
+	// JobStatsV2 summarises the recent pipeline runs of a job.
+	JobStatsV2(jobID int32) pipeline.JobStats
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -141,6 +163,8 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	workflowORM              workflowstore.Store
+	workflowEngines          *workflows.EngineRegistry
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
 	txmStorageService        txmgr.EvmTxStore
@@ -379,14 +403,15 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 
 	var (
-		pipelineORM    = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
-		bridgeORM      = bridges.NewORM(opts.DS)
-		mercuryORM     = mercury.NewORM(opts.DS)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
-		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
-		txmORM         = txmgr.NewTxStore(opts.DS, globalLogger)
-		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
-		workflowORM    = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
+		pipelineORM     = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
+		bridgeORM       = bridges.NewORM(opts.DS)
+		mercuryORM      = mercury.NewORM(opts.DS)
+		pipelineRunner  = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		jobORM          = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
+		txmORM          = txmgr.NewTxStore(opts.DS, globalLogger)
+		streamRegistry  = streams.NewRegistry(globalLogger, pipelineRunner)
+		workflowORM     = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
+		workflowEngines = workflows.NewEngineRegistry()
 	)
 
 	promReporter := headreporter.NewPrometheusReporter(opts.DS, legacyEVMChains)
@@ -404,6 +429,10 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 	srvcs = append(srvcs, pipelineORM)
 
+	workflowsCfg := cfg.Capabilities().Workflows()
+	workflowReaper := workflowstore.NewReaper(workflowORM, globalLogger, workflowsCfg.ExecutionsReaperInterval(), workflowsCfg.ExecutionsReaperThreshold())
+	srvcs = append(srvcs, workflowReaper)
+
 	loopRegistrarConfig := plugins.NewRegistrarConfig(opts.GRPCOpts, opts.LoopRegistry.Register, opts.LoopRegistry.Unregister)
 
 	var (
@@ -466,6 +495,8 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger,
 		opts.CapabilitiesRegistry,
 		workflowORM,
+		workflowEngines,
+		keyStore.WorkflowSecrets(),
 	)
 
 	// Flux monitor requires ethereum just to boot, silence errors with a null delegate
@@ -637,6 +668,8 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		workflowORM:              workflowORM,
+		workflowEngines:          workflowEngines,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
@@ -817,6 +850,10 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) WorkflowORM() workflowstore.Store {
+	return app.workflowORM
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -946,6 +983,211 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(ctx, taskID, result.Value, result.Error)
 }
 
+// SimulateJobV2 performs a dry run of the job's pipeline. HTTP, bridge and
+// EVM tasks are not executed; their results come from fixtures instead.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb *job.Job,
+	fixtures pipeline.SimulationFixtures,
+) (*pipeline.Run, error) {
+	if len(jb.Pipeline.Tasks) == 0 {
+		return nil, errors.New("job has no pipeline to simulate")
+	}
+	if err := fixtures.Validate(&jb.Pipeline); err != nil {
+		return nil, errors.Wrap(err, "invalid fixtures")
+	}
+
+	var gasLimit *uint32
+	if jb.GasLimit.Valid {
+		gasLimit = &jb.GasLimit.Uint32
+	}
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		GasLimit:          gasLimit,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jb.Type),
+	}
+
+	vars := map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    jb.ID,
+			"externalJobID": jb.ExternalJobID,
+			"name":          jb.Name.ValueOrZero(),
+		},
+		"jobRun": map[string]interface{}{
+			"meta": map[string]interface{}{},
+		},
+	}
+	for k, v := range fixtures.Vars {
+		vars[k] = v
+	}
+
+	run, _, err := app.pipelineRunner.ExecuteRun(pipeline.ContextWithSimulation(ctx, fixtures), spec, pipeline.NewVarsFrom(vars))
+	return run, err
+}
+
+func (app *ChainlinkApplication) ReplayRunV2(ctx context.Context, runID int64) (*pipeline.Run, []pipeline.ReplayTaskDiff, error) {
+	return app.pipelineRunner.ReplayRun(ctx, runID)
+}
+
+func (app *ChainlinkApplication) CancelRunV2(ctx context.Context, runID int64) error {
+	return app.pipelineRunner.CancelRun(ctx, runID)
+}
+
+func (app *ChainlinkApplication) ResumeWorkflowExecution(ctx context.Context, executionID string) error {
+	execution, err := app.workflowORM.Get(ctx, executionID)
+	if err != nil {
+		return err
+	}
+	engine, err := app.workflowEngines.Get(execution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	return engine.ResumeExecution(ctx, executionID)
+}
+
+func (app *ChainlinkApplication) RerunWorkflowExecution(ctx context.Context, executionID string) (string, error) {
+	execution, err := app.workflowORM.Get(ctx, executionID)
+	if err != nil {
+		return "", err
+	}
+	engine, err := app.workflowEngines.Get(execution.WorkflowID)
+	if err != nil {
+		return "", err
+	}
+	return engine.RerunExecution(ctx, executionID)
+}
+
+func (app *ChainlinkApplication) UpgradeWorkflow(ctx context.Context, jobID int32, jb *job.Job) error {
+	current, err := app.findUnmanagedWorkflowJob(ctx, jobID, "upgraded")
+	if err != nil {
+		return err
+	}
+	if err = workflows.ValidateUpgrade(current, *jb); err != nil {
+		return err
+	}
+
+	err = sqlutil.TransactDataSource(ctx, app.ds, nil, func(tx sqlutil.DataSource) error {
+		orm := app.jobORM.WithDataSource(tx)
+		if err := orm.SetWorkflowSpecRetired(ctx, *current.WorkflowSpecID, true); err != nil {
+			return err
+		}
+		return orm.CreateJob(ctx, jb)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The new version only starts once the current one is drained, so that both never handle the same trigger events.
+	// The engine isn't running if the workflow failed to start, in which case there's nothing to drain.
+	if engine, err := app.workflowEngines.Get(current.WorkflowSpec.WorkflowID); err == nil {
+		engine.Drain(ctx)
+	}
+	if err = app.jobSpawner.StartService(ctx, *jb); err != nil {
+		return fmt.Errorf("failed to start workflow job %d: %w", jb.ID, err)
+	}
+	return nil
+}
+
+func (app *ChainlinkApplication) RollbackWorkflow(ctx context.Context, jobID int32) (job.Job, error) {
+	current, err := app.findUnmanagedWorkflowJob(ctx, jobID, "rolled back")
+	if err != nil {
+		return job.Job{}, err
+	}
+	if current.WorkflowSpec.Retired {
+		return job.Job{}, fmt.Errorf("%w: job %d", workflows.ErrWorkflowVersionRetired, jobID)
+	}
+
+	jobIDs, err := app.jobORM.FindJobIDsByWorkflowVersions(ctx, *current.WorkflowSpec)
+	if err != nil {
+		return job.Job{}, err
+	}
+	var previousJobID int32
+	for _, id := range jobIDs {
+		if id != jobID {
+			previousJobID = id
+			break
+		}
+	}
+	if previousJobID == 0 {
+		return job.Job{}, fmt.Errorf("%w: job %d", workflows.ErrNoPreviousWorkflowVersion, jobID)
+	}
+	previous, err := app.jobORM.FindJob(ctx, previousJobID)
+	if err != nil {
+		return job.Job{}, err
+	}
+	// The previous version has to be running to take over from the current one.
+	previousEngine, err := app.workflowEngines.Get(previous.WorkflowSpec.WorkflowID)
+	if err != nil {
+		return job.Job{}, err
+	}
+
+	err = sqlutil.TransactDataSource(ctx, app.ds, nil, func(tx sqlutil.DataSource) error {
+		orm := app.jobORM.WithDataSource(tx)
+		if err := orm.SetWorkflowSpecRetired(ctx, *current.WorkflowSpecID, true); err != nil {
+			return err
+		}
+		return orm.SetWorkflowSpecRetired(ctx, *previous.WorkflowSpecID, false)
+	})
+	if err != nil {
+		return job.Job{}, err
+	}
+
+	if engine, err := app.workflowEngines.Get(current.WorkflowSpec.WorkflowID); err == nil {
+		engine.Drain(ctx)
+	}
+	previousEngine.Activate(ctx)
+	previous.WorkflowSpec.Retired = false
+	return previous, nil
+}
+
+func (app *ChainlinkApplication) WorkflowVersions(ctx context.Context, jobID int32) ([]job.Job, error) {
+	jb, err := app.jobORM.FindJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if jb.WorkflowSpec == nil {
+		return nil, fmt.Errorf("%w: job %d", workflows.ErrNotWorkflowJob, jobID)
+	}
+	jobIDs, err := app.jobORM.FindJobIDsByWorkflowVersions(ctx, *jb.WorkflowSpec)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]job.Job, len(jobIDs))
+	for i, id := range jobIDs {
+		if versions[i], err = app.jobORM.FindJob(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	return versions, nil
+}
+
+// findUnmanagedWorkflowJob returns the workflow job with the given ID, unless it's managed by the Feeds Manager,
+// where its versions have to be managed instead.
+func (app *ChainlinkApplication) findUnmanagedWorkflowJob(ctx context.Context, jobID int32, action string) (job.Job, error) {
+	jb, err := app.jobORM.FindJob(ctx, jobID)
+	if err != nil {
+		return job.Job{}, err
+	}
+	if jb.WorkflowSpec == nil {
+		return job.Job{}, fmt.Errorf("%w: job %d", workflows.ErrNotWorkflowJob, jobID)
+	}
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
+	if err != nil {
+		return job.Job{}, err
+	}
+	if isManaged {
+		return job.Job{}, fmt.Errorf("job must be %s in the feeds manager", action)
+	}
+	return jb, nil
+}
+
+func (app *ChainlinkApplication) JobStatsV2(jobID int32) pipeline.JobStats {
+	return app.pipelineRunner.JobStats(jobID)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -1,6 +1,8 @@
 package chainlink
 
 import (
+	"time"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
@@ -68,6 +70,22 @@ func (c *capabilitiesConfig) GatewayConnector() config.GatewayConnector {
 	}
 }
 
+func (c *capabilitiesConfig) Workflows() config.Workflows {
+	return &capabilitiesWorkflows{c: c.c.Workflows}
+}
+
+type capabilitiesWorkflows struct {
+	c toml.Workflows
+}
+
+func (w *capabilitiesWorkflows) ExecutionsReaperInterval() time.Duration {
+	return w.c.ExecutionsReaperInterval.Duration()
+}
+
+func (w *capabilitiesWorkflows) ExecutionsReaperThreshold() time.Duration {
+	return w.c.ExecutionsReaperThreshold.Duration()
+}
+
 type capabilitiesExternalRegistry struct {
 	c toml.ExternalRegistry
 }
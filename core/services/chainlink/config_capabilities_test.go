@@ -43,4 +43,8 @@ func TestCapabilitiesConfig(t *testing.T) {
 	assert.Equal(t, time.Minute, v2.DeltaDial().Duration())
 	assert.Equal(t, 2*time.Second, v2.DeltaReconcile().Duration())
 	assert.Equal(t, []string{"foo", "bar"}, v2.ListenAddresses())
+
+	workflows := cfg.Capabilities().Workflows()
+	assert.Equal(t, 10*time.Minute, workflows.ExecutionsReaperInterval())
+	assert.Equal(t, 24*time.Hour, workflows.ExecutionsReaperThreshold())
 }
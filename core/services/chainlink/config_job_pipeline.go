@@ -14,6 +14,14 @@ type jobPipelineConfig struct {
 	c toml.JobPipeline
 }
 
+func (j *jobPipelineConfig) BridgeCircuitBreakerFailureThreshold() uint32 {
+	return *j.c.BridgeCircuitBreaker.FailureThreshold
+}
+
+func (j *jobPipelineConfig) BridgeCircuitBreakerOpenTimeout() time.Duration {
+	return j.c.BridgeCircuitBreaker.OpenTimeout.Duration()
+}
+
 func (j *jobPipelineConfig) DefaultHTTPLimit() int64 {
 	return int64(*j.c.HTTPRequest.MaxSize)
 }
@@ -30,6 +38,10 @@ func (j *jobPipelineConfig) MaxSuccessfulRuns() uint64 {
 	return *j.c.MaxSuccessfulRuns
 }
 
+func (j *jobPipelineConfig) MaxSuspendDuration() time.Duration {
+	return j.c.MaxSuspendDuration.Duration()
+}
+
 func (j *jobPipelineConfig) ReaperInterval() time.Duration {
 	return j.c.ReaperInterval.Duration()
 }
@@ -38,6 +50,10 @@ func (j *jobPipelineConfig) ReaperThreshold() time.Duration {
 	return j.c.ReaperThreshold.Duration()
 }
 
+func (j *jobPipelineConfig) ReplayBundlesEnabled() bool {
+	return *j.c.ReplayBundlesEnabled
+}
+
 func (j *jobPipelineConfig) ResultWriteQueueDepth() uint64 {
 	return uint64(*j.c.ResultWriteQueueDepth)
 }
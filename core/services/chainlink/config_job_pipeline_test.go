@@ -26,8 +26,12 @@ func TestJobPipelineConfigTest(t *testing.T) {
 	assert.Equal(t, d, jp.DefaultHTTPTimeout())
 	assert.Equal(t, 1*time.Hour, jp.MaxRunDuration())
 	assert.Equal(t, uint64(123456), jp.MaxSuccessfulRuns())
+	assert.Equal(t, time.Hour, jp.MaxSuspendDuration())
 	assert.Equal(t, 4*time.Hour, jp.ReaperInterval())
 	assert.Equal(t, 168*time.Hour, jp.ReaperThreshold())
+	assert.True(t, jp.ReplayBundlesEnabled())
+	assert.Equal(t, uint32(5), jp.BridgeCircuitBreakerFailureThreshold())
+	assert.Equal(t, time.Minute, jp.BridgeCircuitBreakerOpenTimeout())
 	assert.Equal(t, uint64(10), jp.ResultWriteQueueDepth())
 	assert.True(t, jp.ExternalInitiatorsEnabled())
 }
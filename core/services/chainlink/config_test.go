@@ -413,14 +413,20 @@ func TestConfig_Marshal(t *testing.T) {
 		ExternalInitiatorsEnabled: ptr(true),
 		MaxRunDuration:            commoncfg.MustNewDuration(time.Hour),
 		MaxSuccessfulRuns:         ptr[uint64](123456),
+		MaxSuspendDuration:        commoncfg.MustNewDuration(time.Hour),
 		ReaperInterval:            commoncfg.MustNewDuration(4 * time.Hour),
 		ReaperThreshold:           commoncfg.MustNewDuration(7 * 24 * time.Hour),
+		ReplayBundlesEnabled:      ptr(true),
 		ResultWriteQueueDepth:     ptr[uint32](10),
 		VerboseLogging:            ptr(false),
 		HTTPRequest: toml.JobPipelineHTTPRequest{
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
 		},
+		BridgeCircuitBreaker: toml.JobPipelineBridgeCircuitBreaker{
+			FailureThreshold: ptr[uint32](5),
+			OpenTimeout:      commoncfg.MustNewDuration(time.Minute),
+		},
 	}
 	full.FluxMonitor = toml.FluxMonitor{
 		DefaultTransactionQueueDepth: ptr[uint32](100),
@@ -515,6 +521,10 @@ func TestConfig_Marshal(t *testing.T) {
 				{ID: ptr("example_gateway"), URL: ptr("wss://localhost:8081/node")},
 			},
 		},
+		Workflows: toml.Workflows{
+			ExecutionsReaperInterval:  commoncfg.MustNewDuration(10 * time.Minute),
+			ExecutionsReaperThreshold: commoncfg.MustNewDuration(24 * time.Hour),
+		},
 	}
 	full.Keeper = toml.Keeper{
 		DefaultTransactionQueueDepth: ptr[uint32](17),
@@ -656,6 +666,28 @@ func TestConfig_Marshal(t *testing.T) {
 					AutoPurge: evmcfg.AutoPurgeConfig{
 						Enabled: ptr(false),
 					},
+					Batching: evmcfg.BatchingConfig{
+						Multicall3Address: mustAddress("0xF9cda624FBC7e059355ce98a31693d299FACd963"),
+						MaxCalls:          ptr[uint32](5),
+					},
+					PriorityLanes: evmcfg.PriorityLanesConfig{
+						CriticalMaxQueued:     ptr[uint32](10),
+						NormalMaxQueued:       ptr[uint32](50),
+						BulkMaxQueued:         ptr[uint32](30),
+						CriticalBumpThreshold: ptr[uint32](3),
+					},
+					Simulation: evmcfg.SimulationConfig{
+						Enabled:  ptr(true),
+						OnRevert: ptr("FatalError"),
+					},
+					StatusEvents: evmcfg.StatusEventsConfig{
+						Enabled:             ptr(true),
+						WebhookURL:          mustURL("https://tx.status/events"),
+						PollInterval:        commoncfg.MustNewDuration(2 * time.Second),
+						RetryInterval:       commoncfg.MustNewDuration(30 * time.Second),
+						MaxDeliveryAttempts: ptr[uint32](5),
+						ReaperThreshold:     commoncfg.MustNewDuration(72 * time.Hour),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -990,14 +1022,20 @@ SimulateTransactions = true
 ExternalInitiatorsEnabled = true
 MaxRunDuration = '1h0m0s'
 MaxSuccessfulRuns = 123456
+MaxSuspendDuration = '1h0m0s'
 ReaperInterval = '4h0m0s'
 ReaperThreshold = '168h0m0s'
+ReplayBundlesEnabled = true
 ResultWriteQueueDepth = 10
 VerboseLogging = false
 
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[JobPipeline.BridgeCircuitBreaker]
+FailureThreshold = 5
+OpenTimeout = '1m0s'
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -1118,6 +1156,28 @@ ResendAfterThreshold = '1h0m0s'
 [EVM.Transactions.AutoPurge]
 Enabled = false
 
+[EVM.Transactions.Batching]
+Multicall3Address = '0xF9cda624FBC7e059355ce98a31693d299FACd963'
+MaxCalls = 5
+
+[EVM.Transactions.PriorityLanes]
+CriticalMaxQueued = 10
+NormalMaxQueued = 50
+BulkMaxQueued = 30
+CriticalBumpThreshold = 3
+
+[EVM.Transactions.Simulation]
+Enabled = true
+OnRevert = 'FatalError'
+
+[EVM.Transactions.StatusEvents]
+Enabled = true
+WebhookURL = 'https://tx.status/events'
+PollInterval = '2s'
+RetryInterval = '30s'
+MaxDeliveryAttempts = 5
+ReaperThreshold = '72h0m0s'
+
 [EVM.BalanceMonitor]
 Enabled = true
 
@@ -1976,6 +1976,50 @@ func Test_ORM_FindJobByWorkflow_Multiple(t *testing.T) {
 	})
 }
 
+func Test_ORM_FindJobIDsByWorkflowVersions(t *testing.T) {
+	var addr1 = "0x012345678901234567890123456789012345ffff"
+	t.Parallel()
+	db := pgtest.NewSqlxDB(t)
+	o := NewTestORM(t,
+		db,
+		pipeline.NewORM(db,
+			logger.TestLogger(t),
+			configtest.NewTestGeneralConfig(t).JobPipeline().MaxSuccessfulRuns()),
+		bridges.NewORM(db),
+		cltest.NewKeyStore(t, db))
+	ctx := testutils.Context(t)
+
+	wfYaml := pkgworkflows.WFYamlSpec(t, "workflow00", addr1)
+	v1 := job.WorkflowSpec{Workflow: wfYaml, SpecType: job.YamlSpec, WorkflowVersion: "1"}
+	v1JobID := mustInsertWFJob(t, o, &v1)
+
+	// the active version has to be retired before another one is deployed
+	v2 := job.WorkflowSpec{Workflow: wfYaml + "\n# v2\n", SpecType: job.YamlSpec, WorkflowVersion: "2"}
+	require.NoError(t, v2.Validate(ctx))
+	err := o.CreateJob(ctx, &job.Job{Type: job.Workflow, WorkflowSpec: &v2, ExternalJobID: uuid.New(), SchemaVersion: 1})
+	require.Error(t, err)
+
+	v1Job, err := o.FindJob(ctx, v1JobID)
+	require.NoError(t, err)
+	require.NoError(t, o.SetWorkflowSpecRetired(ctx, *v1Job.WorkflowSpecID, true))
+	v2JobID := mustInsertWFJob(t, o, &v2)
+
+	jobIDs, err := o.FindJobIDsByWorkflowVersions(ctx, v1)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{v2JobID, v1JobID}, jobIDs)
+
+	activeJobID, err := o.FindJobIDByWorkflow(ctx, v1)
+	require.NoError(t, err)
+	assert.Equal(t, v2JobID, activeJobID)
+
+	v1Job, err = o.FindJob(ctx, v1JobID)
+	require.NoError(t, err)
+	assert.True(t, v1Job.WorkflowSpec.Retired)
+	assert.Equal(t, "1", v1Job.WorkflowSpec.WorkflowVersion)
+
+	require.ErrorIs(t, o.SetWorkflowSpecRetired(ctx, -1, true), sql.ErrNoRows)
+}
+
 func mustInsertWFJob(t *testing.T, orm job.ORM, s *job.WorkflowSpec) int32 {
 	t.Helper()
 	err := s.Validate(testutils.Context(t))
@@ -657,6 +657,65 @@ func (_c *ORM_FindJobIDByWorkflow_Call) RunAndReturn(run func(context.Context, j
 	return _c
 }
 
+// FindJobIDsByWorkflowVersions provides a mock function with given fields: ctx, spec
+func (_m *ORM) FindJobIDsByWorkflowVersions(ctx context.Context, spec job.WorkflowSpec) ([]int32, error) {
+	ret := _m.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobIDsByWorkflowVersions")
+	}
+
+	var r0 []int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.WorkflowSpec) ([]int32, error)); ok {
+		return rf(ctx, spec)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.WorkflowSpec) []int32); ok {
+		r0 = rf(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.WorkflowSpec) error); ok {
+		r1 = rf(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindJobIDsByWorkflowVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobIDsByWorkflowVersions'
+type ORM_FindJobIDsByWorkflowVersions_Call struct {
+	*mock.Call
+}
+
+// FindJobIDsByWorkflowVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec job.WorkflowSpec
+func (_e *ORM_Expecter) FindJobIDsByWorkflowVersions(ctx interface{}, spec interface{}) *ORM_FindJobIDsByWorkflowVersions_Call {
+	return &ORM_FindJobIDsByWorkflowVersions_Call{Call: _e.mock.On("FindJobIDsByWorkflowVersions", ctx, spec)}
+}
+
+func (_c *ORM_FindJobIDsByWorkflowVersions_Call) Run(run func(ctx context.Context, spec job.WorkflowSpec)) *ORM_FindJobIDsByWorkflowVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.WorkflowSpec))
+	})
+	return _c
+}
+
+func (_c *ORM_FindJobIDsByWorkflowVersions_Call) Return(_a0 []int32, _a1 error) *ORM_FindJobIDsByWorkflowVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindJobIDsByWorkflowVersions_Call) RunAndReturn(run func(context.Context, job.WorkflowSpec) ([]int32, error)) *ORM_FindJobIDsByWorkflowVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindJobIDsWithBridge provides a mock function with given fields: ctx, name
 func (_m *ORM) FindJobIDsWithBridge(ctx context.Context, name string) ([]int32, error) {
 	ret := _m.Called(ctx, name)
@@ -1520,6 +1579,54 @@ func (_c *ORM_RecordError_Call) RunAndReturn(run func(context.Context, int32, st
 	return _c
 }
 
+// SetWorkflowSpecRetired provides a mock function with given fields: ctx, specID, retired
+func (_m *ORM) SetWorkflowSpecRetired(ctx context.Context, specID int32, retired bool) error {
+	ret := _m.Called(ctx, specID, retired)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetWorkflowSpecRetired")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, bool) error); ok {
+		r0 = rf(ctx, specID, retired)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_SetWorkflowSpecRetired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWorkflowSpecRetired'
+type ORM_SetWorkflowSpecRetired_Call struct {
+	*mock.Call
+}
+
+// SetWorkflowSpecRetired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - specID int32
+//   - retired bool
+func (_e *ORM_Expecter) SetWorkflowSpecRetired(ctx interface{}, specID interface{}, retired interface{}) *ORM_SetWorkflowSpecRetired_Call {
+	return &ORM_SetWorkflowSpecRetired_Call{Call: _e.mock.On("SetWorkflowSpecRetired", ctx, specID, retired)}
+}
+
+func (_c *ORM_SetWorkflowSpecRetired_Call) Run(run func(ctx context.Context, specID int32, retired bool)) *ORM_SetWorkflowSpecRetired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ORM_SetWorkflowSpecRetired_Call) Return(_a0 error) *ORM_SetWorkflowSpecRetired_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_SetWorkflowSpecRetired_Call) RunAndReturn(run func(context.Context, int32, bool) error) *ORM_SetWorkflowSpecRetired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TryRecordError provides a mock function with given fields: ctx, jobID, description
 func (_m *ORM) TryRecordError(ctx context.Context, jobID int32, description string) {
 	_m.Called(ctx, jobID, description)
@@ -29,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowsecret"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/signatures/secp256k1"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -652,6 +653,10 @@ type BlockhashStoreSpec struct {
 	// FromAddress is the sender address that should be used to store blockhashes.
 	FromAddresses []evmtypes.EIP55Address `toml:"fromAddresses"`
 
+	// BatchTransactions merges the blockhash stores into Multicall3 batch transactions, of at most
+	// the chain's Transactions.Batching.MaxCalls stores each.
+	BatchTransactions bool `toml:"batchTransactions"`
+
 	// CreatedAt is the time this job was created.
 	CreatedAt time.Time `toml:"-"`
 
@@ -868,6 +873,29 @@ const (
 	DefaultSpecType                  = ""
 )
 
+// WorkflowConcurrencyMode controls what happens to the trigger events of a workflow
+// received while its maximum number of concurrent executions are in progress.
+type WorkflowConcurrencyMode string
+
+const (
+	// WorkflowConcurrencyModeQueue queues events until an execution finishes. This is the default.
+	WorkflowConcurrencyModeQueue WorkflowConcurrencyMode = "queue"
+	// WorkflowConcurrencyModeDrop drops events.
+	WorkflowConcurrencyModeDrop WorkflowConcurrencyMode = "drop"
+	// WorkflowConcurrencyModeQueueLatest queues the latest event only, replacing any event queued before it.
+	WorkflowConcurrencyModeQueueLatest WorkflowConcurrencyMode = "queue_latest"
+	// WorkflowConcurrencyModeCancelPrevious cancels the oldest execution in progress in favour of the event.
+	WorkflowConcurrencyModeCancelPrevious WorkflowConcurrencyMode = "cancel_previous"
+)
+
+func (m WorkflowConcurrencyMode) isValid() bool {
+	switch m {
+	case "", WorkflowConcurrencyModeQueue, WorkflowConcurrencyModeDrop, WorkflowConcurrencyModeQueueLatest, WorkflowConcurrencyModeCancelPrevious:
+		return true
+	}
+	return false
+}
+
 type WorkflowSpec struct {
 	ID       int32  `toml:"-"`
 	Workflow string `toml:"workflow"`           // the raw representation of the workflow
@@ -880,8 +908,18 @@ type WorkflowSpec struct {
 	CreatedAt     time.Time        `toml:"-"`
 	UpdatedAt     time.Time        `toml:"-"`
 	SpecType      WorkflowSpecType `toml:"spec_type" db:"spec_type"`
-	sdkWorkflow   *sdk.WorkflowSpec
-	rawSpec       []byte
+	// MaxConcurrentExecutions limits the number of executions of the workflow in progress at once; zero means unlimited.
+	MaxConcurrentExecutions uint32                  `toml:"max_concurrent_executions" db:"max_concurrent_executions"`
+	ConcurrencyMode         WorkflowConcurrencyMode `toml:"concurrency_mode" db:"concurrency_mode"`
+	// WorkflowVersion tells apart the versions of a workflow, which share its owner and name.
+	WorkflowVersion string `toml:"workflow_version" db:"workflow_version"`
+	// AllowedSecrets are the names of the workflow secrets of the node which the workflow may reference.
+	AllowedSecrets pq.StringArray `toml:"allowed_secrets" db:"allowed_secrets"`
+	// Retired is set once a newer version of the workflow is deployed, or it's rolled back.
+	// A retired version takes no new trigger events, and only runs its executions in progress to completion.
+	Retired     bool `toml:"-" db:"retired"`
+	sdkWorkflow *sdk.WorkflowSpec
+	rawSpec     []byte
 }
 
 var (
@@ -907,6 +945,19 @@ func (w *WorkflowSpec) Validate(ctx context.Context) error {
 		return fmt.Errorf("%w: incorrect length for id %s: expected %d, got %d", ErrInvalidWorkflowID, w.WorkflowID, workflowIDLen, len(w.WorkflowID))
 	}
 
+	if !w.ConcurrencyMode.isValid() {
+		return fmt.Errorf("invalid concurrency_mode %q: expected one of %q, %q, %q or %q", w.ConcurrencyMode,
+			WorkflowConcurrencyModeQueue, WorkflowConcurrencyModeDrop, WorkflowConcurrencyModeQueueLatest, WorkflowConcurrencyModeCancelPrevious)
+	}
+	if w.ConcurrencyMode != "" && w.MaxConcurrentExecutions == 0 {
+		return errors.New("concurrency_mode requires max_concurrent_executions to be set")
+	}
+	for _, name := range w.AllowedSecrets {
+		if err = workflowsecret.ValidateName(name); err != nil {
+			return fmt.Errorf("invalid allowed_secrets: %w", err)
+		}
+	}
+
 	return nil
 }
 
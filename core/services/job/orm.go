@@ -78,6 +78,10 @@ type ORM interface {
 	WithDataSource(source sqlutil.DataSource) ORM
 
 	FindJobIDByWorkflow(ctx context.Context, spec WorkflowSpec) (int32, error)
+	// FindJobIDsByWorkflowVersions returns the jobs of all the versions of the workflow of spec: the active one first,
+	// followed by the retired ones, most recently retired first.
+	FindJobIDsByWorkflowVersions(ctx context.Context, spec WorkflowSpec) ([]int32, error)
+	SetWorkflowSpecRetired(ctx context.Context, specID int32, retired bool) error
 	FindJobIDByCapabilityNameAndVersion(ctx context.Context, spec CCIPSpec) (int32, error)
 }
 
@@ -408,8 +412,8 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 		case Stream:
 			// 'stream' type has no associated spec, nothing to do here
 		case Workflow:
-			sql := `INSERT INTO workflow_specs (workflow, workflow_id, workflow_owner, workflow_name, created_at, updated_at, spec_type, config)
-			VALUES (:workflow, :workflow_id, :workflow_owner, :workflow_name, NOW(), NOW(), :spec_type, :config)
+			sql := `INSERT INTO workflow_specs (workflow, workflow_id, workflow_owner, workflow_name, created_at, updated_at, spec_type, config, max_concurrent_executions, concurrency_mode, workflow_version, allowed_secrets)
+			VALUES (:workflow, :workflow_id, :workflow_owner, :workflow_name, NOW(), NOW(), :spec_type, :config, :max_concurrent_executions, :concurrency_mode, :workflow_version, :allowed_secrets)
 			RETURNING id;`
 			specID, err := tx.prepareQuerySpecID(ctx, sql, jb.WorkflowSpec)
 			if err != nil {
@@ -551,8 +555,8 @@ func (o *orm) insertVRFSpec(ctx context.Context, spec *VRFSpec) (specID int32, e
 }
 
 func (o *orm) insertBlockhashStoreSpec(ctx context.Context, spec *BlockhashStoreSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO blockhash_store_specs (coordinator_v1_address, coordinator_v2_address, coordinator_v2_plus_address, trusted_blockhash_store_address, trusted_blockhash_store_batch_size, wait_blocks, lookback_blocks, heartbeat_period, blockhash_store_address, poll_period, run_timeout, evm_chain_id, from_addresses, created_at, updated_at)
-			VALUES (:coordinator_v1_address, :coordinator_v2_address, :coordinator_v2_plus_address, :trusted_blockhash_store_address, :trusted_blockhash_store_batch_size, :wait_blocks, :lookback_blocks, :heartbeat_period, :blockhash_store_address, :poll_period, :run_timeout, :evm_chain_id, :from_addresses, NOW(), NOW())
+	return o.prepareQuerySpecID(ctx, `INSERT INTO blockhash_store_specs (coordinator_v1_address, coordinator_v2_address, coordinator_v2_plus_address, trusted_blockhash_store_address, trusted_blockhash_store_batch_size, wait_blocks, lookback_blocks, heartbeat_period, blockhash_store_address, poll_period, run_timeout, evm_chain_id, from_addresses, batch_transactions, created_at, updated_at)
+			VALUES (:coordinator_v1_address, :coordinator_v2_address, :coordinator_v2_plus_address, :trusted_blockhash_store_address, :trusted_blockhash_store_batch_size, :wait_blocks, :lookback_blocks, :heartbeat_period, :blockhash_store_address, :poll_period, :run_timeout, :evm_chain_id, :from_addresses, :batch_transactions, NOW(), NOW())
 			RETURNING id;`, toBlockhashStoreSpecRow(spec))
 }
 
@@ -1105,7 +1109,7 @@ func (o *orm) FindJobIDsWithBridge(ctx context.Context, name string) (jids []int
 func (o *orm) FindJobIDByWorkflow(ctx context.Context, spec WorkflowSpec) (jobID int32, err error) {
 	stmt := `
 SELECT jobs.id FROM jobs
-INNER JOIN workflow_specs ws on jobs.workflow_spec_id = ws.id AND ws.workflow_owner = $1 AND ws.workflow_name = $2
+INNER JOIN workflow_specs ws on jobs.workflow_spec_id = ws.id AND ws.workflow_owner = $1 AND ws.workflow_name = $2 AND NOT ws.retired
 `
 	err = o.ds.GetContext(ctx, &jobID, stmt, spec.WorkflowOwner, spec.WorkflowName)
 	if err != nil {
@@ -1119,6 +1123,35 @@ INNER JOIN workflow_specs ws on jobs.workflow_spec_id = ws.id AND ws.workflow_ow
 	return
 }
 
+func (o *orm) FindJobIDsByWorkflowVersions(ctx context.Context, spec WorkflowSpec) (jobIDs []int32, err error) {
+	stmt := `
+SELECT jobs.id FROM jobs
+INNER JOIN workflow_specs ws on jobs.workflow_spec_id = ws.id AND ws.workflow_owner = $1 AND ws.workflow_name = $2
+ORDER BY ws.retired ASC, ws.updated_at DESC, jobs.id DESC
+`
+	err = o.ds.SelectContext(ctx, &jobIDs, stmt, spec.WorkflowOwner, spec.WorkflowName)
+	if err != nil {
+		err = fmt.Errorf("error searching for the versions of workflow (owner,name) ('%s','%s'): %w", spec.WorkflowOwner, spec.WorkflowName, err)
+	}
+	return
+}
+
+// SetWorkflowSpecRetired retires a version of a workflow, or reinstates it.
+func (o *orm) SetWorkflowSpecRetired(ctx context.Context, specID int32, retired bool) error {
+	res, err := o.ds.ExecContext(ctx, `UPDATE workflow_specs SET retired = $1, updated_at = NOW() WHERE id = $2`, retired, specID)
+	if err != nil {
+		return fmt.Errorf("failed to update workflow spec %d: %w", specID, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("workflow spec %d: %w", specID, sql.ErrNoRows)
+	}
+	return nil
+}
+
 func (o *orm) FindJobIDByCapabilityNameAndVersion(ctx context.Context, spec CCIPSpec) (jobID int32, err error) {
 	stmt := `
 SELECT jobs.id FROM jobs
@@ -32,8 +32,9 @@ type (
 		ActiveJobs() map[int32]Job
 
 		// StartService starts services for the given job spec.
-		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
-		// to start a job that was previously manually inserted into DB
+		// NOTE: Prefer to use CreateJob, this is only publicly exposed to start a job
+		// that was previously inserted into DB, e.g. in tests or by a workflow upgrade
+		// which starts the new version only once the old one is drained
 		StartService(ctx context.Context, spec Job) error
 	}
 
@@ -84,6 +84,14 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 	}
 	svcLogger.Info("Registry version is: ", registryWrapper.Version)
 
+	registryABI, err := registryWrapper.ABI()
+	if err != nil {
+		return nil, err
+	}
+	if err = chain.TxManager().RegisterRevertABI(registryAddress.Address(), registryABI); err != nil {
+		return nil, errors.Wrap(err, "unable to register keeper registry ABI")
+	}
+
 	minIncomingConfirmations := chain.Config().EVM().MinIncomingConfirmations()
 	if spec.KeeperSpec.MinIncomingConfirmations != nil {
 		minIncomingConfirmations = *spec.KeeperSpec.MinIncomingConfirmations
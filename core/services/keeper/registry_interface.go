@@ -135,6 +135,20 @@ func newUnsupportedVersionError(functionName string, version RegistryVersion) er
 	return errors.Errorf("Registry version %d does not support %s", version, functionName)
 }
 
+// ABI returns the JSON ABI of the registry, used to decode its custom errors
+func (rw *RegistryWrapper) ABI() (string, error) {
+	switch rw.Version {
+	case RegistryVersion_1_0, RegistryVersion_1_1:
+		return registry1_1.KeeperRegistryABI, nil
+	case RegistryVersion_1_2:
+		return registry1_2.KeeperRegistryABI, nil
+	case RegistryVersion_1_3:
+		return registry1_3.KeeperRegistryABI, nil
+	default:
+		return "", newUnsupportedVersionError("ABI", rw.Version)
+	}
+}
+
 // getUpkeepCount retrieves the number of upkeeps
 func (rw *RegistryWrapper) getUpkeepCount(opts *bind.CallOpts) (*big.Int, error) {
 	switch rw.Version {
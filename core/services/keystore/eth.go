@@ -11,6 +11,8 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -35,6 +37,7 @@ type Eth interface {
 	SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, unsub func())
 
 	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignTypedData(ctx context.Context, address common.Address, typedData apitypes.TypedData) ([]byte, error)
 
 	EnabledKeysForChain(ctx context.Context, chainID *big.Int) (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
@@ -321,6 +324,29 @@ func (ks *eth) SignTx(ctx context.Context, address common.Address, tx *types.Tra
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
 
+// SignTypedData signs the EIP-712 hash of the given typed data, returning the signature with a recovery ID of 27 or 28
+func (ks *eth) SignTypedData(ctx context.Context, address common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hash typed data")
+	}
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(address.String())
+	if err != nil {
+		return nil, err
+	}
+	signature, err := crypto.Sign(hash, key.ToEcdsaPrivKey())
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
 // EnabledKeysForChain returns all keys that are enabled for the given chain
 func (ks *eth) EnabledKeysForChain(ctx context.Context, chainID *big.Int) (sendingKeys []ethkey.KeyV2, err error) {
 	if chainID == nil {
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -357,6 +359,42 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_SignTypedData(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	ethKeyStore := keyStore.Eth()
+
+	k, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}},
+			"Message":      {{Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Message",
+		Domain:      apitypes.TypedDataDomain{Name: "Test"},
+		Message:     apitypes.TypedDataMessage{"contents": "Hello"},
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+
+	_, err = ethKeyStore.SignTypedData(ctx, testutils.NewAddress(), typedData)
+	require.EqualError(t, err, "Key not found")
+
+	signature, err := ethKeyStore.SignTypedData(ctx, k.Address, typedData)
+	require.NoError(t, err)
+	require.Len(t, signature, 65)
+	require.Contains(t, []byte{27, 28}, signature[crypto.RecoveryIDOffset])
+	signature[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(hash, signature)
+	require.NoError(t, err)
+	assert.Equal(t, k.Address, crypto.PubkeyToAddress(*pubKey))
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,98 @@
+package workflowsecret
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+var nameRegex = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// Raw represents the encoded name and value of a workflow secret
+type Raw []byte
+
+type rawSecret struct {
+	Name  string
+	Value string
+}
+
+// Key gets the Secret
+func (raw Raw) Key() Secret {
+	var s rawSecret
+	if err := json.Unmarshal(raw, &s); err != nil {
+		panic(err)
+	}
+	return Secret{name: s.Name, value: s.Value}
+}
+
+// String returns description
+func (raw Raw) String() string {
+	return "<Workflow Raw Secret>"
+}
+
+// GoString wraps String()
+func (raw Raw) GoString() string {
+	return raw.String()
+}
+
+var _ fmt.GoStringer = &Secret{}
+
+// Secret is a named value, such as an API key, which workflow specs reference
+// instead of carrying it in plain text.
+type Secret struct {
+	name  string
+	value string
+}
+
+// ValidateName checks that name can name a Secret, and be referenced as `$(secrets.NAME)`
+func ValidateName(name string) error {
+	if !nameRegex.MatchString(name) {
+		return fmt.Errorf("invalid secret name %q: only letters, digits and underscores are allowed", name)
+	}
+	return nil
+}
+
+// New creates a new Secret, validating its name
+func New(name, value string) (Secret, error) {
+	if err := ValidateName(name); err != nil {
+		return Secret{}, err
+	}
+	if value == "" {
+		return Secret{}, fmt.Errorf("secret %s has an empty value", name)
+	}
+	return Secret{name: name, value: value}, nil
+}
+
+// ID gets the Secret ID, which is its name
+func (s Secret) ID() string {
+	return s.name
+}
+
+// Name gets the name of the Secret
+func (s Secret) Name() string {
+	return s.name
+}
+
+// Value gets the plain text value of the Secret
+func (s Secret) Value() string {
+	return s.value
+}
+
+// Raw returns the encoded name and value of the Secret
+func (s Secret) Raw() Raw {
+	raw, err := json.Marshal(rawSecret{Name: s.name, Value: s.value})
+	if err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// String is the print-friendly format of the Secret
+func (s Secret) String() string {
+	return fmt.Sprintf("WorkflowSecret{Name: %s, Value: <redacted>}", s.name)
+}
+
+// GoString wraps String()
+func (s Secret) GoString() string {
+	return s.String()
+}
@@ -0,0 +1,26 @@
+package workflowsecret
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecret(t *testing.T) {
+	s, err := New("API_KEY", "hunter2")
+	require.NoError(t, err)
+	assert.Equal(t, "API_KEY", s.ID())
+	assert.Equal(t, "hunter2", s.Value())
+	assert.Equal(t, s, s.Raw().Key())
+
+	assert.NotContains(t, s.String(), "hunter2")
+	assert.NotContains(t, fmt.Sprintf("%#v", s), "hunter2")
+	assert.NotContains(t, fmt.Sprintf("%v", s.Raw()), "hunter2")
+
+	_, err = New("api-key", "hunter2")
+	assert.ErrorContains(t, err, "invalid secret name")
+	_, err = New("API_KEY", "")
+	assert.ErrorContains(t, err, "empty value")
+}
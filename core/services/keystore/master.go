@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/solkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/starkkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowsecret"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -45,22 +46,24 @@ type Master interface {
 	StarkNet() StarkNet
 	Aptos() Aptos
 	VRF() VRF
+	WorkflowSecrets() WorkflowSecrets
 	Unlock(ctx context.Context, password string) error
 	IsEmpty(ctx context.Context) (bool, error)
 }
 
 type master struct {
 	*keyManager
-	cosmos   *cosmos
-	csa      *csa
-	eth      *eth
-	ocr      *ocr
-	ocr2     ocr2
-	p2p      *p2p
-	solana   *solana
-	starknet *starknet
-	aptos    *aptos
-	vrf      *vrf
+	cosmos          *cosmos
+	csa             *csa
+	eth             *eth
+	ocr             *ocr
+	ocr2            ocr2
+	p2p             *p2p
+	solana          *solana
+	starknet        *starknet
+	aptos           *aptos
+	vrf             *vrf
+	workflowSecrets *workflowSecrets
 }
 
 func New(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logger.Logger) Master {
@@ -78,17 +81,18 @@ func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logg
 	}
 
 	return &master{
-		keyManager: km,
-		cosmos:     newCosmosKeyStore(km),
-		csa:        newCSAKeyStore(km),
-		eth:        newEthKeyStore(km, orm, orm.ds),
-		ocr:        newOCRKeyStore(km),
-		ocr2:       newOCR2KeyStore(km),
-		p2p:        newP2PKeyStore(km),
-		solana:     newSolanaKeyStore(km),
-		starknet:   newStarkNetKeyStore(km),
-		aptos:      newAptosKeyStore(km),
-		vrf:        newVRFKeyStore(km),
+		keyManager:      km,
+		cosmos:          newCosmosKeyStore(km),
+		csa:             newCSAKeyStore(km),
+		eth:             newEthKeyStore(km, orm, orm.ds),
+		ocr:             newOCRKeyStore(km),
+		ocr2:            newOCR2KeyStore(km),
+		p2p:             newP2PKeyStore(km),
+		solana:          newSolanaKeyStore(km),
+		starknet:        newStarkNetKeyStore(km),
+		aptos:           newAptosKeyStore(km),
+		vrf:             newVRFKeyStore(km),
+		workflowSecrets: newWorkflowSecretsStore(km),
 	}
 }
 
@@ -132,6 +136,10 @@ func (ks *master) VRF() VRF {
 	return ks.vrf
 }
 
+func (ks *master) WorkflowSecrets() WorkflowSecrets {
+	return ks.workflowSecrets
+}
+
 type ORM interface {
 	isEmpty(context.Context) (bool, error)
 	saveEncryptedKeyRing(context.Context, *encryptedKeyRing, ...func(sqlutil.DataSource) error) error
@@ -267,6 +275,8 @@ func GetFieldNameForKey(unknownKey Key) (string, error) {
 		return "Aptos", nil
 	case vrfkey.KeyV2:
 		return "VRF", nil
+	case workflowsecret.Secret:
+		return "WorkflowSecrets", nil
 	}
 	return "", fmt.Errorf("unknown key type: %T", unknownKey)
 }
@@ -12,6 +12,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	apitypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+
 	types "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -1143,6 +1145,66 @@ func (_c *Eth_SignTx_Call) RunAndReturn(run func(context.Context, common.Address
 	return _c
 }
 
+// SignTypedData provides a mock function with given fields: ctx, address, typedData
+func (_m *Eth) SignTypedData(ctx context.Context, address common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	ret := _m.Called(ctx, address, typedData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignTypedData")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, apitypes.TypedData) ([]byte, error)); ok {
+		return rf(ctx, address, typedData)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, apitypes.TypedData) []byte); ok {
+		r0 = rf(ctx, address, typedData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, apitypes.TypedData) error); ok {
+		r1 = rf(ctx, address, typedData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Eth_SignTypedData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignTypedData'
+type Eth_SignTypedData_Call struct {
+	*mock.Call
+}
+
+// SignTypedData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+//   - typedData apitypes.TypedData
+func (_e *Eth_Expecter) SignTypedData(ctx interface{}, address interface{}, typedData interface{}) *Eth_SignTypedData_Call {
+	return &Eth_SignTypedData_Call{Call: _e.mock.On("SignTypedData", ctx, address, typedData)}
+}
+
+func (_c *Eth_SignTypedData_Call) Run(run func(ctx context.Context, address common.Address, typedData apitypes.TypedData)) *Eth_SignTypedData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(apitypes.TypedData))
+	})
+	return _c
+}
+
+func (_c *Eth_SignTypedData_Call) Return(_a0 []byte, _a1 error) *Eth_SignTypedData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Eth_SignTypedData_Call) RunAndReturn(run func(context.Context, common.Address, apitypes.TypedData) ([]byte, error)) *Eth_SignTypedData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SubscribeToKeyChanges provides a mock function with given fields: ctx
 func (_m *Eth) SubscribeToKeyChanges(ctx context.Context) (chan struct{}, func()) {
 	ret := _m.Called(ctx)
@@ -595,6 +595,53 @@ func (_c *Master_VRF_Call) RunAndReturn(run func() keystore.VRF) *Master_VRF_Cal
 	return _c
 }
 
+// WorkflowSecrets provides a mock function with given fields:
+func (_m *Master) WorkflowSecrets() keystore.WorkflowSecrets {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowSecrets")
+	}
+
+	var r0 keystore.WorkflowSecrets
+	if rf, ok := ret.Get(0).(func() keystore.WorkflowSecrets); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keystore.WorkflowSecrets)
+		}
+	}
+
+	return r0
+}
+
+// Master_WorkflowSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowSecrets'
+type Master_WorkflowSecrets_Call struct {
+	*mock.Call
+}
+
+// WorkflowSecrets is a helper method to define mock.On call
+func (_e *Master_Expecter) WorkflowSecrets() *Master_WorkflowSecrets_Call {
+	return &Master_WorkflowSecrets_Call{Call: _e.mock.On("WorkflowSecrets")}
+}
+
+func (_c *Master_WorkflowSecrets_Call) Run(run func()) *Master_WorkflowSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Master_WorkflowSecrets_Call) Return(_a0 keystore.WorkflowSecrets) *Master_WorkflowSecrets_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_WorkflowSecrets_Call) RunAndReturn(run func() keystore.WorkflowSecrets) *Master_WorkflowSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMaster creates a new instance of Master. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMaster(t interface {
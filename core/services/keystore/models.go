@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/solkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/starkkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowsecret"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -148,31 +149,33 @@ func (ks *keyStates) delete(addr common.Address) {
 }
 
 type keyRing struct {
-	CSA        map[string]csakey.KeyV2
-	Eth        map[string]ethkey.KeyV2
-	OCR        map[string]ocrkey.KeyV2
-	OCR2       map[string]ocr2key.KeyBundle
-	P2P        map[string]p2pkey.KeyV2
-	Cosmos     map[string]cosmoskey.Key
-	Solana     map[string]solkey.Key
-	StarkNet   map[string]starkkey.Key
-	Aptos      map[string]aptoskey.Key
-	VRF        map[string]vrfkey.KeyV2
-	LegacyKeys LegacyKeyStorage
+	CSA             map[string]csakey.KeyV2
+	Eth             map[string]ethkey.KeyV2
+	OCR             map[string]ocrkey.KeyV2
+	OCR2            map[string]ocr2key.KeyBundle
+	P2P             map[string]p2pkey.KeyV2
+	Cosmos          map[string]cosmoskey.Key
+	Solana          map[string]solkey.Key
+	StarkNet        map[string]starkkey.Key
+	Aptos           map[string]aptoskey.Key
+	VRF             map[string]vrfkey.KeyV2
+	WorkflowSecrets map[string]workflowsecret.Secret
+	LegacyKeys      LegacyKeyStorage
 }
 
 func newKeyRing() *keyRing {
 	return &keyRing{
-		CSA:      make(map[string]csakey.KeyV2),
-		Eth:      make(map[string]ethkey.KeyV2),
-		OCR:      make(map[string]ocrkey.KeyV2),
-		OCR2:     make(map[string]ocr2key.KeyBundle),
-		P2P:      make(map[string]p2pkey.KeyV2),
-		Cosmos:   make(map[string]cosmoskey.Key),
-		Solana:   make(map[string]solkey.Key),
-		StarkNet: make(map[string]starkkey.Key),
-		Aptos:    make(map[string]aptoskey.Key),
-		VRF:      make(map[string]vrfkey.KeyV2),
+		CSA:             make(map[string]csakey.KeyV2),
+		Eth:             make(map[string]ethkey.KeyV2),
+		OCR:             make(map[string]ocrkey.KeyV2),
+		OCR2:            make(map[string]ocr2key.KeyBundle),
+		P2P:             make(map[string]p2pkey.KeyV2),
+		Cosmos:          make(map[string]cosmoskey.Key),
+		Solana:          make(map[string]solkey.Key),
+		StarkNet:        make(map[string]starkkey.Key),
+		Aptos:           make(map[string]aptoskey.Key),
+		VRF:             make(map[string]vrfkey.KeyV2),
+		WorkflowSecrets: make(map[string]workflowsecret.Secret),
 	}
 }
 
@@ -236,6 +239,9 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, vrfKey := range kr.VRF {
 		rawKeys.VRF = append(rawKeys.VRF, vrfKey.Raw())
 	}
+	for _, secret := range kr.WorkflowSecrets {
+		rawKeys.WorkflowSecrets = append(rawKeys.WorkflowSecrets, secret.Raw())
+	}
 	return rawKeys
 }
 
@@ -281,6 +287,10 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	for _, VRFKey := range kr.VRF {
 		vrfIDs = append(vrfIDs, VRFKey.ID())
 	}
+	var secretNames []string
+	for _, secret := range kr.WorkflowSecrets {
+		secretNames = append(secretNames, secret.Name())
+	}
 	if len(csaIDs) > 0 {
 		lggr.Infow(fmt.Sprintf("Unlocked %d CSA keys", len(csaIDs)), "keys", csaIDs)
 	}
@@ -311,6 +321,9 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	if len(vrfIDs) > 0 {
 		lggr.Infow(fmt.Sprintf("Unlocked %d VRF keys", len(vrfIDs)), "keys", vrfIDs)
 	}
+	if len(secretNames) > 0 {
+		lggr.Infow(fmt.Sprintf("Unlocked %d workflow secrets", len(secretNames)), "names", secretNames)
+	}
 	if len(kr.LegacyKeys.legacyRawKeys) > 0 {
 		lggr.Infow(fmt.Sprintf("%d keys stored in legacy system", kr.LegacyKeys.legacyRawKeys.len()))
 	}
@@ -320,17 +333,18 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 // it holds only the essential key information to avoid adding unnecessary data
 // (like public keys) to the database
 type rawKeyRing struct {
-	Eth        []ethkey.Raw
-	CSA        []csakey.Raw
-	OCR        []ocrkey.Raw
-	OCR2       []ocr2key.Raw
-	P2P        []p2pkey.Raw
-	Cosmos     []cosmoskey.Raw
-	Solana     []solkey.Raw
-	StarkNet   []starkkey.Raw
-	Aptos      []aptoskey.Raw
-	VRF        []vrfkey.Raw
-	LegacyKeys LegacyKeyStorage `json:"-"`
+	Eth             []ethkey.Raw
+	CSA             []csakey.Raw
+	OCR             []ocrkey.Raw
+	OCR2            []ocr2key.Raw
+	P2P             []p2pkey.Raw
+	Cosmos          []cosmoskey.Raw
+	Solana          []solkey.Raw
+	StarkNet        []starkkey.Raw
+	Aptos           []aptoskey.Raw
+	VRF             []vrfkey.Raw
+	WorkflowSecrets []workflowsecret.Raw
+	LegacyKeys      LegacyKeyStorage `json:"-"`
 }
 
 func (rawKeys rawKeyRing) keys() (*keyRing, error) {
@@ -376,6 +390,10 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 		vrfKey := rawVRFKey.Key()
 		keyRing.VRF[vrfKey.ID()] = vrfKey
 	}
+	for _, rawSecret := range rawKeys.WorkflowSecrets {
+		secret := rawSecret.Key()
+		keyRing.WorkflowSecrets[secret.ID()] = secret
+	}
 
 	keyRing.LegacyKeys = rawKeys.LegacyKeys
 	return keyRing, nil
@@ -0,0 +1,83 @@
+package keystore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowsecret"
+)
+
+// WorkflowSecrets stores the named secrets referenced by workflow specs, encrypted along with the keys.
+type WorkflowSecrets interface {
+	Get(name string) (workflowsecret.Secret, error)
+	GetAll() ([]workflowsecret.Secret, error)
+	Add(ctx context.Context, secret workflowsecret.Secret) error
+	Delete(ctx context.Context, name string) (workflowsecret.Secret, error)
+}
+
+type workflowSecrets struct {
+	*keyManager
+}
+
+var _ WorkflowSecrets = &workflowSecrets{}
+
+func newWorkflowSecretsStore(km *keyManager) *workflowSecrets {
+	return &workflowSecrets{
+		km,
+	}
+}
+
+func (ks *workflowSecrets) Get(name string) (workflowsecret.Secret, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return workflowsecret.Secret{}, ErrLocked
+	}
+	return ks.getByName(name)
+}
+
+func (ks *workflowSecrets) GetAll() (secrets []workflowsecret.Secret, _ error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	for _, secret := range ks.keyRing.WorkflowSecrets {
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
+func (ks *workflowSecrets) Add(ctx context.Context, secret workflowsecret.Secret) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if _, found := ks.keyRing.WorkflowSecrets[secret.ID()]; found {
+		return fmt.Errorf("%w: workflow secret %s", ErrKeyExists, secret.ID())
+	}
+	return ks.safeAddKey(ctx, secret)
+}
+
+func (ks *workflowSecrets) Delete(ctx context.Context, name string) (workflowsecret.Secret, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return workflowsecret.Secret{}, ErrLocked
+	}
+	secret, err := ks.getByName(name)
+	if err != nil {
+		return workflowsecret.Secret{}, err
+	}
+	err = ks.safeRemoveKey(ctx, secret)
+	return secret, err
+}
+
+func (ks *workflowSecrets) getByName(name string) (workflowsecret.Secret, error) {
+	secret, found := ks.keyRing.WorkflowSecrets[name]
+	if !found {
+		return workflowsecret.Secret{}, KeyNotFoundError{ID: name, KeyType: "workflow secret"}
+	}
+	return secret, nil
+}
@@ -0,0 +1,84 @@
+package keystore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowsecret"
+)
+
+func Test_WorkflowSecretsKeyStore_E2E(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(testutils.Context(t), cltest.Password))
+	ks := keyStore.WorkflowSecrets()
+	reset := func() {
+		ctx := context.Background() // Executed on cleanup
+		require.NoError(t, utils.JustError(db.Exec("DELETE FROM encrypted_key_rings")))
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	}
+
+	t.Run("initializes with an empty state", func(t *testing.T) {
+		defer reset()
+		secrets, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Equal(t, 0, len(secrets))
+	})
+
+	t.Run("errors when getting non-existent name", func(t *testing.T) {
+		defer reset()
+		_, err := ks.Get("NON_EXISTENT")
+		require.Error(t, err)
+	})
+
+	t.Run("adds / deletes a secret", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		secret, err := workflowsecret.New("API_KEY", "hunter2")
+		require.NoError(t, err)
+		require.NoError(t, ks.Add(ctx, secret))
+		assert.ErrorIs(t, ks.Add(ctx, secret), keystore.ErrKeyExists)
+		retrieved, err := ks.Get("API_KEY")
+		require.NoError(t, err)
+		require.Equal(t, secret, retrieved)
+		secrets, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(secrets))
+		_, err = ks.Delete(ctx, "API_KEY")
+		require.NoError(t, err)
+		_, err = ks.Delete(ctx, "API_KEY")
+		assert.Error(t, err)
+		_, err = ks.Get("API_KEY")
+		require.Error(t, err)
+	})
+
+	t.Run("persists secrets encrypted with the master password", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		secret, err := workflowsecret.New("API_KEY", "hunter2")
+		require.NoError(t, err)
+		require.NoError(t, ks.Add(ctx, secret))
+
+		var encrypted []byte
+		require.NoError(t, db.Get(&encrypted, "SELECT encrypted_keys FROM encrypted_key_rings"))
+		assert.NotContains(t, string(encrypted), "hunter2")
+
+		keyStore.ResetXXXTestOnly()
+		_, err = ks.Get("API_KEY")
+		require.ErrorIs(t, err, keystore.ErrLocked)
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		retrieved, err := ks.Get("API_KEY")
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", retrieved.Value())
+	})
+}
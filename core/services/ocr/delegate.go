@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -233,6 +234,8 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) (services []
 			checker,
 			chain.ID(),
 			d.keyStore.Eth(),
+			// Reports are only valid for a short time, so they're sent ahead of any other transactions of the key.
+			ocrcommon.WithPriority(txmgrtypes.TxPriorityCritical),
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to create transmitter")
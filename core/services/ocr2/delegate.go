@@ -46,6 +46,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
+	iregistry21 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/i_keeper_registry_master_wrapper_2_1"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
@@ -1192,6 +1193,15 @@ func (d *Delegate) newServicesOCR2Keepers21(
 		return nil, ErrRelayNotEnabled{Err: err, Relay: spec.Relay, PluginName: "ocr2keepers"}
 	}
 
+	chain, err := d.legacyChains.Get(rid.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("keeper2 services: failed to get chain (%s): %w", rid.ChainID, err)
+	}
+	// the registry reverts with custom errors, which can only be decoded with its ABI
+	if err = chain.TxManager().RegisterRevertABI(common.HexToAddress(spec.ContractID), iregistry21.IKeeperRegistryMasterABI); err != nil {
+		return nil, errors.Wrap(err, "failed to register keeper registry ABI")
+	}
+
 	provider, err := relayer.NewPluginProvider(ctx,
 		types.RelayArgs{
 			ExternalJobID:      jb.ExternalJobID,
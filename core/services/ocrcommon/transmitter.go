@@ -26,6 +26,15 @@ type Transmitter interface {
 	FromAddress(context.Context) common.Address
 }
 
+type TransmitterOption func(t *transmitter)
+
+// WithPriority sets the priority of the transactions created by the transmitter, which are of normal priority by default.
+func WithPriority(priority types.TxPriority) TransmitterOption {
+	return func(t *transmitter) {
+		t.priority = priority
+	}
+}
+
 type transmitter struct {
 	txm                         txManager
 	fromAddresses               []common.Address
@@ -35,6 +44,7 @@ type transmitter struct {
 	checker                     txmgr.TransmitCheckerSpec
 	chainID                     *big.Int
 	keystore                    roundRobinKeystore
+	priority                    types.TxPriority
 }
 
 // NewTransmitter creates a new eth transmitter
@@ -47,13 +57,14 @@ func NewTransmitter(
 	checker txmgr.TransmitCheckerSpec,
 	chainID *big.Int,
 	keystore roundRobinKeystore,
+	opts ...TransmitterOption,
 ) (Transmitter, error) {
 	// Ensure that a keystore is provided.
 	if keystore == nil {
 		return nil, errors.New("nil keystore provided to transmitter")
 	}
 
-	return &transmitter{
+	t := &transmitter{
 		txm:                         txm,
 		fromAddresses:               fromAddresses,
 		gasLimit:                    gasLimit,
@@ -62,7 +73,11 @@ func NewTransmitter(
 		checker:                     checker,
 		chainID:                     chainID,
 		keystore:                    keystore,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t, nil
 }
 
 type txManagerOCR2 interface {
@@ -88,13 +103,14 @@ func NewOCR2FeedsTransmitter(
 	checker txmgr.TransmitCheckerSpec,
 	chainID *big.Int,
 	keystore roundRobinKeystore,
+	opts ...TransmitterOption,
 ) (Transmitter, error) {
 	// Ensure that a keystore is provided.
 	if keystore == nil {
 		return nil, errors.New("nil keystore provided to transmitter")
 	}
 
-	return &ocr2FeedsTransmitter{
+	t := &ocr2FeedsTransmitter{
 		ocr2Aggregator: ocr2Aggregator,
 		txManagerOCR2:  txm,
 		transmitter: transmitter{
@@ -107,7 +123,11 @@ func NewOCR2FeedsTransmitter(
 			chainID:                     chainID,
 			keystore:                    keystore,
 		},
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(&t.transmitter)
+	}
+	return t, nil
 }
 
 func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte, txMeta *txmgr.TxMeta) error {
@@ -125,6 +145,7 @@ func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common
 		Strategy:         t.strategy,
 		Checker:          t.checker,
 		Meta:             txMeta,
+		Priority:         t.priority,
 	})
 	return errors.Wrap(err, "skipped OCR transmission")
 }
@@ -162,6 +183,7 @@ func (t *ocr2FeedsTransmitter) CreateEthTransaction(ctx context.Context, toAddre
 		Strategy:         t.strategy,
 		Checker:          t.checker,
 		Meta:             txMeta,
+		Priority:         t.priority,
 	})
 
 	return errors.Wrap(err, "skipped OCR transmission")
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
@@ -61,6 +62,48 @@ func Test_DefaultTransmitter_CreateEthTransaction(t *testing.T) {
 	require.NoError(t, transmitter.CreateEthTransaction(testutils.Context(t), toAddress, payload, nil))
 }
 
+func Test_DefaultTransmitter_CreateEthTransaction_WithPriority(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	gasLimit := uint64(1000)
+	chainID := big.NewInt(0)
+	effectiveTransmitterAddress := fromAddress
+	toAddress := testutils.NewAddress()
+	payload := []byte{1, 2, 3}
+	txm := txmmocks.NewMockEvmTxManager(t)
+	strategy := newMockTxStrategy(t)
+
+	transmitter, err := ocrcommon.NewTransmitter(
+		txm,
+		[]common.Address{fromAddress},
+		gasLimit,
+		effectiveTransmitterAddress,
+		strategy,
+		txmgr.TransmitCheckerSpec{},
+		chainID,
+		ethKeyStore,
+		ocrcommon.WithPriority(txmgrtypes.TxPriorityCritical),
+	)
+	require.NoError(t, err)
+
+	txm.On("CreateTransaction", mock.Anything, txmgr.TxRequest{
+		FromAddress:      fromAddress,
+		ToAddress:        toAddress,
+		EncodedPayload:   payload,
+		FeeLimit:         gasLimit,
+		ForwarderAddress: common.Address{},
+		Meta:             nil,
+		Strategy:         strategy,
+		Priority:         txmgrtypes.TxPriorityCritical,
+	}).Return(txmgr.Tx{}, nil).Once()
+	require.NoError(t, transmitter.CreateEthTransaction(testutils.Context(t), toAddress, payload, nil))
+}
+
 func Test_DefaultTransmitter_Forwarding_Enabled_CreateEthTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,156 @@
+package pipeline
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+var promBridgeCircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bridge_circuit_breaker_state",
+	Help: "State of the bridge circuit breaker scoped by name: 0 = closed, 1 = open, 2 = half-open",
+},
+	[]string{"name"},
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitClosed:
+		return "closed"
+	case circuitOpen:
+		return "open"
+	case circuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// circuitBreaker stops requests to a failing endpoint. It opens after
+// threshold consecutive failures, and lets a single probe request through once
+// openTimeout has elapsed. A successful probe closes the breaker again.
+//
+// A nil *circuitBreaker is valid and always closed.
+type circuitBreaker struct {
+	name        string
+	threshold   uint32
+	openTimeout time.Duration
+	lggr        logger.Logger
+	now         func() time.Time
+
+	mu       sync.Mutex
+	state    circuitState
+	failures uint32
+	openedAt time.Time
+}
+
+// allow returns true if a request may be sent. In the half-open state only one
+// probe is let through until its outcome is reported.
+func (cb *circuitBreaker) allow() bool {
+	if cb == nil {
+		return true
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case circuitOpen:
+		if cb.now().Sub(cb.openedAt) < cb.openTimeout {
+			return false
+		}
+		cb.setState(circuitHalfOpen)
+		return true
+	case circuitHalfOpen:
+		// a probe is already in flight
+		return false
+	default:
+		return true
+	}
+}
+
+// report records the outcome of a request which was allowed.
+func (cb *circuitBreaker) report(success bool) {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if success {
+		cb.failures = 0
+		if cb.state != circuitClosed {
+			cb.lggr.Infow("Bridge circuit breaker closed", "name", cb.name)
+			cb.setState(circuitClosed)
+		}
+		return
+	}
+
+	cb.failures++
+	if cb.state == circuitHalfOpen || (cb.state == circuitClosed && cb.failures >= cb.threshold) {
+		cb.lggr.Warnw("Bridge circuit breaker opened", "name", cb.name, "consecutiveFailures", cb.failures, "openTimeout", cb.openTimeout)
+		cb.openedAt = cb.now()
+		cb.setState(circuitOpen)
+	}
+}
+
+func (cb *circuitBreaker) setState(state circuitState) {
+	cb.state = state
+	promBridgeCircuitBreakerState.WithLabelValues(cb.name).Set(float64(state))
+}
+
+// circuitBreakers holds one circuitBreaker per bridge name, shared by all jobs
+// executed by a runner.
+type circuitBreakers struct {
+	threshold   uint32
+	openTimeout time.Duration
+	lggr        logger.Logger
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+func newCircuitBreakers(cfg Config, lggr logger.Logger) *circuitBreakers {
+	return &circuitBreakers{
+		threshold:   cfg.BridgeCircuitBreakerFailureThreshold(),
+		openTimeout: cfg.BridgeCircuitBreakerOpenTimeout(),
+		lggr:        lggr.Named("CircuitBreaker"),
+		breakers:    make(map[string]*circuitBreaker),
+	}
+}
+
+// get returns the circuit breaker for name, or nil if circuit breakers are disabled.
+func (b *circuitBreakers) get(name string) *circuitBreaker {
+	if b == nil || b.threshold == 0 {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cb, ok := b.breakers[name]
+	if !ok {
+		cb = &circuitBreaker{
+			name:        name,
+			threshold:   b.threshold,
+			openTimeout: b.openTimeout,
+			lggr:        b.lggr,
+			now:         time.Now,
+		}
+		b.breakers[name] = cb
+	}
+	return cb
+}
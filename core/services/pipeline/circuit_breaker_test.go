@@ -0,0 +1,73 @@
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cb := &circuitBreaker{
+		name:        "test",
+		threshold:   3,
+		openTimeout: time.Minute,
+		lggr:        logger.TestLogger(t),
+		now:         func() time.Time { return now },
+	}
+
+	// successes reset the consecutive failure count
+	for i := 0; i < 2; i++ {
+		assert.True(t, cb.allow())
+		cb.report(false)
+	}
+	assert.True(t, cb.allow())
+	cb.report(true)
+	assert.Equal(t, circuitClosed, cb.state)
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, cb.allow())
+		cb.report(false)
+	}
+	assert.Equal(t, circuitOpen, cb.state)
+	assert.False(t, cb.allow())
+
+	// a failed probe opens the breaker again
+	now = now.Add(time.Minute)
+	assert.True(t, cb.allow())
+	assert.Equal(t, circuitHalfOpen, cb.state)
+	assert.False(t, cb.allow(), "only one probe at a time")
+	cb.report(false)
+	assert.Equal(t, circuitOpen, cb.state)
+	assert.False(t, cb.allow())
+
+	// a successful probe closes it
+	now = now.Add(time.Minute)
+	assert.True(t, cb.allow())
+	cb.report(true)
+	assert.Equal(t, circuitClosed, cb.state)
+	assert.True(t, cb.allow())
+}
+
+func TestCircuitBreakers_Get(t *testing.T) {
+	t.Parallel()
+
+	breakers := &circuitBreakers{threshold: 1, openTimeout: time.Minute, lggr: logger.TestLogger(t), breakers: map[string]*circuitBreaker{}}
+	assert.Same(t, breakers.get("a"), breakers.get("a"))
+	assert.NotSame(t, breakers.get("a"), breakers.get("b"))
+
+	disabled := &circuitBreakers{threshold: 0, breakers: map[string]*circuitBreaker{}}
+	cb := disabled.get("a")
+	assert.Nil(t, cb)
+	assert.True(t, cb.allow())
+	cb.report(false)
+	assert.True(t, cb.allow())
+
+	var unset *circuitBreakers
+	assert.Nil(t, unset.get("a"))
+}
@@ -65,11 +65,15 @@ type (
 	}
 
 	Config interface {
+		BridgeCircuitBreakerFailureThreshold() uint32
+		BridgeCircuitBreakerOpenTimeout() time.Duration
 		DefaultHTTPLimit() int64
 		DefaultHTTPTimeout() commonconfig.Duration
 		MaxRunDuration() time.Duration
+		MaxSuspendDuration() time.Duration
 		ReaperInterval() time.Duration
 		ReaperThreshold() time.Duration
+		ReplayBundlesEnabled() bool
 		VerboseLogging() bool
 	}
 
@@ -108,6 +112,11 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// SkipRetries is set when the task failed in a way retrying will not fix,
+	// e.g. the HTTP status code is not listed in retryOn
+	SkipRetries bool
+	// nested holds the results of the tasks executed by a foreach task
+	nested TaskRunResults
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -307,26 +316,33 @@ const (
 	TaskTypeETHABIEncode     TaskType = "ethabiencode"
 	TaskTypeETHABIEncode2    TaskType = "ethabiencode2"
 	TaskTypeETHCall          TaskType = "ethcall"
+	TaskTypeETHSignTypedData TaskType = "ethsigntypeddata"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeExpr             TaskType = "expr"
+	TaskTypeForEach          TaskType = "foreach"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
+	TaskTypeJMESPath         TaskType = "jmespath"
 	TaskTypeJSONParse        TaskType = "jsonparse"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
 	TaskTypeLowercase        TaskType = "lowercase"
+	TaskTypeMADFilter        TaskType = "madfilter"
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
 	TaskTypeSum              TaskType = "sum"
+	TaskTypeTrimmedMean      TaskType = "trimmedmean"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
 	TaskTypeVRFV2            TaskType = "vrfv2"
 	TaskTypeVRFV2Plus        TaskType = "vrfv2plus"
+	TaskTypeWeightedMedian   TaskType = "weightedmedian"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -367,18 +383,30 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &MedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMode:
 		task = &ModeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeTrimmedMean:
+		task = &TrimmedMeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMADFilter:
+		task = &MADFilterTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWeightedMedian:
+		task = &WeightedMedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeSum:
 		task = &SumTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeAny:
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJMESPath:
+		task = &JMESPathTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
 		task = &MultiplyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeDivide:
 		task = &DivideTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpr:
+		task = &ExprTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeForEach:
+		task = &ForEachTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeVRF:
 		task = &VRFTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeVRFV2:
@@ -391,6 +419,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &ETHCallTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHTx:
 		task = &ETHTxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHSignTypedData:
+		task = &ETHSignTypedDataTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIEncode:
 		task = &ETHABIEncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIEncode2:
@@ -0,0 +1,93 @@
+package pipeline
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Reasons for discarding an input of an outlier-filtering aggregation task,
+// as recorded in the task's output.
+const (
+	DiscardReasonErrored = "errored"
+	DiscardReasonTrimmed = "trimmed"
+	DiscardReasonOutlier = "outlier"
+)
+
+// aggregateInput is a successful input of an aggregation task, along with its
+// position in the task's values.
+type aggregateInput struct {
+	index int
+	value decimal.Decimal
+}
+
+// resolveAggregateInputs splits valuesAndErrs into decimal values and errored
+// inputs, returning an error if there are more errored inputs than allowed.
+// Unless allowedFaults is set, all but one input may error. The values are
+// returned sorted in ascending order.
+func resolveAggregateInputs(taskType TaskType, valuesAndErrs SliceParam, maybeAllowedFaults MaybeUint64Param) (inputs []aggregateInput, discarded []interface{}, err error) {
+	allowedFaults := len(valuesAndErrs) - 1
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	}
+
+	for i, v := range valuesAndErrs {
+		if err, is := v.(error); is {
+			discarded = append(discarded, map[string]interface{}{
+				"index":  i,
+				"error":  err.Error(),
+				"reason": DiscardReasonErrored,
+			})
+			continue
+		}
+		var d DecimalParam
+		if err := d.UnmarshalPipelineParam(v); err != nil {
+			return nil, nil, errors.Wrapf(ErrBadInput, "values: %v", err)
+		}
+		inputs = append(inputs, aggregateInput{index: i, value: d.Decimal()})
+	}
+
+	if len(discarded) > allowedFaults {
+		return nil, nil, errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to %s task > number allowed faults %v", len(discarded), taskType, allowedFaults)
+	} else if len(inputs) == 0 {
+		return nil, nil, errors.Wrap(ErrWrongInputCardinality, "values")
+	}
+
+	sort.SliceStable(inputs, func(i, j int) bool {
+		return inputs[i].value.LessThan(inputs[j].value)
+	})
+	return inputs, discarded, nil
+}
+
+func discardedAggregateInput(input aggregateInput, reason string) map[string]interface{} {
+	return map[string]interface{}{
+		"index":  input.index,
+		"value":  input.value,
+		"reason": reason,
+	}
+}
+
+// aggregateResult is the output of an outlier-filtering aggregation task: the
+// aggregated result, and the inputs which were left out of it ordered by index.
+func aggregateResult(result interface{}, discarded []interface{}) map[string]interface{} {
+	if discarded == nil {
+		discarded = []interface{}{}
+	}
+	sort.SliceStable(discarded, func(i, j int) bool {
+		return discarded[i].(map[string]interface{})["index"].(int) < discarded[j].(map[string]interface{})["index"].(int)
+	})
+	return map[string]interface{}{
+		"result":    result,
+		"discarded": discarded,
+	}
+}
+
+// medianOf returns the median of values, which must be sorted and non-empty.
+func medianOf(values []decimal.Decimal) decimal.Decimal {
+	k := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[k]
+	}
+	return values[k].Add(values[k-1]).Div(decimal.NewFromInt(2))
+}
@@ -14,6 +14,21 @@ import (
 	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
 )
 
+// retryOnStatusCode returns true if a request which failed with statusCode
+// should be retried according to the retryOn status codes of a task. Failures
+// without a status code, such as timeouts, are always retried.
+func retryOnStatusCode(retryOn Uint64SliceParam, statusCode int) bool {
+	if len(retryOn) == 0 || statusCode == 0 {
+		return true
+	}
+	for _, code := range retryOn {
+		if code == uint64(statusCode) {
+			return true
+		}
+	}
+	return false
+}
+
 func makeHTTPRequest(
 	ctx context.Context,
 	lggr logger.Logger,
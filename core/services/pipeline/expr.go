@@ -0,0 +1,591 @@
+package pipeline
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidExpression = errors.New("invalid expression")
+
+	exprKeypathRegexp = regexp.MustCompile(`^[a-zA-Z0-9_\.]+$`)
+)
+
+// maxExprLiteralExponent bounds the exponent of numeric literals, so that an
+// expression cannot allocate arbitrarily large numbers, e.g. 1e999999999 + 1.
+const maxExprLiteralExponent = 1000
+
+// Expr is a compiled arithmetic expression evaluated with decimal precision.
+//
+// The grammar, from lowest to highest precedence:
+//
+//	expr    = and { "||" and }
+//	and     = cmp { "&&" cmp }
+//	cmp     = sum [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" ) sum ]
+//	sum     = product { ( "+" | "-" ) product }
+//	product = unary { ( "*" | "/" | "%" ) unary }
+//	unary   = ( "-" | "!" ) unary | primary
+//	primary = number | "true" | "false" | "$(" keypath ")" | ident "(" [ expr { "," expr } ] ")" | "(" expr ")"
+//
+// Variables are referenced with the usual $(keypath) syntax and must resolve to
+// numbers. The available functions are min, max, abs and round.
+type Expr struct {
+	source string
+	root   exprNode
+}
+
+// CompileExpr parses and type checks an expression.
+func CompileExpr(source string) (*Expr, error) {
+	p := &exprParser{lexer: exprLexer{src: source}}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind == exprTokEOF {
+		return nil, errors.Wrap(ErrInvalidExpression, "empty expression")
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != exprTokEOF {
+		return nil, p.unexpected()
+	}
+	return &Expr{source: source, root: root}, nil
+}
+
+func (e *Expr) String() string {
+	return e.source
+}
+
+// IsBool returns true if the expression evaluates to a bool rather than a number.
+func (e *Expr) IsBool() bool {
+	return e.root.kind() == exprKindBool
+}
+
+// Eval evaluates the expression. Numbers are returned as decimal.Decimal and
+// comparisons as bool. If divPrecision is set, divisions are rounded to that
+// many decimal places, otherwise the decimal library default is used.
+func (e *Expr) Eval(vars Vars, divPrecision *int32) (value interface{}, err error) {
+	defer func() {
+		// decimal panics on exponent overflows which are not caught upfront
+		if r := recover(); r != nil {
+			err = errors.Wrapf(ErrBadInput, "expression %q: %v", e.source, r)
+		}
+	}()
+	v, err := e.root.eval(&exprEnv{vars: vars, divPrecision: divPrecision})
+	if err != nil {
+		return nil, err
+	}
+	if e.IsBool() {
+		return v.b, nil
+	}
+	return v.num, nil
+}
+
+type exprKind int
+
+const (
+	exprKindNumber exprKind = iota
+	exprKindBool
+)
+
+func (k exprKind) String() string {
+	if k == exprKindBool {
+		return "bool"
+	}
+	return "number"
+}
+
+type exprValue struct {
+	num decimal.Decimal
+	b   bool
+}
+
+type exprEnv struct {
+	vars         Vars
+	divPrecision *int32
+}
+
+type exprNode interface {
+	kind() exprKind
+	eval(env *exprEnv) (exprValue, error)
+}
+
+type exprLiteral struct {
+	typ   exprKind
+	value exprValue
+}
+
+func (n *exprLiteral) kind() exprKind                   { return n.typ }
+func (n *exprLiteral) eval(*exprEnv) (exprValue, error) { return n.value, nil }
+
+type exprVariable struct {
+	keypath string
+}
+
+func (n *exprVariable) kind() exprKind { return exprKindNumber }
+
+func (n *exprVariable) eval(env *exprEnv) (exprValue, error) {
+	val, err := env.vars.Get(n.keypath)
+	if err != nil {
+		return exprValue{}, err
+	}
+	var d DecimalParam
+	if err = d.UnmarshalPipelineParam(val); err != nil {
+		return exprValue{}, errors.Wrapf(err, "$(%s)", n.keypath)
+	}
+	return exprValue{num: d.Decimal()}, nil
+}
+
+type exprUnary struct {
+	op      string
+	operand exprNode
+}
+
+func (n *exprUnary) kind() exprKind { return n.operand.kind() }
+
+func (n *exprUnary) eval(env *exprEnv) (exprValue, error) {
+	v, err := n.operand.eval(env)
+	if err != nil {
+		return exprValue{}, err
+	}
+	if n.op == "!" {
+		return exprValue{b: !v.b}, nil
+	}
+	return exprValue{num: v.num.Neg()}, nil
+}
+
+type exprBinary struct {
+	op          string
+	left, right exprNode
+}
+
+func (n *exprBinary) kind() exprKind {
+	switch n.op {
+	case "+", "-", "*", "/", "%":
+		return exprKindNumber
+	default:
+		return exprKindBool
+	}
+}
+
+func (n *exprBinary) eval(env *exprEnv) (exprValue, error) {
+	l, err := n.left.eval(env)
+	if err != nil {
+		return exprValue{}, err
+	}
+	// logical operators short circuit
+	switch {
+	case n.op == "&&" && !l.b:
+		return exprValue{b: false}, nil
+	case n.op == "||" && l.b:
+		return exprValue{b: true}, nil
+	}
+	r, err := n.right.eval(env)
+	if err != nil {
+		return exprValue{}, err
+	}
+
+	a, b := l.num, r.num
+	switch n.op {
+	case "&&", "||":
+		return exprValue{b: r.b}, nil
+	case "+":
+		return exprValue{num: a.Add(b)}, nil
+	case "-":
+		return exprValue{num: a.Sub(b)}, nil
+	case "*":
+		newExp := int64(a.Exponent()) + int64(b.Exponent())
+		if newExp > math.MaxInt32 || newExp < math.MinInt32 {
+			return exprValue{}, ErrMultiplyOverlow
+		}
+		return exprValue{num: a.Mul(b)}, nil
+	case "/":
+		if b.IsZero() {
+			return exprValue{}, ErrDivideByZero
+		}
+		if env.divPrecision != nil {
+			e := int64(a.Exponent()) - int64(b.Exponent()) + int64(*env.divPrecision)
+			if e > math.MaxInt32 || e < math.MinInt32 {
+				return exprValue{}, ErrDivisionOverlow
+			}
+			return exprValue{num: a.DivRound(b, *env.divPrecision)}, nil
+		}
+		return exprValue{num: a.Div(b)}, nil
+	case "%":
+		if b.IsZero() {
+			return exprValue{}, ErrDivideByZero
+		}
+		return exprValue{num: a.Mod(b)}, nil
+	case "==":
+		if n.left.kind() == exprKindBool {
+			return exprValue{b: l.b == r.b}, nil
+		}
+		return exprValue{b: a.Equal(b)}, nil
+	case "!=":
+		if n.left.kind() == exprKindBool {
+			return exprValue{b: l.b != r.b}, nil
+		}
+		return exprValue{b: !a.Equal(b)}, nil
+	case "<":
+		return exprValue{b: a.LessThan(b)}, nil
+	case "<=":
+		return exprValue{b: a.LessThanOrEqual(b)}, nil
+	case ">":
+		return exprValue{b: a.GreaterThan(b)}, nil
+	case ">=":
+		return exprValue{b: a.GreaterThanOrEqual(b)}, nil
+	default:
+		return exprValue{}, errors.Errorf("unknown operator %q", n.op)
+	}
+}
+
+type exprCall struct {
+	name string
+	args []exprNode
+}
+
+func (n *exprCall) kind() exprKind { return exprKindNumber }
+
+func (n *exprCall) eval(env *exprEnv) (exprValue, error) {
+	args := make([]decimal.Decimal, len(n.args))
+	for i, arg := range n.args {
+		v, err := arg.eval(env)
+		if err != nil {
+			return exprValue{}, err
+		}
+		args[i] = v.num
+	}
+	switch n.name {
+	case "min":
+		return exprValue{num: decimal.Min(args[0], args[1:]...)}, nil
+	case "max":
+		return exprValue{num: decimal.Max(args[0], args[1:]...)}, nil
+	case "abs":
+		return exprValue{num: args[0].Abs()}, nil
+	case "round":
+		places := int32(0)
+		if len(args) == 2 {
+			if !args[1].IsInteger() || args[1].Abs().GreaterThan(decimal.NewFromInt(maxExprLiteralExponent)) {
+				return exprValue{}, errors.Wrapf(ErrBadInput, "round: invalid number of places %s", args[1])
+			}
+			places = int32(args[1].IntPart())
+		}
+		return exprValue{num: args[0].Round(places)}, nil
+	default:
+		return exprValue{}, errors.Errorf("unknown function %q", n.name)
+	}
+}
+
+// exprFunctions holds the minimum and maximum number of arguments of each
+// function, -1 meaning unbounded.
+var exprFunctions = map[string][2]int{
+	"min":   {1, -1},
+	"max":   {1, -1},
+	"abs":   {1, 1},
+	"round": {1, 2},
+}
+
+type exprTokenKind int
+
+const (
+	exprTokEOF exprTokenKind = iota
+	exprTokNumber
+	exprTokIdent
+	exprTokVariable
+	exprTokOp
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	pos  int
+}
+
+type exprLexer struct {
+	src string
+	pos int
+}
+
+func (l *exprLexer) next() (exprToken, error) {
+	for l.pos < len(l.src) && unicode.IsSpace(rune(l.src[l.pos])) {
+		l.pos++
+	}
+	start := l.pos
+	if l.pos >= len(l.src) {
+		return exprToken{kind: exprTokEOF, pos: start}, nil
+	}
+
+	c := l.src[l.pos]
+	switch {
+	case isExprDigit(c) || (c == '.' && l.pos+1 < len(l.src) && isExprDigit(l.src[l.pos+1])):
+		for l.pos < len(l.src) && (isExprDigit(l.src[l.pos]) || l.src[l.pos] == '.') {
+			l.pos++
+		}
+		if l.pos < len(l.src) && (l.src[l.pos] == 'e' || l.src[l.pos] == 'E') {
+			l.pos++
+			if l.pos < len(l.src) && (l.src[l.pos] == '+' || l.src[l.pos] == '-') {
+				l.pos++
+			}
+			for l.pos < len(l.src) && isExprDigit(l.src[l.pos]) {
+				l.pos++
+			}
+		}
+		return exprToken{kind: exprTokNumber, text: l.src[start:l.pos], pos: start}, nil
+
+	case isExprIdentStart(c):
+		for l.pos < len(l.src) && (isExprIdentStart(l.src[l.pos]) || isExprDigit(l.src[l.pos])) {
+			l.pos++
+		}
+		return exprToken{kind: exprTokIdent, text: l.src[start:l.pos], pos: start}, nil
+
+	case c == '$':
+		end := strings.IndexByte(l.src[l.pos:], ')')
+		if !strings.HasPrefix(l.src[l.pos:], "$(") || end < 0 {
+			return exprToken{}, errors.Wrapf(ErrInvalidExpression, "unterminated variable at offset %d", start)
+		}
+		keypath := strings.TrimSpace(l.src[l.pos+2 : l.pos+end])
+		if _, err := NewKeypathFromString(keypath); err != nil || !exprKeypathRegexp.MatchString(keypath) {
+			return exprToken{}, errors.Wrapf(ErrInvalidExpression, "invalid variable %q at offset %d", l.src[l.pos:l.pos+end+1], start)
+		}
+		l.pos += end + 1
+		return exprToken{kind: exprTokVariable, text: keypath, pos: start}, nil
+	}
+
+	for _, op := range []string{"==", "!=", "<=", ">=", "&&", "||"} {
+		if strings.HasPrefix(l.src[l.pos:], op) {
+			l.pos += len(op)
+			return exprToken{kind: exprTokOp, text: op, pos: start}, nil
+		}
+	}
+	if strings.IndexByte("+-*/%<>!(),", c) >= 0 {
+		l.pos++
+		return exprToken{kind: exprTokOp, text: string(c), pos: start}, nil
+	}
+	return exprToken{}, errors.Wrapf(ErrInvalidExpression, "unexpected character %q at offset %d", c, start)
+}
+
+func isExprDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isExprIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+type exprParser struct {
+	lexer exprLexer
+	tok   exprToken
+}
+
+func (p *exprParser) next() (err error) {
+	p.tok, err = p.lexer.next()
+	return err
+}
+
+func (p *exprParser) is(op string) bool {
+	return p.tok.kind == exprTokOp && p.tok.text == op
+}
+
+func (p *exprParser) unexpected() error {
+	if p.tok.kind == exprTokEOF {
+		return errors.Wrap(ErrInvalidExpression, "unexpected end of expression")
+	}
+	return errors.Wrapf(ErrInvalidExpression, "unexpected %q at offset %d", p.tok.text, p.tok.pos)
+}
+
+func (p *exprParser) expect(op string) error {
+	if !p.is(op) {
+		return p.unexpected()
+	}
+	return p.next()
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	return p.parseBinary([]string{"||"}, p.parseAnd)
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	return p.parseBinary([]string{"&&"}, p.parseComparison)
+}
+
+func (p *exprParser) parseComparison() (exprNode, error) {
+	left, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!=", "<", "<=", ">", ">="} {
+		if !p.is(op) {
+			continue
+		}
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		return newExprBinary(op, left, right)
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseSum() (exprNode, error) {
+	return p.parseBinary([]string{"+", "-"}, p.parseProduct)
+}
+
+func (p *exprParser) parseProduct() (exprNode, error) {
+	return p.parseBinary([]string{"*", "/", "%"}, p.parseUnary)
+}
+
+func (p *exprParser) parseBinary(ops []string, operand func() (exprNode, error)) (exprNode, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+outer:
+	for {
+		for _, op := range ops {
+			if !p.is(op) {
+				continue
+			}
+			if err = p.next(); err != nil {
+				return nil, err
+			}
+			right, err := operand()
+			if err != nil {
+				return nil, err
+			}
+			if left, err = newExprBinary(op, left, right); err != nil {
+				return nil, err
+			}
+			continue outer
+		}
+		return left, nil
+	}
+}
+
+func newExprBinary(op string, left, right exprNode) (exprNode, error) {
+	want := exprKindNumber
+	switch op {
+	case "&&", "||":
+		want = exprKindBool
+	case "==", "!=":
+		want = left.kind()
+	}
+	if left.kind() != want || right.kind() != want {
+		return nil, errors.Wrapf(ErrInvalidExpression, "operator %s cannot be applied to %s and %s", op, left.kind(), right.kind())
+	}
+	return &exprBinary{op: op, left: left, right: right}, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.is("-") || p.is("!") {
+		op := p.tok.text
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		want := exprKindNumber
+		if op == "!" {
+			want = exprKindBool
+		}
+		if operand.kind() != want {
+			return nil, errors.Wrapf(ErrInvalidExpression, "operator %s cannot be applied to %s", op, operand.kind())
+		}
+		return &exprUnary{op: op, operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.tok
+	switch tok.kind {
+	case exprTokNumber:
+		d, err := decimal.NewFromString(tok.text)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidExpression, "invalid number %q at offset %d", tok.text, tok.pos)
+		}
+		if exp := d.Exponent(); exp > maxExprLiteralExponent || exp < -maxExprLiteralExponent {
+			return nil, errors.Wrapf(ErrInvalidExpression, "number %q at offset %d is out of range", tok.text, tok.pos)
+		}
+		return &exprLiteral{typ: exprKindNumber, value: exprValue{num: d}}, p.next()
+
+	case exprTokVariable:
+		return &exprVariable{keypath: tok.text}, p.next()
+
+	case exprTokIdent:
+		if tok.text == "true" || tok.text == "false" {
+			return &exprLiteral{typ: exprKindBool, value: exprValue{b: tok.text == "true"}}, p.next()
+		}
+		return p.parseCall()
+
+	case exprTokOp:
+		if tok.text == "(" {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			return node, p.expect(")")
+		}
+	}
+	return nil, p.unexpected()
+}
+
+func (p *exprParser) parseCall() (exprNode, error) {
+	name := p.tok.text
+	arity, ok := exprFunctions[name]
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidExpression, "unknown function %q at offset %d", name, p.tok.pos)
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	call := &exprCall{name: name}
+	for !p.is(")") {
+		if len(call.args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if arg.kind() != exprKindNumber {
+			return nil, errors.Wrapf(ErrInvalidExpression, "%s: arguments must be numbers", name)
+		}
+		call.args = append(call.args, arg)
+	}
+	if len(call.args) < arity[0] || (arity[1] >= 0 && len(call.args) > arity[1]) {
+		return nil, errors.Wrapf(ErrInvalidExpression, "%s: %s", name, exprArityString(arity, len(call.args)))
+	}
+	return call, p.next()
+}
+
+func exprArityString(arity [2]int, got int) string {
+	switch {
+	case arity[0] == arity[1]:
+		return fmt.Sprintf("expected %d argument(s), got %d", arity[0], got)
+	case arity[1] < 0:
+		return fmt.Sprintf("expected at least %d argument(s), got %d", arity[0], got)
+	default:
+		return fmt.Sprintf("expected %d to %d arguments, got %d", arity[0], arity[1], got)
+	}
+}
@@ -208,6 +208,29 @@ func (p *Pipeline) ByDotID(id string) Task {
 	return nil
 }
 
+// byPath looks up a task by dot ID, or a task of a foreach spec by the path it
+// is recorded under, e.g. "prices[0].fetch". Tasks of foreach specs are returned
+// as parsed, rather than as executed for an element.
+func (p *Pipeline) byPath(path string) Task {
+	if task := p.ByDotID(path); task != nil {
+		return task
+	}
+	matches := nestedPathRegexp.FindStringSubmatch(path)
+	if matches == nil {
+		return nil
+	}
+	forEachTask, is := p.ByDotID(matches[1]).(*ForEachTask)
+	if !is || forEachTask.template == nil {
+		return nil
+	}
+	return forEachTask.template.byPath(matches[2])
+}
+
+// isNested returns true if path refers to a task of a foreach spec.
+func (p *Pipeline) isNested(path string) bool {
+	return p.ByDotID(path) == nil && p.byPath(path) != nil
+}
+
 func Parse(text string) (*Pipeline, error) {
 	if strings.TrimSpace(text) == "" {
 		return nil, errors.New("empty pipeline")
@@ -253,6 +276,17 @@ func Parse(text string) (*Pipeline, error) {
 			return nil, err
 		}
 
+		// expressions and nested specs are validated upfront rather than when the task runs
+		switch task := task.(type) {
+		case *ExprTask:
+			err = task.compile()
+		case *ForEachTask:
+			err = task.parse()
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "task %s", node.dotID)
+		}
+
 		if task.OutputIndex() > 0 {
 			_, exists := resultIdxs[task.OutputIndex()]
 			if exists {
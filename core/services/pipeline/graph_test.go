@@ -259,6 +259,9 @@ func TestParse(t *testing.T) {
 		{"empty", ""},
 		{"blank", " "},
 		{"foo", "foo"},
+		{"invalid expression", `a [type=expr expression="1 +"]`},
+		{"expression type mismatch", `a [type=expr expression="1 + (2 > 1)"]`},
+		{"unknown expression function", `a [type=expr expression="pow(2, 3)"]`},
 	} {
 		t.Run(s.name, func(t *testing.T) {
 			_, err := pipeline.Parse(s.pipeline)
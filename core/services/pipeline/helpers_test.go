@@ -8,6 +8,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
 const (
@@ -45,6 +46,10 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.specId = specId
 }
 
+func (t *BridgeTask) HelperSetCircuitBreakers(config Config, lggr logger.Logger) {
+	t.circuitBreakers = newCircuitBreakers(config, lggr)
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
 	t.config = config
 	t.httpClient = restrictedHTTPClient
@@ -65,4 +70,8 @@ func (t *ETHTxTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainCont
 	t.jobType = jobType
 }
 
+func (t *ETHSignTypedDataTask) HelperSetDependencies(keyStore ETHKeyStore) {
+	t.keyStore = keyStore
+}
+
 func (o *orm) Prune(ctx context.Context, pipelineSpecID int32) { o.prune(ctx, o.ds, pipelineSpecID) }
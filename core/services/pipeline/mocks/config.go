@@ -22,6 +22,96 @@ func (_m *Config) EXPECT() *Config_Expecter {
 	return &Config_Expecter{mock: &_m.Mock}
 }
 
+// BridgeCircuitBreakerFailureThreshold provides a mock function with given fields:
+func (_m *Config) BridgeCircuitBreakerFailureThreshold() uint32 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeCircuitBreakerFailureThreshold")
+	}
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// Config_BridgeCircuitBreakerFailureThreshold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeCircuitBreakerFailureThreshold'
+type Config_BridgeCircuitBreakerFailureThreshold_Call struct {
+	*mock.Call
+}
+
+// BridgeCircuitBreakerFailureThreshold is a helper method to define mock.On call
+func (_e *Config_Expecter) BridgeCircuitBreakerFailureThreshold() *Config_BridgeCircuitBreakerFailureThreshold_Call {
+	return &Config_BridgeCircuitBreakerFailureThreshold_Call{Call: _e.mock.On("BridgeCircuitBreakerFailureThreshold")}
+}
+
+func (_c *Config_BridgeCircuitBreakerFailureThreshold_Call) Run(run func()) *Config_BridgeCircuitBreakerFailureThreshold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_BridgeCircuitBreakerFailureThreshold_Call) Return(_a0 uint32) *Config_BridgeCircuitBreakerFailureThreshold_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_BridgeCircuitBreakerFailureThreshold_Call) RunAndReturn(run func() uint32) *Config_BridgeCircuitBreakerFailureThreshold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BridgeCircuitBreakerOpenTimeout provides a mock function with given fields:
+func (_m *Config) BridgeCircuitBreakerOpenTimeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeCircuitBreakerOpenTimeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_BridgeCircuitBreakerOpenTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeCircuitBreakerOpenTimeout'
+type Config_BridgeCircuitBreakerOpenTimeout_Call struct {
+	*mock.Call
+}
+
+// BridgeCircuitBreakerOpenTimeout is a helper method to define mock.On call
+func (_e *Config_Expecter) BridgeCircuitBreakerOpenTimeout() *Config_BridgeCircuitBreakerOpenTimeout_Call {
+	return &Config_BridgeCircuitBreakerOpenTimeout_Call{Call: _e.mock.On("BridgeCircuitBreakerOpenTimeout")}
+}
+
+func (_c *Config_BridgeCircuitBreakerOpenTimeout_Call) Run(run func()) *Config_BridgeCircuitBreakerOpenTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_BridgeCircuitBreakerOpenTimeout_Call) Return(_a0 time.Duration) *Config_BridgeCircuitBreakerOpenTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_BridgeCircuitBreakerOpenTimeout_Call) RunAndReturn(run func() time.Duration) *Config_BridgeCircuitBreakerOpenTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DefaultHTTPLimit provides a mock function with given fields:
 func (_m *Config) DefaultHTTPLimit() int64 {
 	ret := _m.Called()
@@ -157,6 +247,51 @@ func (_c *Config_MaxRunDuration_Call) RunAndReturn(run func() time.Duration) *Co
 	return _c
 }
 
+// MaxSuspendDuration provides a mock function with given fields:
+func (_m *Config) MaxSuspendDuration() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxSuspendDuration")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_MaxSuspendDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxSuspendDuration'
+type Config_MaxSuspendDuration_Call struct {
+	*mock.Call
+}
+
+// MaxSuspendDuration is a helper method to define mock.On call
+func (_e *Config_Expecter) MaxSuspendDuration() *Config_MaxSuspendDuration_Call {
+	return &Config_MaxSuspendDuration_Call{Call: _e.mock.On("MaxSuspendDuration")}
+}
+
+func (_c *Config_MaxSuspendDuration_Call) Run(run func()) *Config_MaxSuspendDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_MaxSuspendDuration_Call) Return(_a0 time.Duration) *Config_MaxSuspendDuration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_MaxSuspendDuration_Call) RunAndReturn(run func() time.Duration) *Config_MaxSuspendDuration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReaperInterval provides a mock function with given fields:
 func (_m *Config) ReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -247,6 +382,51 @@ func (_c *Config_ReaperThreshold_Call) RunAndReturn(run func() time.Duration) *C
 	return _c
 }
 
+// ReplayBundlesEnabled provides a mock function with given fields:
+func (_m *Config) ReplayBundlesEnabled() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayBundlesEnabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Config_ReplayBundlesEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayBundlesEnabled'
+type Config_ReplayBundlesEnabled_Call struct {
+	*mock.Call
+}
+
+// ReplayBundlesEnabled is a helper method to define mock.On call
+func (_e *Config_Expecter) ReplayBundlesEnabled() *Config_ReplayBundlesEnabled_Call {
+	return &Config_ReplayBundlesEnabled_Call{Call: _e.mock.On("ReplayBundlesEnabled")}
+}
+
+func (_c *Config_ReplayBundlesEnabled_Call) Run(run func()) *Config_ReplayBundlesEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_ReplayBundlesEnabled_Call) Return(_a0 bool) *Config_ReplayBundlesEnabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_ReplayBundlesEnabled_Call) RunAndReturn(run func() bool) *Config_ReplayBundlesEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerboseLogging provides a mock function with given fields:
 func (_m *Config) VerboseLogging() bool {
 	ret := _m.Called()
@@ -321,6 +321,124 @@ func (_c *ORM_DeleteRunsOlderThan_Call) RunAndReturn(run func(context.Context, t
 	return _c
 }
 
+// FailRun provides a mock function with given fields: ctx, id, reason, terminalTasks
+func (_m *ORM) FailRun(ctx context.Context, id int64, reason string, terminalTasks int) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, id, reason, terminalTasks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailRun")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int) ([]uuid.UUID, error)); ok {
+		return rf(ctx, id, reason, terminalTasks)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int) []uuid.UUID); ok {
+		r0 = rf(ctx, id, reason, terminalTasks)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int) error); ok {
+		r1 = rf(ctx, id, reason, terminalTasks)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FailRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailRun'
+type ORM_FailRun_Call struct {
+	*mock.Call
+}
+
+// FailRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - reason string
+//   - terminalTasks int
+func (_e *ORM_Expecter) FailRun(ctx interface{}, id interface{}, reason interface{}, terminalTasks interface{}) *ORM_FailRun_Call {
+	return &ORM_FailRun_Call{Call: _e.mock.On("FailRun", ctx, id, reason, terminalTasks)}
+}
+
+func (_c *ORM_FailRun_Call) Run(run func(ctx context.Context, id int64, reason string, terminalTasks int)) *ORM_FailRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_FailRun_Call) Return(pendingTaskRunIDs []uuid.UUID, err error) *ORM_FailRun_Call {
+	_c.Call.Return(pendingTaskRunIDs, err)
+	return _c
+}
+
+func (_c *ORM_FailRun_Call) RunAndReturn(run func(context.Context, int64, string, int) ([]uuid.UUID, error)) *ORM_FailRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindReplayBundle provides a mock function with given fields: ctx, runID
+func (_m *ORM) FindReplayBundle(ctx context.Context, runID int64) (pipeline.ReplayBundle, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindReplayBundle")
+	}
+
+	var r0 pipeline.ReplayBundle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (pipeline.ReplayBundle, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) pipeline.ReplayBundle); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Get(0).(pipeline.ReplayBundle)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindReplayBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindReplayBundle'
+type ORM_FindReplayBundle_Call struct {
+	*mock.Call
+}
+
+// FindReplayBundle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+func (_e *ORM_Expecter) FindReplayBundle(ctx interface{}, runID interface{}) *ORM_FindReplayBundle_Call {
+	return &ORM_FindReplayBundle_Call{Call: _e.mock.On("FindReplayBundle", ctx, runID)}
+}
+
+func (_c *ORM_FindReplayBundle_Call) Run(run func(ctx context.Context, runID int64)) *ORM_FindReplayBundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ORM_FindReplayBundle_Call) Return(_a0 pipeline.ReplayBundle, _a1 error) *ORM_FindReplayBundle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindReplayBundle_Call) RunAndReturn(run func(context.Context, int64) (pipeline.ReplayBundle, error)) *ORM_FindReplayBundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindRun provides a mock function with given fields: ctx, id
 func (_m *ORM) FindRun(ctx context.Context, id int64) (pipeline.Run, error) {
 	ret := _m.Called(ctx, id)
@@ -378,6 +496,65 @@ func (_c *ORM_FindRun_Call) RunAndReturn(run func(context.Context, int64) (pipel
 	return _c
 }
 
+// FindSuspendedRunsOlderThan provides a mock function with given fields: ctx, threshold
+func (_m *ORM) FindSuspendedRunsOlderThan(ctx context.Context, threshold time.Duration) ([]pipeline.Run, error) {
+	ret := _m.Called(ctx, threshold)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSuspendedRunsOlderThan")
+	}
+
+	var r0 []pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) ([]pipeline.Run, error)); ok {
+		return rf(ctx, threshold)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) []pipeline.Run); ok {
+		r0 = rf(ctx, threshold)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, threshold)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindSuspendedRunsOlderThan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSuspendedRunsOlderThan'
+type ORM_FindSuspendedRunsOlderThan_Call struct {
+	*mock.Call
+}
+
+// FindSuspendedRunsOlderThan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - threshold time.Duration
+func (_e *ORM_Expecter) FindSuspendedRunsOlderThan(ctx interface{}, threshold interface{}) *ORM_FindSuspendedRunsOlderThan_Call {
+	return &ORM_FindSuspendedRunsOlderThan_Call{Call: _e.mock.On("FindSuspendedRunsOlderThan", ctx, threshold)}
+}
+
+func (_c *ORM_FindSuspendedRunsOlderThan_Call) Run(run func(ctx context.Context, threshold time.Duration)) *ORM_FindSuspendedRunsOlderThan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ORM_FindSuspendedRunsOlderThan_Call) Return(runs []pipeline.Run, err error) *ORM_FindSuspendedRunsOlderThan_Call {
+	_c.Call.Return(runs, err)
+	return _c
+}
+
+func (_c *ORM_FindSuspendedRunsOlderThan_Call) RunAndReturn(run func(context.Context, time.Duration) ([]pipeline.Run, error)) *ORM_FindSuspendedRunsOlderThan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllRuns provides a mock function with given fields: ctx
 func (_m *ORM) GetAllRuns(ctx context.Context) ([]pipeline.Run, error) {
 	ret := _m.Called(ctx)
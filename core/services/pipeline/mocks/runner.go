@@ -26,6 +26,53 @@ func (_m *Runner) EXPECT() *Runner_Expecter {
 	return &Runner_Expecter{mock: &_m.Mock}
 }
 
+// CancelRun provides a mock function with given fields: ctx, runID
+func (_m *Runner) CancelRun(ctx context.Context, runID int64) error {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Runner_CancelRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelRun'
+type Runner_CancelRun_Call struct {
+	*mock.Call
+}
+
+// CancelRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+func (_e *Runner_Expecter) CancelRun(ctx interface{}, runID interface{}) *Runner_CancelRun_Call {
+	return &Runner_CancelRun_Call{Call: _e.mock.On("CancelRun", ctx, runID)}
+}
+
+func (_c *Runner_CancelRun_Call) Run(run func(ctx context.Context, runID int64)) *Runner_CancelRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Runner_CancelRun_Call) Return(_a0 error) *Runner_CancelRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_CancelRun_Call) RunAndReturn(run func(context.Context, int64) error) *Runner_CancelRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *Runner) Close() error {
 	ret := _m.Called()
@@ -411,6 +458,52 @@ func (_c *Runner_InsertFinishedRuns_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// JobStats provides a mock function with given fields: jobID
+func (_m *Runner) JobStats(jobID int32) pipeline.JobStats {
+	ret := _m.Called(jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobStats")
+	}
+
+	var r0 pipeline.JobStats
+	if rf, ok := ret.Get(0).(func(int32) pipeline.JobStats); ok {
+		r0 = rf(jobID)
+	} else {
+		r0 = ret.Get(0).(pipeline.JobStats)
+	}
+
+	return r0
+}
+
+// Runner_JobStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobStats'
+type Runner_JobStats_Call struct {
+	*mock.Call
+}
+
+// JobStats is a helper method to define mock.On call
+//   - jobID int32
+func (_e *Runner_Expecter) JobStats(jobID interface{}) *Runner_JobStats_Call {
+	return &Runner_JobStats_Call{Call: _e.mock.On("JobStats", jobID)}
+}
+
+func (_c *Runner_JobStats_Call) Run(run func(jobID int32)) *Runner_JobStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32))
+	})
+	return _c
+}
+
+func (_c *Runner_JobStats_Call) Return(_a0 pipeline.JobStats) *Runner_JobStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_JobStats_Call) RunAndReturn(run func(int32) pipeline.JobStats) *Runner_JobStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *Runner) Name() string {
 	ret := _m.Called()
@@ -534,6 +627,74 @@ func (_c *Runner_Ready_Call) RunAndReturn(run func() error) *Runner_Ready_Call {
 	return _c
 }
 
+// ReplayRun provides a mock function with given fields: ctx, runID
+func (_m *Runner) ReplayRun(ctx context.Context, runID int64) (*pipeline.Run, []pipeline.ReplayTaskDiff, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 []pipeline.ReplayTaskDiff
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*pipeline.Run, []pipeline.ReplayTaskDiff, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *pipeline.Run); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) []pipeline.ReplayTaskDiff); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]pipeline.ReplayTaskDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64) error); ok {
+		r2 = rf(ctx, runID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Runner_ReplayRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayRun'
+type Runner_ReplayRun_Call struct {
+	*mock.Call
+}
+
+// ReplayRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+func (_e *Runner_Expecter) ReplayRun(ctx interface{}, runID interface{}) *Runner_ReplayRun_Call {
+	return &Runner_ReplayRun_Call{Call: _e.mock.On("ReplayRun", ctx, runID)}
+}
+
+func (_c *Runner_ReplayRun_Call) Run(run func(ctx context.Context, runID int64)) *Runner_ReplayRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Runner_ReplayRun_Call) Return(run *pipeline.Run, diffs []pipeline.ReplayTaskDiff, err error) *Runner_ReplayRun_Call {
+	_c.Call.Return(run, diffs, err)
+	return _c
+}
+
+func (_c *Runner_ReplayRun_Call) RunAndReturn(run func(context.Context, int64) (*pipeline.Run, []pipeline.ReplayTaskDiff, error)) *Runner_ReplayRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeRun provides a mock function with given fields: ctx, taskID, value, err
 func (_m *Runner) ResumeRun(ctx context.Context, taskID uuid.UUID, value interface{}, err error) error {
 	ret := _m.Called(ctx, taskID, value, err)
@@ -68,6 +68,11 @@ type Run struct {
 	Pending bool
 	// FailSilently is used to signal that a task with the failEarly flag has failed, and we want to not put this in the db
 	FailSilently bool
+	// ReplayBundle is set on finished runs if JobPipeline.ReplayBundlesEnabled is set, and persisted alongside the run
+	ReplayBundle *ReplayBundle `json:"-" db:"-"`
+
+	// nestedResults holds the results of the tasks executed by foreach tasks
+	nestedResults TaskRunResults
 }
 
 func (r Run) GetID() string {
@@ -10,9 +10,11 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -81,6 +83,12 @@ type ORM interface {
 	CreateRun(ctx context.Context, run *Run) (err error)
 	InsertRun(ctx context.Context, run *Run) error
 	DeleteRun(ctx context.Context, id int64) error
+	// FailRun marks an unfinished run and its pending task runs as errored with reason, recording a fatal error and a
+	// null output for each of the terminalTasks of its pipeline, and returns the IDs of the pending task runs.
+	// Returns sql.ErrNoRows if the run does not exist, or ErrRunFinished if it has already finished.
+	FailRun(ctx context.Context, id int64, reason string, terminalTasks int) (pendingTaskRunIDs []uuid.UUID, err error)
+	// FindSuspendedRunsOlderThan returns the runs, loaded with their pipeline specs, which are still suspended on a task started longer than threshold ago.
+	FindSuspendedRunsOlderThan(ctx context.Context, threshold time.Duration) (runs []Run, err error)
 	StoreRun(ctx context.Context, run *Run) (restart bool, err error)
 	UpdateTaskRunResult(ctx context.Context, taskID uuid.UUID, result Result) (run Run, start bool, err error)
 	InsertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool) (err error)
@@ -92,6 +100,7 @@ type ORM interface {
 
 	DeleteRunsOlderThan(context.Context, time.Duration) error
 	FindRun(ctx context.Context, id int64) (Run, error)
+	FindReplayBundle(ctx context.Context, runID int64) (ReplayBundle, error)
 	GetAllRuns(ctx context.Context) ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 
@@ -113,6 +122,9 @@ type orm struct {
 
 var _ ORM = (*orm)(nil)
 
+// ErrRunFinished is returned when attempting to cancel or store a run which has already finished, e.g. because it was cancelled.
+var ErrRunFinished = errors.New("pipeline run has already finished")
+
 func NewORM(ds sqlutil.DataSource, lggr logger.Logger, jobPipelineMaxSuccessfulRuns uint64) *orm {
 	return &orm{
 		ds:                ds,
@@ -239,14 +251,18 @@ func (o *orm) InsertRun(ctx context.Context, run *Run) error {
 // If `restart` is true, then new task run data is available and the run should be resumed immediately.
 func (o *orm) StoreRun(ctx context.Context, run *Run) (restart bool, err error) {
 	err = o.transact(ctx, func(tx *orm) error {
+		// Lock the current run. This prevents races with /v2/resume and with cancellation
+		var state RunStatus
+		sql := `SELECT state FROM pipeline_runs WHERE id = $1 FOR UPDATE;`
+		if err = tx.ds.GetContext(ctx, &state, sql, run.ID); err != nil {
+			return fmt.Errorf("failed to select pipeline run %d: %w", run.ID, err)
+		}
+		if state.Finished() {
+			return fmt.Errorf("failed to store pipeline run %d: %w", run.ID, ErrRunFinished)
+		}
+
 		finished := run.FinishedAt.Valid
 		if !finished {
-			// Lock the current run. This prevents races with /v2/resume
-			sql := `SELECT id FROM pipeline_runs WHERE id = $1 FOR UPDATE;`
-			if _, err = tx.ds.ExecContext(ctx, sql, run.ID); err != nil {
-				return fmt.Errorf("failed to select pipeline run %d: %w", run.ID, err)
-			}
-
 			taskRuns := []TaskRun{}
 			// Reload task runs, we want to check for any changes while the run was ongoing
 			if err = tx.ds.SelectContext(ctx, &taskRuns, `SELECT * FROM pipeline_task_runs WHERE pipeline_run_id = $1`, run.ID); err != nil {
@@ -281,17 +297,19 @@ func (o *orm) StoreRun(ctx context.Context, run *Run) (restart bool, err error)
 			}
 		} else {
 			defer o.prune(ctx, tx.ds, run.PruningKey)
-			// Simply finish the run, no need to do any sort of locking
 			if run.Outputs.Val == nil || len(run.FatalErrors)+len(run.AllErrors) == 0 {
 				return fmt.Errorf("run must have both Outputs and Errors, got Outputs: %#v, FatalErrors: %#v, AllErrors: %#v", run.Outputs.Val, run.FatalErrors, run.AllErrors)
 			}
-			sql := `UPDATE pipeline_runs SET state = :state, finished_at = :finished_at, all_errors= :all_errors, fatal_errors= :fatal_errors, outputs = :outputs WHERE id = :id`
+			sql = `UPDATE pipeline_runs SET state = :state, finished_at = :finished_at, all_errors= :all_errors, fatal_errors= :fatal_errors, outputs = :outputs WHERE id = :id`
 			if _, err = tx.ds.NamedExecContext(ctx, sql, run); err != nil {
 				return fmt.Errorf("failed to update pipeline run %d: %w", run.ID, err)
 			}
+			if err = tx.insertReplayBundle(ctx, run.ID, run.ReplayBundle); err != nil {
+				return err
+			}
 		}
 
-		sql := `
+		sql = `
 		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
 		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
@@ -321,6 +339,58 @@ func (o *orm) DeleteRun(ctx context.Context, id int64) error {
 	return err
 }
 
+func (o *orm) FailRun(ctx context.Context, id int64, reason string, terminalTasks int) (pendingTaskRunIDs []uuid.UUID, err error) {
+	err = o.transact(ctx, func(tx *orm) error {
+		// Lock the run. This prevents races with /v2/resume and with the runner storing the run
+		var state RunStatus
+		if err = tx.ds.GetContext(ctx, &state, `SELECT state FROM pipeline_runs WHERE id = $1 FOR UPDATE`, id); err != nil {
+			return err
+		}
+		if state.Finished() {
+			return ErrRunFinished
+		}
+
+		now := time.Now()
+		sql := `UPDATE pipeline_task_runs SET error = $2, finished_at = $3 WHERE pipeline_run_id = $1 AND finished_at IS NULL RETURNING id`
+		if err = tx.ds.SelectContext(ctx, &pendingTaskRunIDs, sql, id, reason, now); err != nil {
+			return errors.Wrap(err, "failed to fail pending pipeline task runs")
+		}
+
+		fatalErrs := make(RunErrors, terminalTasks)
+		outputs := make([]interface{}, terminalTasks)
+		for i := range fatalErrs {
+			fatalErrs[i] = null.StringFrom(reason)
+		}
+		sql = `UPDATE pipeline_runs SET state = $2, finished_at = $3, all_errors = $4, fatal_errors = $5, outputs = $6 WHERE id = $1`
+		if _, err = tx.ds.ExecContext(ctx, sql, id, RunStatusErrored, now, RunErrors{null.StringFrom(reason)}, fatalErrs,
+			jsonserializable.JSONSerializable{Val: outputs, Valid: true}); err != nil {
+			return errors.Wrap(err, "failed to fail pipeline run")
+		}
+		return nil
+	})
+	return pendingTaskRunIDs, err
+}
+
+func (o *orm) FindSuspendedRunsOlderThan(ctx context.Context, threshold time.Duration) (runs []Run, err error) {
+	var runsPtrs []*Run
+	err = o.transact(ctx, func(tx *orm) error {
+		// a run is suspended since its latest pending task was started, which can be long after the run was created
+		sql := `SELECT * FROM pipeline_runs WHERE state = $1 AND COALESCE(
+	(SELECT max(created_at) FROM pipeline_task_runs WHERE pipeline_task_runs.pipeline_run_id = pipeline_runs.id AND finished_at IS NULL),
+	created_at
+) < $2 ORDER BY id`
+		if err = tx.ds.SelectContext(ctx, &runsPtrs, sql, RunStatusSuspended, time.Now().Add(-threshold)); err != nil {
+			return errors.Wrap(err, "failed to select suspended pipeline runs")
+		}
+		return loadAssociations(ctx, tx.ds, runsPtrs)
+	})
+	runs = make([]Run, len(runsPtrs))
+	for i, runPtr := range runsPtrs {
+		runs[i] = *runPtr
+	}
+	return runs, err
+}
+
 func (o *orm) UpdateTaskRunResult(ctx context.Context, taskID uuid.UUID, result Result) (run Run, start bool, err error) {
 	if result.OutputDB().Valid && result.ErrorDB().Valid {
 		panic("run result must specify either output or error, not both")
@@ -390,6 +460,9 @@ RETURNING id
 			for j := range run.PipelineTaskRuns {
 				run.PipelineTaskRuns[j].PipelineRunID = runIDs[i]
 			}
+			if errB := tx.insertReplayBundle(ctx, runIDs[i], run.ReplayBundle); errB != nil {
+				return errB
+			}
 		}
 
 		defer func() {
@@ -499,6 +572,10 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 		run.PipelineTaskRuns[i].PipelineRunID = run.ID
 	}
 
+	if err = o.insertReplayBundle(ctx, run.ID, run.ReplayBundle); err != nil {
+		return err
+	}
+
 	if !saveSuccessfulTaskRuns && !run.HasErrors() {
 		return nil
 	}
@@ -511,6 +588,16 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 	return errors.Wrap(err, "failed to insert pipeline_task_runs")
 }
 
+// insertReplayBundle persists the replay bundle of a finished run, if one was recorded.
+func (o *orm) insertReplayBundle(ctx context.Context, runID int64, bundle *ReplayBundle) error {
+	if bundle == nil {
+		return nil
+	}
+	sql := `INSERT INTO pipeline_run_replay_bundles (pipeline_run_id, bundle, created_at) VALUES ($1, $2, NOW());`
+	_, err := o.ds.ExecContext(ctx, sql, runID, bundle)
+	return errors.Wrap(err, "failed to insert pipeline_run_replay_bundles")
+}
+
 // DeleteRunsOlderThan deletes all pipeline_runs that have been finished for a certain threshold to free DB space
 // Caller is expected to set timeout on calling context.
 func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration) error {
@@ -580,6 +667,12 @@ func (o *orm) FindRun(ctx context.Context, id int64) (r Run, err error) {
 	return *runs[0], err
 }
 
+// FindReplayBundle returns the replay bundle recorded for a run, or sql.ErrNoRows if there is none.
+func (o *orm) FindReplayBundle(ctx context.Context, runID int64) (bundle ReplayBundle, err error) {
+	err = o.ds.GetContext(ctx, &bundle, `SELECT bundle FROM pipeline_run_replay_bundles WHERE pipeline_run_id = $1`, runID)
+	return bundle, err
+}
+
 func (o *orm) GetAllRuns(ctx context.Context) (runs []Run, err error) {
 	var runsPtrs []*Run
 	err = o.transact(ctx, func(tx *orm) error {
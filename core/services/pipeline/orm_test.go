@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -598,6 +599,105 @@ func Test_PipelineORM_DeleteRun(t *testing.T) {
 	require.Error(t, err, "not found")
 }
 
+func Test_PipelineORM_FailRun(t *testing.T) {
+	ctx := testutils.Context(t)
+	_, orm, jorm := setupLiteORM(t)
+
+	run := mustInsertAsyncRun(t, orm, jorm)
+
+	now := time.Now()
+	pendingTaskRunID := uuid.New()
+	run.PipelineTaskRuns = []pipeline.TaskRun{
+		// pending task
+		{
+			ID:            pendingTaskRunID,
+			PipelineRunID: run.ID,
+			Type:          "bridge",
+			DotID:         "ds1",
+			CreatedAt:     now,
+			FinishedAt:    null.Time{},
+		},
+		// finished task
+		{
+			ID:            uuid.New(),
+			PipelineRunID: run.ID,
+			Type:          "median",
+			DotID:         "answer2",
+			Output:        jsonserializable.JSONSerializable{Val: 1, Valid: true},
+			CreatedAt:     now,
+			FinishedAt:    null.TimeFrom(now),
+		},
+	}
+	restart, err := orm.StoreRun(ctx, run)
+	require.NoError(t, err)
+	require.False(t, restart)
+	require.Equal(t, pipeline.RunStatusSuspended, run.State)
+
+	pendingTaskRunIDs, err := orm.FailRun(ctx, run.ID, "cancelled by test", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{pendingTaskRunID}, pendingTaskRunIDs)
+
+	r, err := orm.FindRun(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.RunStatusErrored, r.State)
+	assert.True(t, r.FinishedAt.Valid)
+	// one fatal error and output per terminal task
+	assert.Equal(t, pipeline.RunErrors{null.StringFrom("cancelled by test"), null.StringFrom("cancelled by test")}, r.FatalErrors)
+	assert.Equal(t, []interface{}{nil, nil}, r.Outputs.Val)
+	assert.Equal(t, "cancelled by test", r.ByDotID("ds1").Error.String)
+	assert.True(t, r.ByDotID("ds1").FinishedAt.Valid)
+	assert.False(t, r.ByDotID("answer2").Error.Valid)
+
+	// finished runs can neither be cancelled nor overwritten
+	_, err = orm.FailRun(ctx, run.ID, "cancelled by test", 2)
+	require.ErrorIs(t, err, pipeline.ErrRunFinished)
+	_, err = orm.StoreRun(ctx, run)
+	require.ErrorIs(t, err, pipeline.ErrRunFinished)
+
+	_, err = orm.FailRun(ctx, run.ID+1, "cancelled by test", 2)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func Test_PipelineORM_FindSuspendedRunsOlderThan(t *testing.T) {
+	ctx := testutils.Context(t)
+	db, orm, jorm := setupLiteORM(t)
+
+	suspend := func(run *pipeline.Run, at time.Time) {
+		run.PipelineTaskRuns = []pipeline.TaskRun{{
+			ID:            uuid.New(),
+			PipelineRunID: run.ID,
+			Type:          "bridge",
+			DotID:         "ds1",
+			CreatedAt:     at,
+		}}
+		_, err := orm.StoreRun(ctx, run)
+		require.NoError(t, err)
+	}
+
+	run := mustInsertAsyncRun(t, orm, jorm)
+	suspend(run, time.Now().Add(-time.Minute))
+	// still running
+	mustInsertAsyncRun(t, orm, jorm)
+
+	// created long ago, but only suspended now
+	resumed := mustInsertAsyncRun(t, orm, jorm)
+	_, err := db.ExecContext(ctx, `UPDATE pipeline_runs SET created_at = $2 WHERE id = $1`, resumed.ID, time.Now().Add(-2*time.Hour))
+	require.NoError(t, err)
+	suspend(resumed, time.Now())
+
+	runs, err := orm.FindSuspendedRunsOlderThan(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+
+	// neither the running run nor the recently suspended one are returned
+	runs, err = orm.FindSuspendedRunsOlderThan(ctx, 30*time.Second)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, run.ID, runs[0].ID)
+	assert.Equal(t, pipeline.RunStatusSuspended, runs[0].State)
+	assert.NotEmpty(t, runs[0].PipelineSpec.DotDagSource)
+}
+
 func Test_PipelineORM_DeleteRunsOlderThan(t *testing.T) {
 	ctx := testutils.Context(t)
 	_, orm, jorm := setupHeavyORM(t)
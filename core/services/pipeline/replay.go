@@ -0,0 +1,166 @@
+package pipeline
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+var ErrNoReplayBundle = errors.New("no replay bundle recorded for run")
+
+// ReplayBundle is recorded for finished runs when JobPipeline.ReplayBundlesEnabled
+// is set. It holds everything required to re-execute the run deterministically:
+// the run's input variables and the raw results of its external tasks, in the
+// form of simulation fixtures. Results holds the original result of every task,
+// keyed by dot ID, to diff the replayed run against.
+type ReplayBundle struct {
+	Fixtures SimulationFixtures          `json:"fixtures"`
+	Results  map[string]ReplayTaskResult `json:"results"`
+}
+
+// ReplayTaskResult is the result of a single task, as persisted in the database.
+type ReplayTaskResult struct {
+	Type   TaskType                          `json:"type"`
+	Output jsonserializable.JSONSerializable `json:"output"`
+	Error  string                            `json:"error,omitempty"`
+}
+
+// ReplayTaskDiff compares the original and replayed results of a task. Original
+// is nil if the task did not run originally, Replayed is nil if the task did not
+// run during the replay.
+type ReplayTaskDiff struct {
+	DotID    string
+	Type     TaskType
+	Original *ReplayTaskResult
+	Replayed *ReplayTaskResult
+}
+
+// Match returns true if the task produced the same output and error in both runs.
+func (d ReplayTaskDiff) Match() bool {
+	if d.Original == nil || d.Replayed == nil {
+		return false
+	}
+	return d.Original.Error == d.Replayed.Error && d.Original.outputEqual(*d.Replayed)
+}
+
+func (r ReplayTaskResult) outputEqual(other ReplayTaskResult) bool {
+	a, err := r.Output.MarshalJSON()
+	if err != nil {
+		return false
+	}
+	b, err := other.Output.MarshalJSON()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(a, b)
+}
+
+func (b *ReplayBundle) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("ReplayBundle#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(bytes, b)
+}
+
+func (b ReplayBundle) Value() (driver.Value, error) {
+	return json.Marshal(b)
+}
+
+// newReplayBundle records the inputs of a run and the results of its tasks.
+// Values are normalised the same way as when they are persisted, so that a
+// bundle behaves identically whether it was just recorded or loaded from the
+// database.
+func newReplayBundle(inputs map[string]interface{}, results TaskRunResults) (*ReplayBundle, error) {
+	vars, err := normaliseReplayValue(inputs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to record run inputs")
+	}
+	varsMap, _ := vars.(map[string]interface{})
+
+	bundle := &ReplayBundle{
+		Fixtures: SimulationFixtures{
+			Vars:  varsMap,
+			Tasks: make(map[string]SimulationFixture),
+		},
+		Results: make(map[string]ReplayTaskResult, len(results)),
+	}
+	for _, trr := range results {
+		result, err := newReplayTaskResult(trr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to record result of task %s", trr.Task.Base().path())
+		}
+		bundle.Results[trr.Task.Base().path()] = result
+
+		if IsSimulatedTaskType(trr.Task.Type()) {
+			bundle.Fixtures.Tasks[trr.Task.Base().path()] = SimulationFixture{
+				Value: result.Output.Val,
+				Error: result.Error,
+			}
+		}
+	}
+	return bundle, nil
+}
+
+func newReplayTaskResult(trr TaskRunResult) (ReplayTaskResult, error) {
+	output, err := normaliseReplayValue(trr.Result.Value)
+	if err != nil {
+		return ReplayTaskResult{}, err
+	}
+	return ReplayTaskResult{
+		Type:   trr.Task.Type(),
+		Output: jsonserializable.JSONSerializable{Val: output, Valid: output != nil},
+		Error:  trr.Result.ErrorDB().String,
+	}, nil
+}
+
+// normaliseReplayValue round trips v through its database representation.
+func normaliseReplayValue(v interface{}) (interface{}, error) {
+	b, err := (Result{Value: v}).OutputDB().MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var js jsonserializable.JSONSerializable
+	if err = js.UnmarshalJSON(b); err != nil {
+		return nil, err
+	}
+	return js.Val, nil
+}
+
+// Diff compares the results of a replayed run against the original results
+// recorded in the bundle. Diffs are sorted by dot ID.
+func (b ReplayBundle) Diff(results TaskRunResults) ([]ReplayTaskDiff, error) {
+	diffs := make(map[string]*ReplayTaskDiff, len(b.Results))
+	for dotID, original := range b.Results {
+		original := original
+		diffs[dotID] = &ReplayTaskDiff{DotID: dotID, Type: original.Type, Original: &original}
+	}
+	for _, trr := range results {
+		replayed, err := newReplayTaskResult(trr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to normalise result of task %s", trr.Task.Base().path())
+		}
+		dotID := trr.Task.Base().path()
+		diff, ok := diffs[dotID]
+		if !ok {
+			diff = &ReplayTaskDiff{DotID: dotID, Type: trr.Task.Type()}
+			diffs[dotID] = diff
+		}
+		diff.Replayed = &replayed
+	}
+
+	out := make([]ReplayTaskDiff, 0, len(diffs))
+	for _, diff := range diffs {
+		out = append(out, *diff)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].DotID < out[j].DotID })
+	return out, nil
+}
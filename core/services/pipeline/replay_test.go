@@ -0,0 +1,107 @@
+package pipeline_test
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func TestRunner_ReplayRun(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"result":1.5}}`))
+	}))
+	defer s.Close()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, _ *chainlink.Secrets) {
+		enabled := true
+		c.JobPipeline.ReplayBundlesEnabled = &enabled
+	})
+	orm := mocks.NewORM(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(orm, bridgesMocks.NewORM(t), cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), c, c)
+
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+fetch    [type=http method=GET url="%s"]
+parse    [type=jsonparse path="data,result"]
+multiply [type=multiply input="$(parse)" times="$(times)"]
+
+fetch -> parse -> multiply
+`, s.URL)}
+
+	original, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(map[string]interface{}{"times": 100}))
+	require.NoError(t, err)
+	require.Equal(t, pipeline.RunStatusCompleted, original.State)
+	require.NotNil(t, original.ReplayBundle)
+	assert.Equal(t, map[string]interface{}{"times": int64(100)}, original.ReplayBundle.Fixtures.Vars)
+	assert.Equal(t, `{"data":{"result":1.5}}`, original.ReplayBundle.Fixtures.Tasks["fetch"].Value)
+	require.Len(t, original.ReplayBundle.Results, 3)
+
+	// the bundle is replayed as loaded from the database
+	value, err := original.ReplayBundle.Value()
+	require.NoError(t, err)
+	var bundle pipeline.ReplayBundle
+	require.NoError(t, bundle.Scan(value))
+
+	s.Close() // replays never reach out to the network
+
+	t.Run("matches the original run", func(t *testing.T) {
+		orm.On("FindReplayBundle", mock.Anything, int64(1)).Return(bundle, nil).Once()
+		orm.On("FindRun", mock.Anything, int64(1)).Return(pipeline.Run{ID: 1, PipelineSpec: spec}, nil).Once()
+
+		run, diffs, err := r.ReplayRun(testutils.Context(t), 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), run.ID)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		assert.Nil(t, run.ReplayBundle)
+		require.Len(t, diffs, 3)
+		for _, diff := range diffs {
+			assert.True(t, diff.Match(), diff.DotID)
+		}
+	})
+
+	t.Run("reports diverging tasks", func(t *testing.T) {
+		tampered := pipeline.ReplayBundle{Fixtures: bundle.Fixtures, Results: map[string]pipeline.ReplayTaskResult{}}
+		for dotID, result := range bundle.Results {
+			tampered.Results[dotID] = result
+		}
+		tampered.Results["multiply"] = pipeline.ReplayTaskResult{
+			Type:   pipeline.TaskTypeMultiply,
+			Output: jsonserializable.JSONSerializable{Val: "151", Valid: true},
+		}
+		orm.On("FindReplayBundle", mock.Anything, int64(2)).Return(tampered, nil).Once()
+		orm.On("FindRun", mock.Anything, int64(2)).Return(pipeline.Run{ID: 2, PipelineSpec: spec}, nil).Once()
+
+		_, diffs, err := r.ReplayRun(testutils.Context(t), 2)
+		require.NoError(t, err)
+		require.Len(t, diffs, 3)
+		for _, diff := range diffs {
+			assert.Equal(t, diff.DotID != "multiply", diff.Match(), diff.DotID)
+		}
+	})
+
+	t.Run("errors without a bundle", func(t *testing.T) {
+		orm.On("FindReplayBundle", mock.Anything, int64(3)).Return(pipeline.ReplayBundle{}, sql.ErrNoRows).Once()
+
+		_, _, err := r.ReplayRun(testutils.Context(t), 3)
+		require.ErrorIs(t, err, pipeline.ErrNoReplayBundle)
+	})
+}
@@ -2,6 +2,8 @@ package pipeline
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -36,9 +38,16 @@ type Runner interface {
 	// Note that `saveSuccessfulTaskRuns` value is ignored if the run contains async tasks.
 	Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool, fn func(tx sqlutil.DataSource) error) (incomplete bool, err error)
 	ResumeRun(ctx context.Context, taskID uuid.UUID, value interface{}, err error) error
+	// CancelRun marks an unfinished run as errored and stops executing it.
+	// Returns sql.ErrNoRows if the run does not exist, or ErrRunFinished if it has already finished.
+	CancelRun(ctx context.Context, runID int64) error
+	// ReplayRun re-executes a finished run from its replay bundle and diffs every task against the original run.
+	// Returns ErrNoReplayBundle if no bundle was recorded for the run.
+	ReplayRun(ctx context.Context, runID int64) (run *Run, diffs []ReplayTaskDiff, err error)
 
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
+	// If ctx was derived from ContextWithSimulation, external tasks are resolved against fixtures.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars) (run *Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	// ds is an optional override, for example when executing a transaction.
@@ -53,6 +62,9 @@ type Runner interface {
 
 	OnRunFinished(func(*Run))
 	InitializePipeline(spec Spec) (*Pipeline, error)
+
+	// JobStats summarises the runs of a job which finished recently, see JobStatsWindows.
+	JobStats(jobID int32) JobStats
 }
 
 type runner struct {
@@ -68,6 +80,12 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	circuitBreakers        *circuitBreakers
+	jobStats               *jobStatsTracker
+
+	// cancel funcs of the runs executing on this node, by run ID
+	inflightMu sync.Mutex
+	inflight   map[int64]context.CancelFunc
 
 	// test helper
 	runFinished func(*Run)
@@ -104,6 +122,20 @@ var (
 	},
 		[]string{"job_id", "job_name", "task_id", "task_type", "bridge_name", "status"},
 	)
+	PromPipelineRunDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_run_duration_seconds",
+		Help:    "How long each finished pipeline run took, including any time suspended",
+		Buckets: latencyBuckets,
+	},
+		[]string{"job_id", "job_name", "status"},
+	)
+	PromPipelineTaskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_task_duration_seconds",
+		Help:    "How long each finished pipeline task took to execute, by task type",
+		Buckets: latencyBuckets,
+	},
+		[]string{"job_id", "job_name", "task_type", "status"},
+	)
 )
 
 func NewRunner(
@@ -133,6 +165,9 @@ func NewRunner(
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		circuitBreakers:        newCircuitBreakers(cfg, lggr),
+		jobStats:               newJobStatsTracker(),
+		inflight:               make(map[int64]context.CancelFunc),
 	}
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
@@ -236,6 +271,9 @@ func (err ErrRunPanicked) Error() string {
 	return fmt.Sprintf("goroutine panicked when executing run: %v", err.v)
 }
 
+// ErrRunCancelled is recorded as the error of runs cancelled with CancelRun.
+var ErrRunCancelled = errors.New("pipeline run cancelled")
+
 func NewRun(spec Spec, vars Vars) *Run {
 	return &Run{
 		State:          RunStatusRunning,
@@ -327,7 +365,12 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	if err != nil {
 		return
 	}
+	r.initializeTasks(pipeline, spec)
+	return pipeline, nil
+}
 
+// initializeTasks injects the runner's dependencies into the tasks of pipeline.
+func (r *runner) initializeTasks(pipeline *Pipeline, spec Spec) {
 	// initialize certain task params
 	for _, task := range pipeline.Tasks {
 		task.Base().uuid = uuid.New()
@@ -347,6 +390,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).circuitBreakers = r.circuitBreakers
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -368,50 +412,38 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeETHSignTypedData:
+			task.(*ETHSignTypedDataTask).keyStore = r.ethKeyStore
+		case TaskTypeForEach:
+			task.(*ForEachTask).runner = r
+			task.(*ForEachTask).pipelineSpec = spec
 		default:
 		}
 	}
-
-	return pipeline, nil
 }
 
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
 	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
+	_, simulated := simulationFromContext(ctx)
+	recordReplay := r.config.ReplayBundlesEnabled() && !simulated
+	var inputs map[string]interface{}
+	if recordReplay {
+		// the scheduler writes task results into vars, keep a copy of the inputs
+		inputs = vars.Copy().vars
+	}
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
-	// This is "just in case" for cleaning up any stray reports.
-	// Normally the scheduler loop doesn't stop until all in progress runs report back
-	reportCtx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	if pipelineTimeout := r.config.MaxRunDuration(); pipelineTimeout != 0 {
+		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, pipelineTimeout)
 		defer cancel()
 	}
 
-	for taskRun := range scheduler.taskCh {
-		taskRun := taskRun
-		// execute
-		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
-
-			logTaskRunToPrometheus(result, run.PipelineSpec)
-
-			scheduler.report(reportCtx, result)
-		}, func(err interface{}) {
-			t := time.Now()
-			scheduler.report(reportCtx, TaskRunResult{
-				ID:         uuid.New(),
-				Task:       taskRun.task,
-				Result:     Result{Error: ErrRunPanicked{err}},
-				FinishedAt: null.TimeFrom(t),
-				CreatedAt:  t, // TODO: more accurate start time
-			})
-		})
-	}
+	r.executeTaskRuns(ctx, run.PipelineSpec, scheduler, l)
 
 	// if the run is suspended, awaiting resumption
 	run.Pending = scheduler.pending
@@ -439,7 +471,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 			Index:         result.Task.OutputIndex(),
 			Output:        output,
 			Error:         result.Result.ErrorDB(),
-			DotID:         result.Task.DotID(),
+			DotID:         result.Task.Base().path(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
 			task:          result.Task,
@@ -481,6 +513,23 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		}
 	}
 
+	// Record the tasks executed by foreach tasks, they do not contribute to the run's errors/outputs
+	run.nestedResults = scheduler.nestedResults()
+	for _, result := range run.nestedResults {
+		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
+			ID:            result.ID,
+			PipelineRunID: run.ID,
+			Type:          result.Task.Type(),
+			Index:         result.Task.OutputIndex(),
+			Output:        result.Result.OutputDB(),
+			Error:         result.Result.ErrorDB(),
+			DotID:         result.Task.Base().path(),
+			CreatedAt:     result.CreatedAt,
+			FinishedAt:    result.FinishedAt,
+			task:          result.Task,
+		})
+	}
+
 	// TODO: drop this once we stop using TaskRunResults
 	var taskRunResults TaskRunResults
 	for _, result := range scheduler.results {
@@ -499,6 +548,18 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		idxs[i] = taskRunResults[i].Task.OutputIndex()
 	}
 
+	if recordReplay && run.FinishedAt.Valid {
+		bundle, err := newReplayBundle(inputs, append(taskRunResults, run.nestedResults...))
+		if err != nil {
+			l.Warnw("Failed to record replay bundle", "err", err)
+		}
+		run.ReplayBundle = bundle
+	}
+
+	if run.FinishedAt.Valid && !simulated {
+		r.recordJobStats(run, runTime, append(taskRunResults, run.nestedResults...))
+	}
+
 	if r.config.VerboseLogging() {
 		l = l.With(
 			"run.PipelineTaskRuns", run.PipelineTaskRuns,
@@ -525,6 +586,54 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
+// executeTaskRuns executes the task runs scheduled by scheduler until it has
+// finished, reporting their results back.
+func (r *runner) executeTaskRuns(ctx context.Context, spec Spec, scheduler *scheduler, l logger.Logger) {
+	// This is "just in case" for cleaning up any stray reports.
+	// Normally the scheduler loop doesn't stop until all in progress runs report back
+	reportCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for taskRun := range scheduler.taskCh {
+		taskRun := taskRun
+		// execute
+		go recovery.WrapRecoverHandle(l, func() {
+			result := r.executeTaskRun(ctx, spec, taskRun, l)
+
+			logTaskRunToPrometheus(result, spec)
+
+			scheduler.report(reportCtx, result)
+		}, func(err interface{}) {
+			t := time.Now()
+			scheduler.report(reportCtx, TaskRunResult{
+				ID:         uuid.New(),
+				Task:       taskRun.task,
+				Result:     Result{Error: ErrRunPanicked{err}},
+				FinishedAt: null.TimeFrom(t),
+				CreatedAt:  t, // TODO: more accurate start time
+			})
+		})
+	}
+}
+
+// runNested executes the tasks of a foreach spec for a single element, and
+// returns their results along with the results of any tasks nested further.
+func (r *runner) runNested(ctx context.Context, pipeline *Pipeline, spec Spec, vars Vars, l logger.Logger) TaskRunResults {
+	scheduler := newScheduler(pipeline, &Run{PipelineSpec: spec}, vars, l)
+	go scheduler.Run()
+
+	r.executeTaskRuns(ctx, spec, scheduler, l)
+
+	var taskRunResults TaskRunResults
+	for _, result := range scheduler.results {
+		taskRunResults = append(taskRunResults, result)
+	}
+	sort.SliceStable(taskRunResults, func(i, j int) bool {
+		return taskRunResults[i].Task.OutputIndex() < taskRunResults[j].Task.OutputIndex()
+	})
+	return append(taskRunResults, scheduler.nestedResults()...)
+}
+
 func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
@@ -552,7 +661,13 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
-	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	var result Result
+	var runInfo RunInfo
+	if fixtures, simulated := simulationFromContext(ctx); simulated && IsSimulatedTaskType(taskRun.task.Type()) {
+		result = fixtures.resultFor(taskRun.task)
+	} else {
+		result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -600,6 +715,28 @@ func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
 	}
 
 	PromPipelineTasksTotalFinished.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, trr.Task.DotID(), string(trr.Task.Type()), bridgeName, status).Inc()
+	if trr.FinishedAt.Valid {
+		PromPipelineTaskDuration.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, string(trr.Task.Type()), status).Observe(elapsed.Seconds())
+	}
+}
+
+// recordJobStats records a finished run towards the stats of its job.
+func (r *runner) recordJobStats(run *Run, runTime time.Duration, results TaskRunResults) {
+	status := "completed"
+	if run.HasFatalErrors() {
+		status = "errored"
+	}
+	PromPipelineRunDuration.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName, status).Observe(runTime.Seconds())
+
+	// runs which don't belong to a job, e.g. executed for the CLI, are not tracked
+	if run.PipelineSpec.JobID == 0 {
+		return
+	}
+	r.jobStats.record(run.PipelineSpec.JobID, runTime, run.HasFatalErrors(), results)
+}
+
+func (r *runner) JobStats(jobID int32) JobStats {
+	return r.jobStats.stats(jobID)
 }
 
 // ExecuteAndInsertFinishedRun executes a run in memory then inserts the finished run/task run records, returning the final result
@@ -633,6 +770,10 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 
 	// retain old UUID values
 	for _, taskRun := range run.PipelineTaskRuns {
+		if pipeline.isNested(taskRun.DotID) {
+			// tasks of foreach specs are executed anew with their foreach task
+			continue
+		}
 		task := pipeline.ByDotID(taskRun.DotID)
 		if task == nil || task.Base() == nil {
 			return false, pkgerrors.Errorf("failed to match a pipeline task for dot ID: %v", taskRun.DotID)
@@ -675,6 +816,14 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 		return false, err
 	}
 
+	if preinsert {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		r.trackRun(run.ID, cancel)
+		defer r.untrackRun(run.ID)
+	}
+
 	for {
 		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})))
 
@@ -689,7 +838,11 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 
 			var restart bool
 			restart, err = r.orm.StoreRun(ctx, run)
-			if err != nil {
+			if errors.Is(err, ErrRunFinished) {
+				// the run was cancelled or timed out while executing, discard the results
+				r.lggr.Infow("Pipeline run finished while executing, discarding results", "runID", run.ID, "err", err)
+				return false, nil
+			} else if err != nil {
 				return false, pkgerrors.Wrapf(err, "error storing run for spec ID %v state %v outputs %v errors %v finished_at %v",
 					run.PipelineSpec.ID, run.State, run.Outputs, run.FatalErrors, run.FinishedAt)
 			}
@@ -743,6 +896,98 @@ func (r *runner) ResumeRun(ctx context.Context, taskID uuid.UUID, value interfac
 	return nil
 }
 
+func (r *runner) trackRun(runID int64, cancel context.CancelFunc) {
+	r.inflightMu.Lock()
+	defer r.inflightMu.Unlock()
+	r.inflight[runID] = cancel
+}
+
+func (r *runner) untrackRun(runID int64) {
+	r.inflightMu.Lock()
+	defer r.inflightMu.Unlock()
+	delete(r.inflight, runID)
+}
+
+// CancelRun fails an unfinished run, along with its pending tasks. If the run is
+// executing on this node, its context is cancelled and its results discarded.
+func (r *runner) CancelRun(ctx context.Context, runID int64) error {
+	run, err := r.orm.FindRun(ctx, runID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to cancel run %d", runID)
+	}
+	if err = r.failRun(ctx, run, ErrRunCancelled.Error()); err != nil {
+		return pkgerrors.Wrapf(err, "failed to cancel run %d", runID)
+	}
+
+	r.lggr.Infow("Pipeline run cancelled", "runID", runID)
+	return nil
+}
+
+// failRun marks an unfinished run and its pending tasks as errored with reason,
+// abandons the transactions of those tasks and stops executing the run on this node.
+func (r *runner) failRun(ctx context.Context, run Run, reason string) error {
+	// like run, record a fatal error and an output for each terminal task
+	terminalTasks := 1
+	if p, err := run.PipelineSpec.GetOrParsePipeline(); err == nil {
+		terminalTasks = 0
+		for _, task := range p.Tasks {
+			if len(task.Outputs()) == 0 {
+				terminalTasks++
+			}
+		}
+	}
+	taskRunIDs, err := r.orm.FailRun(ctx, run.ID, reason, terminalTasks)
+	if err != nil {
+		return err
+	}
+
+	r.inflightMu.Lock()
+	if cancel, ok := r.inflight[run.ID]; ok {
+		cancel()
+	}
+	r.inflightMu.Unlock()
+
+	if len(taskRunIDs) == 0 || r.legacyEVMChains == nil {
+		return nil
+	}
+	// the task runs have failed, so their transactions must neither be sent nor resume the run
+	for _, chain := range r.legacyEVMChains.Slice() {
+		if err = chain.TxManager().AbandonPipelineTaskRunTxs(ctx, taskRunIDs, reason); err != nil {
+			r.lggr.Errorw("Failed to abandon the transactions of failed pipeline task runs", "runID", run.ID, "evmChainID", chain.ID(), "err", err)
+		}
+	}
+	return nil
+}
+
+// ReplayRun re-executes a recorded run from its replay bundle, without any side
+// effects, and diffs the result of every task against the original run.
+func (r *runner) ReplayRun(ctx context.Context, runID int64) (*Run, []ReplayTaskDiff, error) {
+	bundle, err := r.orm.FindReplayBundle(ctx, runID)
+	if pkgerrors.Is(err, sql.ErrNoRows) {
+		return nil, nil, pkgerrors.Wrapf(ErrNoReplayBundle, "run %d", runID)
+	} else if err != nil {
+		return nil, nil, pkgerrors.Wrapf(err, "failed to load replay bundle for run %d", runID)
+	}
+	original, err := r.orm.FindRun(ctx, runID)
+	if err != nil {
+		return nil, nil, pkgerrors.Wrapf(err, "failed to load run %d", runID)
+	}
+
+	spec := original.PipelineSpec
+	spec.Pipeline = nil
+	run, trrs, err := r.ExecuteRun(ContextWithSimulation(ctx, bundle.Fixtures), spec, NewVarsFrom(bundle.Fixtures.Vars))
+	if err != nil {
+		return nil, nil, err
+	}
+	run.ID = original.ID
+
+	diffs, err := bundle.Diff(append(trrs, run.nestedResults...))
+	if err != nil {
+		return nil, nil, err
+	}
+	return run, diffs, nil
+}
+
 func (r *runner) InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *Run, saveSuccessfulTaskRuns bool) error {
 	orm := r.orm
 	if ds != nil {
@@ -771,6 +1016,39 @@ func (r *runner) runReaper() {
 	} else {
 		r.lggr.Debugw("Pipeline run reaper completed successfully")
 	}
+
+	r.failExpiredSuspendedRuns(ctx)
+}
+
+// failExpiredSuspendedRuns marks runs which are still suspended after MaxSuspendDuration as errored.
+func (r *runner) failExpiredSuspendedRuns(ctx context.Context) {
+	maxSuspendDuration := r.config.MaxSuspendDuration()
+	if maxSuspendDuration == 0 {
+		return
+	}
+
+	reason := fmt.Sprintf("pipeline run did not finish within MaxSuspendDuration (%s)", maxSuspendDuration)
+	runs, err := r.orm.FindSuspendedRunsOlderThan(ctx, maxSuspendDuration)
+	if err != nil {
+		r.lggr.Errorw("Failed to find expired suspended pipeline runs", "err", err)
+		r.SvcErrBuffer.Append(err)
+		return
+	}
+	var runIDs []int64
+	for _, run := range runs {
+		// skip the runs resumed and finished meanwhile
+		if err = r.failRun(ctx, run, reason); pkgerrors.Is(err, ErrRunFinished) {
+			continue
+		} else if err != nil {
+			r.lggr.Errorw("Failed to fail expired suspended pipeline run", "runID", run.ID, "err", err)
+			r.SvcErrBuffer.Append(err)
+			continue
+		}
+		runIDs = append(runIDs, run.ID)
+	}
+	if len(runIDs) > 0 {
+		r.lggr.Warnw("Failed pipeline runs suspended for longer than MaxSuspendDuration", "runIDs", runIDs, "maxSuspendDuration", maxSuspendDuration)
+	}
 }
 
 // init task: Searches the database for runs stuck in the 'running' state while the node was previously killed.
@@ -781,14 +1059,16 @@ func (r *runner) scheduleUnfinishedRuns() {
 	// limit using a createdAt < now() @ start of run to prevent executing new jobs
 	now := time.Now()
 
+	ctx, cancel := r.chStop.NewCtx()
+	defer cancel()
+
 	if r.config.ReaperInterval() > time.Duration(0) {
-		// immediately run reaper so we don't consider runs that are too old
+		// immediately run reaper so we don't consider runs that are too old, nor runs suspended for too long
 		r.runReaper()
+	} else {
+		r.failExpiredSuspendedRuns(ctx)
 	}
 
-	ctx, cancel := r.chStop.NewCtx()
-	defer cancel()
-
 	var wgRunsDone sync.WaitGroup
 	err := r.orm.GetUnfinishedRuns(ctx, now, func(run Run) error {
 		wgRunsDone.Add(1)
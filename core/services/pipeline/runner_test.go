@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +26,9 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	legacyevmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -34,6 +39,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -1049,3 +1055,82 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_CancelRun(t *testing.T) {
+	t.Parallel()
+
+	requested, stop := make(chan struct{}), make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		close(requested)
+		select {
+		case <-req.Context().Done():
+		case <-stop:
+		}
+	}))
+	defer s.Close()
+	defer close(stop)
+	u, err := url.ParseRequestURI(s.URL)
+	require.NoError(t, err)
+
+	cfg := configtest.NewGeneralConfig(t, nil)
+	btORM := bridgesMocks.NewORM(t)
+	bridge := bridges.BridgeType{Name: bridges.MustParseBridgeName("blocking"), URL: models.WebURL(*u)}
+	btORM.On("FindBridge", mock.Anything, bridge.Name).Return(bridge, nil).Once()
+	orm := mocks.NewORM(t)
+	txm := txmmocks.NewMockEvmTxManager(t)
+	chain := legacyevmmocks.NewChain(t)
+	chain.On("TxManager").Return(txm)
+	legacyChains := legacyevmmocks.NewLegacyChainContainer(t)
+	legacyChains.On("Slice").Return([]legacyevm.Chain{chain})
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, nil, nil, logger.TestLogger(t), c, c)
+
+	transactCall := orm.On("Transact", mock.Anything, mock.Anything)
+	transactCall.Run(func(args mock.Arguments) {
+		fn := args[1].(func(orm pipeline.ORM) error)
+		transactCall.ReturnArguments = mock.Arguments{fn(orm)}
+	})
+	orm.On("CreateRun", mock.Anything, mock.AnythingOfType("*pipeline.Run")).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(1).(*pipeline.Run).ID = 1
+	}).Once()
+	spec := pipeline.Spec{DotDagSource: `ds1 [type=bridge async=true name="blocking"]`}
+	orm.On("FindRun", mock.Anything, int64(1)).Return(pipeline.Run{ID: 1, PipelineSpec: spec}, nil).Once()
+	pendingTaskRunIDs := []uuid.UUID{uuid.New()}
+	orm.On("FailRun", mock.Anything, int64(1), pipeline.ErrRunCancelled.Error(), 1).Return(pendingTaskRunIDs, nil).Once()
+	// the transactions of the failed task runs are abandoned
+	txm.On("AbandonPipelineTaskRunTxs", mock.Anything, pendingTaskRunIDs, pipeline.ErrRunCancelled.Error()).Return(nil).Once()
+	// the run was finished by CancelRun, so storing its results fails
+	orm.On("StoreRun", mock.Anything, mock.AnythingOfType("*pipeline.Run")).Return(false, pipeline.ErrRunFinished).Once()
+
+	var finished bool
+	r.OnRunFinished(func(*pipeline.Run) { finished = true })
+
+	run := pipeline.NewRun(spec, pipeline.NewVarsFrom(nil))
+	done := make(chan error)
+	go func() {
+		_, err := r.Run(testutils.Context(t), run, false, nil)
+		done <- err
+	}()
+
+	select {
+	case <-requested:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the bridge request")
+	}
+	require.NoError(t, r.CancelRun(testutils.Context(t), 1))
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the run to be cancelled")
+	}
+	assert.False(t, finished)
+
+	orm.On("FindRun", mock.Anything, int64(2)).Return(pipeline.Run{ID: 2, PipelineSpec: spec}, nil).Once()
+	orm.On("FailRun", mock.Anything, int64(2), pipeline.ErrRunCancelled.Error(), 1).Return(nil, pipeline.ErrRunFinished).Once()
+	require.ErrorIs(t, r.CancelRun(testutils.Context(t), 2), pipeline.ErrRunFinished)
+
+	orm.On("FindRun", mock.Anything, int64(3)).Return(pipeline.Run{}, sql.ErrNoRows).Once()
+	require.ErrorIs(t, r.CancelRun(testutils.Context(t), 3), sql.ErrNoRows)
+}
@@ -54,6 +54,7 @@ type scheduler struct {
 	dependencies map[int]uint
 	waiting      uint
 	results      map[int]TaskRunResult
+	nested       map[int]TaskRunResults
 	vars         Vars
 	logger       logger.Logger
 
@@ -77,6 +78,7 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 		run:          run,
 		dependencies: dependencies,
 		results:      make(map[int]TaskRunResult, len(p.Tasks)),
+		nested:       make(map[int]TaskRunResults),
 		vars:         vars,
 		logger:       lggr,
 
@@ -117,6 +119,10 @@ func (s *scheduler) reconstructResults() {
 		task := s.pipeline.ByDotID(r.DotID)
 
 		if task == nil {
+			if s.pipeline.isNested(r.DotID) {
+				// tasks of foreach specs are recorded alongside their foreach task
+				continue
+			}
 			panic("can't find task by dot id")
 		}
 
@@ -182,8 +188,9 @@ func (s *scheduler) Run() {
 			result.Attempts++
 		}
 
-		// store task run
+		// store task run, along with the tasks executed by foreach tasks
 		s.results[result.Task.ID()] = result
+		s.nested[result.Task.ID()] = result.runInfo.nested
 
 		// catch the pending state, we will keep the pipeline running until no more progress is made
 		if result.runInfo.IsPending {
@@ -220,7 +227,7 @@ func (s *scheduler) Run() {
 		}
 
 		// if task hasn't reached it's max retry count yet, we schedule it again
-		if result.Attempts < uint(result.Task.TaskRetries()) && result.Result.Error != nil {
+		if result.Attempts < uint(result.Task.TaskRetries()) && result.Result.Error != nil && !result.runInfo.SkipRetries {
 			// we immediately increase the in-flight counter so the pipeline doesn't terminate
 			// while we wait for the next retry
 			s.waiting++
@@ -274,6 +281,15 @@ func (s *scheduler) Run() {
 	close(s.taskCh)
 }
 
+// nestedResults returns the results of the tasks executed by foreach tasks,
+// in the topological order of the foreach tasks.
+func (s *scheduler) nestedResults() (results TaskRunResults) {
+	for _, task := range s.pipeline.Tasks {
+		results = append(results, s.nested[task.ID()]...)
+	}
+	return results
+}
+
 func (s *scheduler) markRemaining(err error) {
 	now := time.Now()
 	for _, task := range s.pipeline.Tasks {
@@ -15,6 +15,7 @@ import (
 type event struct {
 	expected string
 	result   Result
+	runInfo  RunInfo
 }
 
 func TestScheduler(t *testing.T) {
@@ -76,6 +77,33 @@ func TestScheduler(t *testing.T) {
 				require.Equal(t, ErrTimeout, result.Result.Error)
 			},
 		},
+		{
+			name: "retry: skip retries if the task says so",
+			spec: `
+			a [type=median retries=3 minBackoff="1us" maxBackoff="1us"]
+			b [type=median index=0]
+			a -> b`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTaskRunFailed},
+				},
+				{
+					expected: "a",
+					result:   Result{Error: ErrTimeout},
+					runInfo:  RunInfo{SkipRetries: true},
+				},
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				result := results[p.ByDotID("a").ID()]
+				require.Equal(t, uint(2), result.Attempts)
+				require.Equal(t, ErrTimeout, result.Result.Error)
+			},
+		},
 		{
 			name: "retry task: proceed when it succeeds",
 			spec: `
@@ -148,6 +176,7 @@ func TestScheduler(t *testing.T) {
 					ID:         uuid.New(),
 					Task:       taskRun.task,
 					Result:     event.result,
+					runInfo:    event.runInfo,
 					FinishedAt: null.TimeFrom(now),
 					CreatedAt:  now,
 				})
@@ -0,0 +1,120 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/hex"
+)
+
+var ErrNoSimulationFixture = errors.New("no simulation fixture for task")
+
+// SimulationFixtures describes the inputs of a simulated (dry) run: the
+// variables the run is started with, and canned results for every task that
+// would otherwise reach out to the network or the tx manager.
+type SimulationFixtures struct {
+	Vars  map[string]interface{}       `json:"vars"`
+	Tasks map[string]SimulationFixture `json:"tasks"`
+}
+
+// SimulationFixture is the result returned in place of executing a task, keyed
+// by the task's dot ID in SimulationFixtures.Tasks. Tasks of foreach specs are
+// keyed by the element they are executed for, e.g. "prices[0].fetch".
+//
+// For http and bridge tasks the value is the response body; non-string values
+// are JSON encoded. For ethcall tasks the value is the hex encoded return data.
+type SimulationFixture struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error,omitempty"`
+}
+
+type simulationCtxKey struct{}
+
+// ContextWithSimulation returns a context that makes the runner resolve
+// external tasks (see IsSimulatedTaskType) against fixtures instead of
+// executing them. Runs executed with this context have no side effects.
+func ContextWithSimulation(ctx context.Context, fixtures SimulationFixtures) context.Context {
+	return context.WithValue(ctx, simulationCtxKey{}, fixtures)
+}
+
+func simulationFromContext(ctx context.Context) (SimulationFixtures, bool) {
+	fixtures, ok := ctx.Value(simulationCtxKey{}).(SimulationFixtures)
+	return fixtures, ok
+}
+
+// IsSimulatedTaskType returns true for task types that are never executed
+// during a simulated run because they perform network requests or
+// send transactions.
+func IsSimulatedTaskType(taskType TaskType) bool {
+	switch taskType {
+	case TaskTypeHTTP, TaskTypeBridge, TaskTypeETHCall, TaskTypeETHTx, TaskTypeEstimateGasLimit:
+		return true
+	default:
+		return false
+	}
+}
+
+// resultFor returns the fixture result for task. Tasks of foreach specs are
+// resolved by the path they are recorded under, e.g. "prices[0].fetch".
+func (f SimulationFixtures) resultFor(task Task) Result {
+	fixture, ok := f.Tasks[task.Base().path()]
+	if !ok {
+		return Result{Error: errors.Wrapf(ErrNoSimulationFixture, "%s (type %s)", task.Base().path(), task.Type())}
+	}
+	if fixture.Error != "" {
+		return Result{Error: errors.New(fixture.Error)}
+	}
+
+	switch task.Type() {
+	case TaskTypeHTTP, TaskTypeBridge:
+		// tasks which always stringify the response body
+		switch v := fixture.Value.(type) {
+		case string:
+			return Result{Value: v}
+		case nil:
+			return Result{Value: ""}
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				return Result{Error: errors.Wrapf(ErrBadInput, "fixture for %s: %v", task.DotID(), err)}
+			}
+			return Result{Value: string(b)}
+		}
+	case TaskTypeETHCall:
+		s, ok := fixture.Value.(string)
+		if !ok {
+			return Result{Error: errors.Wrapf(ErrBadInput, "fixture for %s: expected hex string, got %T", task.DotID(), fixture.Value)}
+		}
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "fixture for %s: %v", task.DotID(), err)}
+		}
+		return Result{Value: b}
+	case TaskTypeEstimateGasLimit:
+		var limit Uint64Param
+		if err := limit.UnmarshalPipelineParam(fixture.Value); err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "fixture for %s: %v", task.DotID(), err)}
+		}
+		return Result{Value: uint64(limit)}
+	default:
+		return Result{Value: fixture.Value}
+	}
+}
+
+// Validate checks that every fixture refers to a task in p which is resolved by
+// fixtures during a simulated run.
+func (f SimulationFixtures) Validate(p *Pipeline) error {
+	for dotID := range f.Tasks {
+		task := p.byPath(dotID)
+		if task == nil {
+			return fmt.Errorf("fixture for unknown task %q", dotID)
+		}
+		if !IsSimulatedTaskType(task.Type()) {
+			return fmt.Errorf("fixture for task %q of type %s: only external tasks can be simulated", dotID, task.Type())
+		}
+	}
+	return nil
+}
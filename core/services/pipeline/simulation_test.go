@@ -0,0 +1,108 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const simulationDAG = `
+fetch    [type=http method=GET url="https://example.invalid/price"]
+parse    [type=jsonparse path="data,result"]
+multiply [type=multiply input="$(parse)" times=100]
+
+call     [type=ethcall contract="0x0000000000000000000000000000000000000001" data="0x01" evmChainID=0]
+decode   [type=ethabidecode abi="uint256 answer"]
+
+fetch -> parse -> multiply
+call -> decode
+`
+
+func newSimulationRunner(t *testing.T) pipeline.Runner {
+	cfg := configtest.NewTestGeneralConfig(t)
+	return pipeline.NewRunner(nil, bridgesMocks.NewORM(t), cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+}
+
+func TestSimulation_ExecuteRun(t *testing.T) {
+	t.Parallel()
+
+	r := newSimulationRunner(t)
+	spec := pipeline.Spec{DotDagSource: simulationDAG}
+
+	t.Run("resolves external tasks against fixtures", func(t *testing.T) {
+		fixtures := pipeline.SimulationFixtures{
+			Tasks: map[string]pipeline.SimulationFixture{
+				"fetch": {Value: map[string]interface{}{"data": map[string]interface{}{"result": 1.5}}},
+				"call":  {Value: "0x000000000000000000000000000000000000000000000000000000000000002a"},
+			},
+		}
+		ctx := pipeline.ContextWithSimulation(testutils.Context(t), fixtures)
+
+		run, trrs, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		require.Len(t, trrs, 5)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+
+		final := trrs.FinalResult()
+		require.Len(t, final.Values, 2)
+		assert.False(t, final.HasFatalErrors())
+		for _, v := range final.Values {
+			switch value := v.(type) {
+			case decimal.Decimal:
+				assert.Equal(t, "150", value.String())
+			case map[string]interface{}:
+				assert.Equal(t, big.NewInt(42), value["answer"])
+			default:
+				t.Fatalf("unexpected output %T", v)
+			}
+		}
+	})
+
+	t.Run("errors on missing fixture", func(t *testing.T) {
+		fixtures := pipeline.SimulationFixtures{
+			Tasks: map[string]pipeline.SimulationFixture{
+				"fetch": {Error: "boom"},
+			},
+		}
+		ctx := pipeline.ContextWithSimulation(testutils.Context(t), fixtures)
+
+		run, trrs, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+
+		for _, trr := range trrs {
+			switch trr.Task.DotID() {
+			case "fetch":
+				assert.EqualError(t, trr.Result.Error, "boom")
+			case "call":
+				assert.ErrorIs(t, trr.Result.Error, pipeline.ErrNoSimulationFixture)
+			}
+		}
+	})
+}
+
+func TestSimulationFixtures_Validate(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(simulationDAG)
+	require.NoError(t, err)
+
+	require.NoError(t, pipeline.SimulationFixtures{
+		Tasks: map[string]pipeline.SimulationFixture{"fetch": {}, "call": {}},
+	}.Validate(p))
+	require.ErrorContains(t, pipeline.SimulationFixtures{
+		Tasks: map[string]pipeline.SimulationFixture{"nope": {}},
+	}.Validate(p), `unknown task "nope"`)
+	require.ErrorContains(t, pipeline.SimulationFixtures{
+		Tasks: map[string]pipeline.SimulationFixture{"parse": {}},
+	}.Validate(p), "only external tasks can be simulated")
+}
@@ -0,0 +1,270 @@
+package pipeline
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// JobStatsWindows are the rolling windows over which job stats are reported.
+var JobStatsWindows = []time.Duration{15 * time.Minute, time.Hour, 24 * time.Hour}
+
+// jobStatsSlotWidth is the resolution of job stats: runs are aggregated into
+// slots of this width, and windows are aligned to them.
+const jobStatsSlotWidth = 5 * time.Minute
+
+// latencyBuckets are the upper bounds, in seconds, of the buckets run and task
+// durations are recorded in, both for job stats and Prometheus.
+var latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+// JobStats summarises the recent runs of a job, for each of JobStatsWindows.
+// Stats are kept in memory and start over when the node restarts.
+type JobStats struct {
+	JobID   int32
+	Windows []JobStatsWindow
+}
+
+// JobStatsWindow summarises the runs of a job which finished within Window.
+type JobStatsWindow struct {
+	Window      time.Duration
+	Runs        uint64
+	ErroredRuns uint64
+	// SuccessRatio is the fraction of runs which finished without fatal errors, or 0 if there were no runs
+	SuccessRatio float64
+	RunDuration  LatencyPercentiles
+	// Tasks are sorted by type
+	Tasks []TaskTypeStats
+}
+
+// TaskTypeStats summarises the task runs of a single type.
+type TaskTypeStats struct {
+	Type        TaskType
+	Runs        uint64
+	ErroredRuns uint64
+	Duration    LatencyPercentiles
+}
+
+// LatencyPercentiles are estimated from histogram buckets, by linear
+// interpolation within the bucket a percentile falls into.
+type LatencyPercentiles struct {
+	P50 time.Duration
+	P95 time.Duration
+	P99 time.Duration
+}
+
+type latencyHistogram struct {
+	counts []uint64 // one per bucket, plus +Inf
+	total  uint64
+}
+
+func (h *latencyHistogram) init() {
+	if h.counts == nil {
+		h.counts = make([]uint64, len(latencyBuckets)+1)
+	}
+}
+
+func (h *latencyHistogram) observe(d time.Duration) {
+	h.init()
+	i := sort.SearchFloat64s(latencyBuckets, d.Seconds())
+	h.counts[i]++
+	h.total++
+}
+
+func (h *latencyHistogram) merge(other *latencyHistogram) {
+	h.init()
+	for i, count := range other.counts {
+		h.counts[i] += count
+	}
+	h.total += other.total
+}
+
+// quantile works like Prometheus' histogram_quantile: values in the +Inf
+// bucket are reported as the highest bucket bound.
+func (h *latencyHistogram) quantile(q float64) time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+	rank := q * float64(h.total)
+	var cumulative uint64
+	for i, count := range h.counts {
+		if count == 0 || float64(cumulative+count) < rank {
+			cumulative += count
+			continue
+		}
+		if i == len(latencyBuckets) {
+			break
+		}
+		lower := 0.0
+		if i > 0 {
+			lower = latencyBuckets[i-1]
+		}
+		seconds := lower + (latencyBuckets[i]-lower)*(rank-float64(cumulative))/float64(count)
+		return time.Duration(seconds * float64(time.Second))
+	}
+	return time.Duration(latencyBuckets[len(latencyBuckets)-1] * float64(time.Second))
+}
+
+func (h *latencyHistogram) percentiles() LatencyPercentiles {
+	return LatencyPercentiles{
+		P50: h.quantile(0.5),
+		P95: h.quantile(0.95),
+		P99: h.quantile(0.99),
+	}
+}
+
+type taskTypeStats struct {
+	errored  uint64
+	duration latencyHistogram
+}
+
+// jobStatsSlot holds the runs of a job which finished within a single slot.
+type jobStatsSlot struct {
+	start       time.Time
+	erroredRuns uint64
+	runDuration latencyHistogram
+	tasks       map[TaskType]*taskTypeStats
+}
+
+// jobStatsTracker records the runs of every job into a ring of slots,
+// covering the longest of JobStatsWindows.
+type jobStatsTracker struct {
+	mu        sync.Mutex
+	jobs      map[int32][]*jobStatsSlot
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+func newJobStatsTracker() *jobStatsTracker {
+	return &jobStatsTracker{
+		jobs: make(map[int32][]*jobStatsSlot),
+		now:  time.Now,
+	}
+}
+
+func jobStatsRetention() time.Duration {
+	return JobStatsWindows[len(JobStatsWindows)-1]
+}
+
+// slotFor returns the slot for t, resetting it if it holds an older slot's runs.
+func slotFor(slots []*jobStatsSlot, t time.Time) *jobStatsSlot {
+	start := t.Truncate(jobStatsSlotWidth)
+	i := int(start.Unix()/int64(jobStatsSlotWidth.Seconds())) % len(slots)
+	if slots[i] == nil || !slots[i].start.Equal(start) {
+		slots[i] = &jobStatsSlot{start: start, tasks: make(map[TaskType]*taskTypeStats)}
+	}
+	return slots[i]
+}
+
+// record adds a finished run of jobID, along with its task runs.
+func (t *jobStatsTracker) record(jobID int32, runDuration time.Duration, errored bool, results TaskRunResults) {
+	now := t.now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.prune(now)
+
+	slots, ok := t.jobs[jobID]
+	if !ok {
+		slots = make([]*jobStatsSlot, jobStatsRetention()/jobStatsSlotWidth)
+		t.jobs[jobID] = slots
+	}
+	slot := slotFor(slots, now)
+
+	slot.runDuration.observe(runDuration)
+	if errored {
+		slot.erroredRuns++
+	}
+	for _, result := range results {
+		if !result.FinishedAt.Valid {
+			continue
+		}
+		stats, ok := slot.tasks[result.Task.Type()]
+		if !ok {
+			stats = &taskTypeStats{}
+			slot.tasks[result.Task.Type()] = stats
+		}
+		stats.duration.observe(result.FinishedAt.Time.Sub(result.CreatedAt))
+		if result.Result.Error != nil {
+			stats.errored++
+		}
+	}
+}
+
+// prune forgets jobs without runs in the longest window, e.g. deleted jobs.
+func (t *jobStatsTracker) prune(now time.Time) {
+	if now.Sub(t.lastPrune) < jobStatsSlotWidth {
+		return
+	}
+	t.lastPrune = now
+
+	cutoff := now.Add(-jobStatsRetention())
+	for jobID, slots := range t.jobs {
+		var active bool
+		for _, slot := range slots {
+			if slot != nil && slot.start.After(cutoff) {
+				active = true
+				break
+			}
+		}
+		if !active {
+			delete(t.jobs, jobID)
+		}
+	}
+}
+
+// stats summarises the runs of jobID for each of JobStatsWindows.
+func (t *jobStatsTracker) stats(jobID int32) JobStats {
+	now := t.now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats := JobStats{JobID: jobID}
+	for _, window := range JobStatsWindows {
+		// include the slot the start of the window falls into
+		cutoff := now.Add(-window).Truncate(jobStatsSlotWidth)
+
+		var (
+			runDuration latencyHistogram
+			erroredRuns uint64
+			tasks       = make(map[TaskType]*taskTypeStats)
+		)
+		for _, slot := range t.jobs[jobID] {
+			if slot == nil || slot.start.Before(cutoff) {
+				continue
+			}
+			runDuration.merge(&slot.runDuration)
+			erroredRuns += slot.erroredRuns
+			for taskType, slotTasks := range slot.tasks {
+				if _, ok := tasks[taskType]; !ok {
+					tasks[taskType] = &taskTypeStats{}
+				}
+				tasks[taskType].duration.merge(&slotTasks.duration)
+				tasks[taskType].errored += slotTasks.errored
+			}
+		}
+
+		w := JobStatsWindow{
+			Window:      window,
+			Runs:        runDuration.total,
+			ErroredRuns: erroredRuns,
+			RunDuration: runDuration.percentiles(),
+			Tasks:       []TaskTypeStats{},
+		}
+		if w.Runs > 0 {
+			w.SuccessRatio = float64(w.Runs-w.ErroredRuns) / float64(w.Runs)
+		}
+		for taskType, taskStats := range tasks {
+			w.Tasks = append(w.Tasks, TaskTypeStats{
+				Type:        taskType,
+				Runs:        taskStats.duration.total,
+				ErroredRuns: taskStats.errored,
+				Duration:    taskStats.duration.percentiles(),
+			})
+		}
+		sort.Slice(w.Tasks, func(i, j int) bool { return w.Tasks[i].Type < w.Tasks[j].Type })
+		stats.Windows = append(stats.Windows, w)
+	}
+	return stats
+}
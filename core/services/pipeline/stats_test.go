@@ -0,0 +1,77 @@
+package pipeline
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestJobStatsTracker(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 1, 0, 0, time.UTC)
+	tracker := newJobStatsTracker()
+	tracker.now = func() time.Time { return now }
+
+	httpTask := &HTTPTask{BaseTask: NewBaseTask(0, "fetch", nil, nil, 0)}
+	taskRun := func(duration time.Duration, err error) TaskRunResults {
+		return TaskRunResults{{
+			Task:       httpTask,
+			Result:     Result{Error: err},
+			CreatedAt:  now,
+			FinishedAt: null.TimeFrom(now.Add(duration)),
+		}}
+	}
+
+	for i := 0; i < 90; i++ {
+		tracker.record(1, 75*time.Millisecond, false, taskRun(50*time.Millisecond, nil))
+	}
+	for i := 0; i < 10; i++ {
+		tracker.record(1, 3*time.Second, true, taskRun(time.Second, errors.New("timeout")))
+	}
+	// pending task runs are not recorded
+	tracker.record(2, time.Second, false, TaskRunResults{{Task: httpTask, CreatedAt: now}})
+
+	stats := tracker.stats(1)
+	assert.Equal(t, int32(1), stats.JobID)
+	require.Len(t, stats.Windows, len(JobStatsWindows))
+	for _, w := range stats.Windows {
+		assert.Equal(t, uint64(100), w.Runs)
+		assert.Equal(t, uint64(10), w.ErroredRuns)
+		assert.InDelta(t, 0.9, w.SuccessRatio, 1e-9)
+		assert.InDelta(t, 77.78, float64(w.RunDuration.P50)/float64(time.Millisecond), 0.01)
+		assert.Equal(t, 3750*time.Millisecond, w.RunDuration.P95)
+		assert.Equal(t, 4750*time.Millisecond, w.RunDuration.P99)
+
+		require.Len(t, w.Tasks, 1)
+		assert.Equal(t, TaskTypeHTTP, w.Tasks[0].Type)
+		assert.Equal(t, uint64(100), w.Tasks[0].Runs)
+		assert.Equal(t, uint64(10), w.Tasks[0].ErroredRuns)
+	}
+	assert.Empty(t, tracker.stats(2).Windows[0].Tasks)
+
+	// runs age out of the shorter windows
+	now = now.Add(30 * time.Minute)
+	tracker.record(1, 10*time.Minute, false, nil)
+	stats = tracker.stats(1)
+	assert.Equal(t, uint64(1), stats.Windows[0].Runs)
+	assert.Equal(t, float64(1), stats.Windows[0].SuccessRatio)
+	assert.Equal(t, 300*time.Second, stats.Windows[0].RunDuration.P50, "durations above the highest bucket are reported as its bound")
+	assert.Equal(t, uint64(101), stats.Windows[1].Runs)
+	assert.Equal(t, uint64(101), stats.Windows[2].Runs)
+
+	// jobs without runs in the longest window are forgotten
+	now = now.Add(25 * time.Hour)
+	for _, w := range tracker.stats(1).Windows {
+		assert.Zero(t, w.Runs)
+		assert.Zero(t, w.SuccessRatio)
+		assert.Zero(t, w.RunDuration.P99)
+	}
+	tracker.record(2, time.Second, false, nil)
+	assert.NotContains(t, tracker.jobs, int32(1))
+	assert.Contains(t, tracker.jobs, int32(2))
+}
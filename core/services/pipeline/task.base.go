@@ -25,6 +25,8 @@ type BaseTask struct {
 	Tags string `mapstructure:"tags" json:"-"`
 
 	uuid uuid.UUID
+	// nestedIn is set on tasks executed for an element of a foreach task, e.g. "prices[0]"
+	nestedIn string
 }
 
 func NewBaseTask(id int, dotID string, inputs []TaskDependency, outputs []Task, index int32) BaseTask {
@@ -43,6 +45,15 @@ func (t BaseTask) DotID() string {
 	return t.dotID
 }
 
+// path returns the dot ID qualified by the foreach element the task is
+// executed for, e.g. "prices[0].fetch", or the dot ID for top-level tasks.
+func (t BaseTask) path() string {
+	if t.nestedIn == "" {
+		return t.dotID
+	}
+	return t.nestedIn + "." + t.dotID
+}
+
 func (t BaseTask) OutputIndex() int32 {
 	return t.Index
 }
@@ -63,12 +63,14 @@ type BridgeTask struct {
 	Async             string `json:"async"`
 	CacheTTL          string `json:"cacheTTL"`
 	Headers           string `json:"headers"`
-
-	specId       int32
-	orm          bridges.ORM
-	config       Config
-	bridgeConfig BridgeConfig
-	httpClient   *http.Client
+	RetryOn           string `json:"retryOn"`
+
+	specId          int32
+	orm             bridges.ORM
+	config          Config
+	bridgeConfig    BridgeConfig
+	httpClient      *http.Client
+	circuitBreakers *circuitBreakers
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -93,6 +95,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		includeInputAtKey StringParam
 		cacheTTL          Uint64Param
 		reqHeaders        StringSliceParam
+		retryOn           Uint64SliceParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
@@ -100,6 +103,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		errors.Wrap(ResolveParam(&includeInputAtKey, From(t.IncludeInputAtKey)), "includeInputAtKey"),
 		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), t.bridgeConfig.BridgeCacheTTL().Seconds())), "cacheTTL"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&retryOn, From(VarExpr(t.RetryOn, vars), NonemptyString(t.RetryOn), nil)), "retryOn"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -169,12 +173,25 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		cacheDuration = stalenessCap
 	}
 
-	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	var (
+		cachedResponse bool
+		responseBytes  []byte
+		statusCode     int
+		headers        http.Header
+		elapsed        time.Duration
+	)
+	breaker := t.circuitBreakers.get(string(name))
+	if breaker.allow() {
+		responseBytes, statusCode, headers, elapsed, err = makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
 
-	// check for external adapter response object status
-	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
-		statusCode = code
+		// check for external adapter response object status
+		if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
+			statusCode = code
+		}
+		// client errors are not caused by an outage of the bridge
+		breaker.report((err == nil && statusCode == http.StatusOK) || !isRetryableHTTPError(statusCode, err))
+	} else {
+		err = errors.Wrapf(ErrCircuitOpen, "bridge %s", name)
 	}
 
 	if err != nil || statusCode != http.StatusOK {
@@ -183,8 +200,12 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 
 		promBridgeErrors.WithLabelValues(t.Name).Inc()
+		failedRunInfo := RunInfo{
+			IsRetryable: isRetryableHTTPError(statusCode, err),
+			SkipRetries: errors.Is(err, ErrCircuitOpen) || !retryOnStatusCode(retryOn, statusCode),
+		}
 		if cacheTTL == 0 {
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, failedRunInfo
 		}
 
 		var cacheErr error
@@ -197,7 +218,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 					"url", url.String(),
 				)
 			}
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, failedRunInfo
 		}
 		promBridgeCacheHits.WithLabelValues(t.Name).Inc()
 		lggr.Debugw("Bridge task: request failed, falling back to cache",
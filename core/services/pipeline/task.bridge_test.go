@@ -19,12 +19,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -928,6 +930,53 @@ func TestBridgeTask_ErrorIfBridgeMissing(t *testing.T) {
 	assert.Contains(t, result.Error.Error(), "could not find bridge with name 'foo'")
 }
 
+func TestBridgeTask_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.BridgeCircuitBreaker.FailureThreshold = ptr(uint32(2))
+		c.JobPipeline.BridgeCircuitBreaker.OpenTimeout = commonconfig.MustNewDuration(time.Hour)
+	})
+
+	var requests atomic.Int32
+	s1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer s1.Close()
+
+	feedURL, err := url.ParseRequestURI(s1.URL)
+	require.NoError(t, err)
+	bridge := bridges.BridgeType{Name: bridges.MustParseBridgeName("outage"), URL: models.WebURL(*feedURL)}
+	orm := bridgesMocks.NewORM(t)
+	orm.On("FindBridge", mock.Anything, bridge.Name).Return(bridge, nil)
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: btcUSDPairing,
+		RetryOn:     "[503]",
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, c)
+	task.HelperSetCircuitBreakers(cfg.JobPipeline(), logger.TestLogger(t))
+
+	for i := 0; i < 2; i++ {
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.NotErrorIs(t, result.Error, pipeline.ErrCircuitOpen)
+		assert.True(t, runInfo.IsRetryable)
+		assert.False(t, runInfo.SkipRetries)
+	}
+	require.Equal(t, int32(2), requests.Load())
+
+	// the breaker is open: fail fast without reaching out to the bridge
+	result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.ErrorIs(t, result.Error, pipeline.ErrCircuitOpen)
+	assert.True(t, runInfo.SkipRetries)
+	require.Equal(t, int32(2), requests.Load())
+}
+
 // Sample input taken from
 // https://github.com/smartcontractkit/price-adapters#chainlink-price-request-adapters
 func TestAdapterResponse_UnmarshalJSON_Happy(t *testing.T) {
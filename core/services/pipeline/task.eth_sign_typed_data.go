@@ -0,0 +1,202 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ETHSignTypedDataTask signs EIP-712 typed data with one of the node's ETH
+// keys, so that jobs can produce signed payloads for off-chain APIs without
+// sending a transaction. The signing key is chosen from the from addresses the
+// same way as for ethtx: it must be enabled for evmChainID, and if several are
+// given they are used in turn.
+//
+//	sign [type=ethsigntypeddata
+//	      from=<["0x..."]>
+//	      primaryType="Attestation"
+//	      types=<{"Attestation": [{"name": "answer", "type": "uint256"}, {"name": "timestamp", "type": "uint64"}]}>
+//	      domain=<{"name": "Example", "version": "1", "chainId": 1, "verifyingContract": "0x..."}>
+//	      message=<{"answer": $(answer), "timestamp": $(jobRun.blockTime)}>]
+//
+// The EIP712Domain type is derived from the domain fields when it is not given
+// in types. Signatures use the Ethereum convention of a recovery ID of 27 or 28.
+//
+// Return types:
+//
+//	map[string]interface{}{"signature": string, "hash": string, "from": string}
+type ETHSignTypedDataTask struct {
+	BaseTask    `mapstructure:",squash"`
+	From        string `json:"from"`
+	EVMChainID  string `json:"evmChainID" mapstructure:"evmChainID"`
+	Types       string `json:"types"`
+	PrimaryType string `json:"primaryType"`
+	Domain      string `json:"domain"`
+	Message     string `json:"message"`
+
+	keyStore ETHKeyStore
+}
+
+var _ Task = (*ETHSignTypedDataTask)(nil)
+
+func (t *ETHSignTypedDataTask) Type() TaskType {
+	return TaskTypeETHSignTypedData
+}
+
+func (t *ETHSignTypedDataTask) getEvmChainID() string {
+	if t.EVMChainID == "" {
+		t.EVMChainID = "$(jobSpec.evmChainID)"
+	}
+	return t.EVMChainID
+}
+
+func (t *ETHSignTypedDataTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		fromAddrs   AddressSliceParam
+		chainID     MaybeBigIntParam
+		types       MapParam
+		primaryType StringParam
+		domain      MapParam
+		message     MapParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID"),
+		errors.Wrap(ResolveParam(&types, From(VarExpr(t.Types, vars), JSONWithVarExprs(t.Types, vars, false))), "types"),
+		errors.Wrap(ResolveParam(&primaryType, From(VarExpr(t.PrimaryType, vars), NonemptyString(t.PrimaryType))), "primaryType"),
+		errors.Wrap(ResolveParam(&domain, From(VarExpr(t.Domain, vars), JSONWithVarExprs(t.Domain, vars, false))), "domain"),
+		errors.Wrap(ResolveParam(&message, From(VarExpr(t.Message, vars), JSONWithVarExprs(t.Message, vars, false))), "message"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if chainID.BigInt() == nil {
+		return Result{Error: errors.Wrap(ErrParameterEmpty, "evmChainID")}, runInfo
+	}
+
+	typedData, err := newTypedData(types, string(primaryType), domain, message)
+	if err != nil {
+		return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "while hashing typed data: %v", err)}, runInfo
+	}
+
+	fromAddr, err := t.keyStore.GetRoundRobinAddress(ctx, chainID.BigInt(), fromAddrs...)
+	if err != nil {
+		err = errors.Wrap(err, "ETHSignTypedDataTask failed to get fromAddress")
+		lggr.Error(err)
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while querying keystore: %v", err)}, retryableRunInfo()
+	}
+	signature, err := t.keyStore.SignTypedData(ctx, fromAddr, typedData)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while signing typed data: %v", err)}, runInfo
+	}
+
+	return Result{Value: map[string]interface{}{
+		"signature": hexutil.Encode(signature),
+		"hash":      hexutil.Encode(hash),
+		"from":      fromAddr.Hex(),
+	}}, runInfo
+}
+
+// eip712DomainFields are the fields of the EIP712Domain type, in the order
+// defined by EIP-712.
+var eip712DomainFields = []apitypes.Type{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+	{Name: "salt", Type: "bytes32"},
+}
+
+func newTypedData(types MapParam, primaryType string, domain MapParam, message MapParam) (typedData apitypes.TypedData, err error) {
+	if err = remarshalTypedData(map[string]interface{}(types), &typedData.Types); err != nil {
+		return typedData, errors.Wrap(err, "types")
+	}
+	if err = remarshalTypedData(normaliseTypedDataValue(map[string]interface{}(domain)), &typedData.Domain); err != nil {
+		return typedData, errors.Wrap(err, "domain")
+	}
+	if _, exists := typedData.Types["EIP712Domain"]; !exists {
+		var domainType []apitypes.Type
+		for _, field := range eip712DomainFields {
+			if _, isSet := domain[field.Name]; isSet {
+				domainType = append(domainType, field)
+			}
+		}
+		typedData.Types["EIP712Domain"] = domainType
+	}
+	if _, exists := typedData.Types[primaryType]; !exists {
+		return typedData, errors.Errorf("primaryType %q is not defined in types", primaryType)
+	}
+	typedData.PrimaryType = primaryType
+	typedData.Message = normaliseTypedDataValue(map[string]interface{}(message)).(map[string]interface{})
+	return typedData, nil
+}
+
+func remarshalTypedData(from interface{}, to interface{}) error {
+	b, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, to)
+}
+
+// normaliseTypedDataValue converts pipeline values into the representations
+// expected by apitypes: integers as decimal strings, and addresses and hashes
+// as hex strings.
+func normaliseTypedDataValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[key] = normaliseTypedDataValue(value)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, value := range v {
+			s[i] = normaliseTypedDataValue(value)
+		}
+		return s
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float64:
+		if d := decimal.NewFromFloat(v); d.IsInteger() {
+			return d.String()
+		}
+		return v
+	case decimal.Decimal:
+		return v.String()
+	case *decimal.Decimal:
+		return v.String()
+	case big.Int:
+		return v.String()
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	default:
+		return v
+	}
+}
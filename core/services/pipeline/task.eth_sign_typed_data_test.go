@@ -0,0 +1,209 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	keystoremocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// The Mail example from EIP-712
+const (
+	eip712Types  = `{"Person": [{"name": "name", "type": "string"}, {"name": "wallet", "type": "address"}], "Mail": [{"name": "from", "type": "Person"}, {"name": "to", "type": "Person"}, {"name": "contents", "type": "string"}]}`
+	eip712Domain = `{"name": "Ether Mail", "version": "1", "chainId": 1, "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"}`
+	eip712Hash   = "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"
+)
+
+func TestETHSignTypedDataTask(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	from := key.Address
+	// signTypedData signs like the keystore, which keeps the key to itself
+	signTypedData := func(_ context.Context, _ common.Address, typedData apitypes.TypedData) ([]byte, error) {
+		hash, _, err := apitypes.TypedDataAndHash(typedData)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := crypto.Sign(hash, key.ToEcdsaPrivKey())
+		if err != nil {
+			return nil, err
+		}
+		signature[crypto.RecoveryIDOffset] += 27
+		return signature, nil
+	}
+	other := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec":  map[string]interface{}{"evmChainID": testutils.FixtureChainID.String()},
+		"contents": "Hello, Bob!",
+		"bob":      common.HexToAddress("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"),
+	})
+	message := `{"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"}, "to": {"name": "Bob", "wallet": $(bob)}, "contents": $(contents)}`
+
+	tests := []struct {
+		name                  string
+		from                  string
+		types                 string
+		primaryType           string
+		domain                string
+		message               string
+		setupKeyStore         func(keyStore *keystoremocks.Eth)
+		expectedErrorCause    error
+		expectedErrorContains string
+		expectedRetryable     bool
+	}{
+		{
+			"happy",
+			`[ "` + from.Hex() + `" ]`,
+			eip712Types,
+			"Mail",
+			eip712Domain,
+			message,
+			func(keyStore *keystoremocks.Eth) {
+				keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+				keyStore.On("SignTypedData", mock.Anything, from, mock.Anything).Return(signTypedData)
+			},
+			nil,
+			"",
+			false,
+		},
+		{
+			"happy (no from address)",
+			"",
+			eip712Types,
+			"Mail",
+			eip712Domain,
+			message,
+			func(keyStore *keystoremocks.Eth) {
+				keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID).Return(from, nil)
+				keyStore.On("SignTypedData", mock.Anything, from, mock.Anything).Return(signTypedData)
+			},
+			nil,
+			"",
+			false,
+		},
+		{
+			"from address not enabled",
+			`[ "` + other.Hex() + `" ]`,
+			eip712Types,
+			"Mail",
+			eip712Domain,
+			message,
+			func(keyStore *keystoremocks.Eth) {
+				keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, other).Return(common.Address{}, errors.New("no sending keys available"))
+			},
+			pipeline.ErrTaskRunFailed,
+			"no sending keys available",
+			true,
+		},
+		{
+			"keystore locked",
+			"",
+			eip712Types,
+			"Mail",
+			eip712Domain,
+			message,
+			func(keyStore *keystoremocks.Eth) {
+				keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID).Return(from, nil)
+				keyStore.On("SignTypedData", mock.Anything, from, mock.Anything).Return(nil, errors.New("keystore is locked"))
+			},
+			pipeline.ErrTaskRunFailed,
+			"keystore is locked",
+			false,
+		},
+		{
+			"undefined primary type",
+			"",
+			eip712Types,
+			"Letter",
+			eip712Domain,
+			message,
+			func(keyStore *keystoremocks.Eth) {},
+			pipeline.ErrBadInput,
+			`primaryType "Letter" is not defined`,
+			false,
+		},
+		{
+			"message does not match types",
+			"",
+			eip712Types,
+			"Mail",
+			eip712Domain,
+			`{"from": {"name": "Cow", "wallet": "foo"}, "to": {"name": "Bob", "wallet": $(bob)}, "contents": $(contents)}`,
+			func(keyStore *keystoremocks.Eth) {},
+			pipeline.ErrBadInput,
+			"while hashing typed data",
+			false,
+		},
+		{
+			"missing message",
+			"",
+			eip712Types,
+			"Mail",
+			eip712Domain,
+			"",
+			func(keyStore *keystoremocks.Eth) {},
+			pipeline.ErrParameterEmpty,
+			"message",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			keyStore := keystoremocks.NewEth(t)
+			test.setupKeyStore(keyStore)
+
+			task := pipeline.ETHSignTypedDataTask{
+				BaseTask:    pipeline.NewBaseTask(0, "sign", nil, nil, 0),
+				From:        test.from,
+				Types:       test.types,
+				PrimaryType: test.primaryType,
+				Domain:      test.domain,
+				Message:     test.message,
+			}
+			task.HelperSetDependencies(keyStore)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+			assert.False(t, runInfo.IsPending)
+			assert.Equal(t, test.expectedRetryable, runInfo.IsRetryable)
+
+			if test.expectedErrorCause != nil {
+				require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				require.Contains(t, result.Error.Error(), test.expectedErrorContains)
+				require.Nil(t, result.Value)
+				return
+			}
+			require.NoError(t, result.Error)
+
+			output := result.Value.(map[string]interface{})
+			assert.Equal(t, eip712Hash, output["hash"])
+			assert.Equal(t, from.Hex(), output["from"])
+
+			signature, err := hexutil.Decode(output["signature"].(string))
+			require.NoError(t, err)
+			require.Len(t, signature, 65)
+			require.Contains(t, []byte{27, 28}, signature[crypto.RecoveryIDOffset])
+			signature[crypto.RecoveryIDOffset] -= 27
+			pubKey, err := crypto.SigToPub(hexutil.MustDecode(eip712Hash), signature)
+			require.NoError(t, err)
+			assert.Equal(t, from, crypto.PubkeyToAddress(*pubKey))
+		})
+	}
+}
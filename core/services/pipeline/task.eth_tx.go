@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -17,6 +18,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -49,6 +51,7 @@ type ETHTxTask struct {
 
 type ETHKeyStore interface {
 	GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addrs ...common.Address) (common.Address, error)
+	SignTypedData(ctx context.Context, address common.Address, typedData apitypes.TypedData) ([]byte, error)
 }
 
 var _ Task = (*ETHTxTask)(nil)
@@ -153,6 +156,10 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		Checker:          transmitChecker,
 		SignalCallback:   true,
 	}
+	if t.jobType == KeeperJobType || t.jobType == VRFJobType {
+		// upkeeps and randomness requests can wait for the time-critical transactions sharing their key
+		txRequest.Priority = txmgrtypes.TxPriorityBulk
+	}
 
 	if !isMinConfirmationSet {
 		// Store the task run ID, so we can resume the pipeline when tx is finalized
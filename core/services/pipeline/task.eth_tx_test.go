@@ -12,6 +12,7 @@ import (
 
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
@@ -603,4 +604,39 @@ func TestETHTxTask(t *testing.T) {
 	}
 }
 
+func TestETHTxTask_Keeper(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+
+	task := pipeline.ETHTxTask{
+		BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+		From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+		To:               "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+		Data:             "foobar",
+		GasLimit:         "12345",
+		MinConfirmations: "0",
+		EVMChainID:       "0",
+	}
+
+	keyStore := keystoremocks.NewEth(t)
+	txManager := txmmocks.NewMockEvmTxManager(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+		TxManager: txManager, KeyStore: keyStore})
+
+	keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+	txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(tx txmgr.TxRequest) bool {
+		// upkeeps are never batched, since the registry only accepts them from the keeper itself
+		return assert.ObjectsAreEqual(txmgrcommon.NewSendEveryStrategy(), tx.Strategy) && tx.Priority == txmgrtypes.TxPriorityBulk
+	})).Return(txmgr.Tx{}, nil)
+
+	task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.KeeperJobType)
+
+	result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, pipeline.RunInfo{}, runInfo)
+}
+
 func ptr[T any](t T) *T { return &t }
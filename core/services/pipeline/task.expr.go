@@ -0,0 +1,82 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ExprTask evaluates an arithmetic expression over pipeline variables with
+// decimal precision, e.g.
+//
+//	deviation [type=expr expression="($(a) - $(b)) / $(b) * 1e18" precision=18]
+//
+// See Expr for the supported syntax. The expression is compiled when the
+// pipeline is parsed, so it cannot itself be a variable.
+//
+// Return types:
+//
+//	decimal.Decimal
+//	bool
+type ExprTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+	Precision  string `json:"precision"`
+
+	compiled *Expr
+}
+
+var _ Task = (*ExprTask)(nil)
+
+func (t *ExprTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+// compile validates the expression, it is called by Parse.
+func (t *ExprTask) compile() error {
+	expr, err := CompileExpr(t.Expression)
+	if err != nil {
+		return err
+	}
+	t.compiled = expr
+	return nil
+}
+
+func (t *ExprTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		expression     StringParam
+		maybePrecision MaybeInt32Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&expression, From(NonemptyString(t.Expression))), "expression"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	expr := t.compiled
+	if expr == nil {
+		if expr, err = CompileExpr(string(expression)); err != nil {
+			return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+		}
+	}
+
+	var divPrecision *int32
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		divPrecision = &precision
+	}
+	value, err := expr.Eval(vars, divPrecision)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
@@ -0,0 +1,140 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestExprTask(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"a":      "101.5",
+		"b":      100,
+		"zero":   0,
+		"prices": map[string]interface{}{"eth": decimal.RequireFromString("3400.25"), "btc": 65000.5},
+		"list":   []interface{}{"1", "2", "3"},
+		"word":   "foo",
+	})
+
+	tests := []struct {
+		name              string
+		expression        string
+		precision         string
+		want              interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"literal", "42", "", decimal.NewFromInt(42), nil, ""},
+		{"precedence", "1 + 2 * 3 - 4 / 2", "", decimal.NewFromInt(5), nil, ""},
+		{"parentheses", "(1 + 2) * 3", "", decimal.NewFromInt(9), nil, ""},
+		{"unary minus", "-$(a) + -(-1)", "", decimal.RequireFromString("-100.5"), nil, ""},
+		{"modulo", "$(b) % 7", "", decimal.NewFromInt(2), nil, ""},
+		{"deviation", "($(a) - $(b)) / $(b) * 1e18", "", decimal.RequireFromString("15000000000000000"), nil, ""},
+		{"precision", "2 / 3", "2", decimal.RequireFromString("0.67"), nil, ""},
+		{"default precision", "2 / 3", "", decimal.RequireFromString("0.6666666666666667"), nil, ""},
+		{"nested keypaths", "$(prices.btc) - $(prices.eth) + $(list.2)", "", decimal.RequireFromString("61603.25"), nil, ""},
+		{"min", "min($(a), $(b), 200)", "", decimal.NewFromInt(100), nil, ""},
+		{"max", "max($(a), $(b))", "", decimal.RequireFromString("101.5"), nil, ""},
+		{"abs", "abs($(b) - $(a))", "", decimal.RequireFromString("1.5"), nil, ""},
+		{"round", "round($(a))", "", decimal.NewFromInt(102), nil, ""},
+		{"round with places", "round(2 / 3, 3)", "", decimal.RequireFromString("0.667"), nil, ""},
+		{"comparison", "$(a) > $(b)", "", true, nil, ""},
+		{"equality", "$(b) == 100.0", "", true, nil, ""},
+		{"logical", "$(a) < $(b) || !($(b) != 100) && true", "", true, nil, ""},
+		{"short circuit", "$(a) < $(b) && $(missing) > 0", "", false, nil, ""},
+
+		{"divide by zero", "$(a) / $(zero)", "", nil, pipeline.ErrDivideByZero, "divide by zero"},
+		{"modulo by zero", "$(a) % 0", "", nil, pipeline.ErrDivideByZero, "divide by zero"},
+		{"missing variable", "$(missing) + 1", "", nil, pipeline.ErrKeypathNotFound, "missing"},
+		{"non-numeric variable", "$(word) + 1", "", nil, pipeline.ErrBadInput, "$(word)"},
+		{"invalid round places", "round(1, 0.5)", "", nil, pipeline.ErrBadInput, "round"},
+		{"empty", "", "", nil, pipeline.ErrParameterEmpty, "expression"},
+		{"invalid precision", "1", "foo", nil, pipeline.ErrBadInput, "precision"},
+		{"syntax error", "1 +", "", nil, pipeline.ErrBadInput, "unexpected end of expression"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ExprTask{
+				BaseTask:   pipeline.NewBaseTask(0, "expr", nil, nil, 0),
+				Expression: test.expression,
+				Precision:  test.precision,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				require.Nil(t, result.Value)
+				return
+			}
+			require.NoError(t, result.Error)
+			if want, ok := test.want.(decimal.Decimal); ok {
+				got, ok := result.Value.(decimal.Decimal)
+				require.True(t, ok, "expected decimal.Decimal, got %T", result.Value)
+				assert.True(t, want.Equal(got), "expected %s, got %s", want, got)
+			} else {
+				assert.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
+
+func TestCompileExpr(t *testing.T) {
+	t.Parallel()
+
+	for _, expression := range []string{
+		"1",
+		".5 * 2E-3",
+		"$( foo.bar_1.0 ) + 1",
+		"min(1) + max(1, 2) + abs(-1) + round(1.5, 0)",
+		"!(1 < 2) || 1 >= 2 && 1 <= 2",
+		"true == (1 != 2)",
+	} {
+		_, err := pipeline.CompileExpr(expression)
+		assert.NoError(t, err, expression)
+	}
+
+	for expression, wantErr := range map[string]string{
+		"":                "empty expression",
+		"1 +":             "unexpected end of expression",
+		"(1":              "unexpected end of expression",
+		"1 2":             `unexpected "2" at offset 2`,
+		"1 = 2":           `unexpected character '='`,
+		"$(foo":           "unterminated variable",
+		"$(foo bar)":      "invalid variable",
+		"$(x $(y)":        "invalid variable",
+		"$()":             "invalid variable",
+		"foo":             `unknown function "foo"`,
+		"abs(1, 2)":       "abs: expected 1 argument(s), got 2",
+		"min()":           "min: expected at least 1 argument(s), got 0",
+		"round(1, 2, 3)":  "round: expected 1 to 2 arguments, got 3",
+		"abs(1 > 2)":      "abs: arguments must be numbers",
+		"1 + true":        "operator + cannot be applied to number and bool",
+		"1 && 2":          "operator && cannot be applied to number and number",
+		"-true":           "operator - cannot be applied to bool",
+		"!1":              "operator ! cannot be applied to number",
+		"true < false":    "operator < cannot be applied to bool and bool",
+		"1 == true":       "operator == cannot be applied to number and bool",
+		"1.2.3":           `invalid number "1.2.3"`,
+		"1e999999999 + 1": "out of range",
+	} {
+		_, err := pipeline.CompileExpr(expression)
+		require.ErrorIs(t, err, pipeline.ErrInvalidExpression, expression)
+		assert.ErrorContains(t, err, wantErr, expression)
+	}
+}
@@ -0,0 +1,194 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	// ForEachItemKey and ForEachIndexKey are the variables holding the current
+	// element of a foreach task, and its index.
+	ForEachItemKey  = "item"
+	ForEachIndexKey = "index"
+
+	defaultForEachConcurrency = 4
+)
+
+// nestedPathRegexp matches the path of a task executed for an element of a
+// foreach task, e.g. "prices[0].fetch".
+var nestedPathRegexp = regexp.MustCompile(`^([^\[\]]+)\[\d+\]\.(.+)$`)
+
+// ForEachTask executes a nested pipeline spec for every element of values, and
+// collects the results into an array, e.g.
+//
+//	assets [type=jsonparse path="assets"]
+//	prices [type=foreach values="$(assets)" concurrency=2 spec=<
+//	    fetch [type=http method=GET url="$(item.url)"]
+//	    parse [type=jsonparse data="$(fetch)" path="price"]
+//	>]
+//
+// The nested spec has access to all variables of the run, as well as $(item)
+// and $(index) for the current element. It must have exactly one terminal task,
+// whose result is collected, and may not contain async tasks. Up to
+// concurrency elements are executed at the same time, 4 by default.
+//
+// Edges cannot be declared inside angle brackets, so tasks of a bracket quoted
+// spec are linked by referencing each other's results as above. Explicit edges
+// require the spec to be double quoted instead.
+//
+// The tasks executed for each element are recorded alongside the other tasks
+// of the run, under their dot ID qualified by the element, e.g. "prices[0].parse".
+// The task errors if the nested spec errors for any element.
+//
+// Return types:
+//
+//	[]interface{}
+type ForEachTask struct {
+	BaseTask    `mapstructure:",squash"`
+	Values      string `json:"values"`
+	Spec        string `json:"spec"`
+	Concurrency string `json:"concurrency"`
+
+	template     *Pipeline
+	runner       *runner
+	pipelineSpec Spec
+}
+
+var _ Task = (*ForEachTask)(nil)
+
+func (t *ForEachTask) Type() TaskType {
+	return TaskTypeForEach
+}
+
+// parse validates the nested spec, it is called by Parse.
+func (t *ForEachTask) parse() error {
+	template, err := parseForEachSpec(t.Spec)
+	if err != nil {
+		return err
+	}
+	t.template = template
+	return nil
+}
+
+func parseForEachSpec(spec string) (*Pipeline, error) {
+	// the brackets around specs containing bracket quoted attributes are kept by the DOT parser
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "<") && strings.HasSuffix(spec, ">") {
+		spec = spec[1 : len(spec)-1]
+	}
+	if strings.TrimSpace(spec) == "" {
+		return nil, errors.Wrap(ErrParameterEmpty, "spec")
+	}
+	p, err := Parse(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "spec")
+	}
+	if p.RequiresPreInsert() {
+		return nil, errors.New("spec: async tasks are not supported by foreach")
+	}
+	var terminal int
+	for _, task := range p.Tasks {
+		if task.DotID() == ForEachItemKey || task.DotID() == ForEachIndexKey {
+			return nil, errors.Errorf("spec: '%v' is a reserved keyword that cannot be used as a task's name", task.DotID())
+		}
+		if len(task.Outputs()) == 0 {
+			terminal++
+		}
+	}
+	if terminal != 1 {
+		return nil, errors.Errorf("spec: expected exactly one terminal task, got %d", terminal)
+	}
+	return p, nil
+}
+
+func (t *ForEachTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		values      SliceParam
+		concurrency Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&values, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, false), Input(inputs, 0))), "values"),
+		errors.Wrap(ResolveParam(&concurrency, From(VarExpr(t.Concurrency, vars), NonemptyString(t.Concurrency), defaultForEachConcurrency)), "concurrency"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if concurrency == 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "concurrency must be greater than 0")}, runInfo
+	}
+	if t.runner == nil {
+		return Result{Error: errors.New("foreach task is not initialized")}, runInfo
+	}
+
+	outputs := make([]interface{}, len(values))
+	nested := make([]TaskRunResults, len(values))
+	errs := make([]error, len(values))
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, item := range values {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, item interface{}) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			outputs[i], nested[i], errs[i] = t.runElement(ctx, lggr, vars, i, item)
+		}(i, item)
+	}
+	wg.Wait()
+
+	for _, results := range nested {
+		runInfo.nested = append(runInfo.nested, results...)
+	}
+	for i, err := range errs {
+		if err != nil {
+			return Result{Error: errors.Wrapf(err, "element %d", i)}, runInfo
+		}
+	}
+	return Result{Value: outputs}, runInfo
+}
+
+// runElement executes the nested spec for a single element, returning the
+// result of its terminal task along with the results of all tasks executed.
+func (t *ForEachTask) runElement(ctx context.Context, lggr logger.Logger, vars Vars, index int, item interface{}) (interface{}, TaskRunResults, error) {
+	p, err := parseForEachSpec(t.Spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	prefix := fmt.Sprintf("%s[%d]", t.path(), index)
+	for _, task := range p.Tasks {
+		task.Base().nestedIn = prefix
+	}
+	t.runner.initializeTasks(p, t.pipelineSpec)
+
+	elementVars := vars.Copy()
+	if err = multierr.Combine(
+		elementVars.Set(ForEachItemKey, item),
+		elementVars.Set(ForEachIndexKey, index),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	results := t.runner.runNested(ctx, p, t.pipelineSpec, elementVars, lggr.With("foreach", prefix))
+	for _, trr := range results {
+		if trr.Task.Base().nestedIn == prefix && trr.IsTerminal() {
+			return trr.Result.Value, results, trr.Result.Error
+		}
+	}
+	return nil, results, errors.New("terminal task did not run")
+}
@@ -0,0 +1,248 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+const forEachDAG = `
+prices [type=foreach values="$(assets)" concurrency=2 spec=<
+	fetch [type=http method=GET url="$(item.url)"]
+	parse [type=jsonparse data="$(fetch)" path="price"]
+	scale [type=multiply input="$(parse)" times="$(item.scale)"]
+>]
+sum [type=sum values="$(prices)"]
+
+prices -> sum
+`
+
+func TestForEachTask_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		spec        string
+		expectedErr string
+	}{
+		{"bracket quoted", `a [type=foreach values=<[1]> spec=<b [type=multiply input="$(item)" times=2]>]`, ""},
+		{"double quoted with edges", `a [type=foreach values=<[1]> spec="b [type=multiply input=2 times=2]; c [type=multiply times=2]; b -> c"]`, ""},
+		{"nested foreach", `a [type=foreach values=<[[1]]> spec=<b [type=foreach values="$(item)" spec=<c [type=multiply input="$(item)" times=2]>]>]`, ""},
+		{"missing spec", `a [type=foreach values=<[1]>]`, "task a: spec: parameter is empty"},
+		{"invalid spec", `a [type=foreach values=<[1]> spec="b [type=nope]"]`, "task a: spec: UnmarshalTaskFromMap: unknown task type: \"nope\""},
+		{"async task", `a [type=foreach values=<[1]> spec=<b [type=ethtx]>]`, "task a: spec: async tasks are not supported by foreach"},
+		{"several terminal tasks", `a [type=foreach values=<[1]> spec=<b [type=multiply input=1 times=2] c [type=multiply input=1 times=3]>]`, "task a: spec: expected exactly one terminal task, got 2"},
+		{"reserved name", `a [type=foreach values=<[1]> spec=<item [type=multiply input=1 times=2]>]`, "task a: spec: 'item' is a reserved keyword that cannot be used as a task's name"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := pipeline.Parse(test.spec)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestForEachTask(t *testing.T) {
+	t.Parallel()
+
+	r := newSimulationRunner(t)
+	spec := pipeline.Spec{DotDagSource: forEachDAG}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"assets": []interface{}{
+			map[string]interface{}{"url": "https://example.com/eth", "scale": 1},
+			map[string]interface{}{"url": "https://example.com/btc", "scale": 10},
+			map[string]interface{}{"url": "https://example.com/link", "scale": 100},
+		},
+	})
+
+	t.Run("collects the result of every element", func(t *testing.T) {
+		fixtures := pipeline.SimulationFixtures{
+			Tasks: map[string]pipeline.SimulationFixture{
+				"prices[0].fetch": {Value: map[string]interface{}{"price": 1.5}},
+				"prices[1].fetch": {Value: map[string]interface{}{"price": 2}},
+				"prices[2].fetch": {Value: map[string]interface{}{"price": 0.25}},
+			},
+		}
+		ctx := pipeline.ContextWithSimulation(testutils.Context(t), fixtures)
+
+		run, trrs, err := r.ExecuteRun(ctx, spec, vars)
+		require.NoError(t, err)
+		require.Len(t, trrs, 2)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+
+		prices := forEachResult(t, trrs, "prices")
+		require.NoError(t, prices.Result.Error)
+		require.Len(t, prices.Result.Value, 3)
+		for i, expected := range []string{"1.5", "20", "25"} {
+			assert.Equal(t, expected, prices.Result.Value.([]interface{})[i].(decimal.Decimal).String())
+		}
+
+		final := trrs.FinalResult()
+		require.False(t, final.HasFatalErrors())
+		require.Len(t, final.Values, 1)
+		assert.Equal(t, "46.5", final.Values[0].(decimal.Decimal).String())
+
+		// the tasks of every element are recorded with the run
+		var dotIDs []string
+		for _, taskRun := range run.PipelineTaskRuns {
+			dotIDs = append(dotIDs, taskRun.DotID)
+		}
+		assert.ElementsMatch(t, []string{
+			"prices", "sum",
+			"prices[0].fetch", "prices[0].parse", "prices[0].scale",
+			"prices[1].fetch", "prices[1].parse", "prices[1].scale",
+			"prices[2].fetch", "prices[2].parse", "prices[2].scale",
+		}, dotIDs)
+		require.Len(t, run.Outputs.Val, 1)
+		assert.Equal(t, "46.5", run.Outputs.Val.([]interface{})[0].(decimal.Decimal).String())
+	})
+
+	t.Run("errors if any element errors", func(t *testing.T) {
+		fixtures := pipeline.SimulationFixtures{
+			Tasks: map[string]pipeline.SimulationFixture{
+				"prices[0].fetch": {Value: map[string]interface{}{"price": 1.5}},
+				"prices[1].fetch": {Error: "connection refused"},
+				"prices[2].fetch": {Value: map[string]interface{}{"price": 0.25}},
+			},
+		}
+		ctx := pipeline.ContextWithSimulation(testutils.Context(t), fixtures)
+
+		run, trrs, err := r.ExecuteRun(ctx, spec, vars)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+		prices := forEachResult(t, trrs, "prices")
+		require.Error(t, prices.Result.Error)
+		assert.Contains(t, prices.Result.Error.Error(), "element 1")
+		assert.True(t, trrs.FinalResult().HasFatalErrors())
+	})
+
+	t.Run("validates fixtures of nested tasks", func(t *testing.T) {
+		p, err := pipeline.Parse(forEachDAG)
+		require.NoError(t, err)
+
+		require.NoError(t, pipeline.SimulationFixtures{
+			Tasks: map[string]pipeline.SimulationFixture{"prices[3].fetch": {Value: "{}"}},
+		}.Validate(p))
+		require.ErrorContains(t, pipeline.SimulationFixtures{
+			Tasks: map[string]pipeline.SimulationFixture{"prices[0].parse": {Value: "{}"}},
+		}.Validate(p), "only external tasks can be simulated")
+		require.ErrorContains(t, pipeline.SimulationFixtures{
+			Tasks: map[string]pipeline.SimulationFixture{"sum[0].fetch": {Value: "{}"}},
+		}.Validate(p), `unknown task "sum[0].fetch"`)
+	})
+}
+
+func TestForEachTask_ReplayRun(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"price":%s}`, req.URL.Query().Get("price"))
+	}))
+	defer s.Close()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, _ *chainlink.Secrets) {
+		enabled := true
+		c.JobPipeline.ReplayBundlesEnabled = &enabled
+	})
+	orm := mocks.NewORM(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(orm, bridgesMocks.NewORM(t), cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), c, c)
+
+	spec := pipeline.Spec{DotDagSource: forEachDAG}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"assets": []interface{}{
+			map[string]interface{}{"url": s.URL + "?price=1.5", "scale": 1},
+			map[string]interface{}{"url": s.URL + "?price=2", "scale": 10},
+		},
+	})
+
+	original, _, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+	require.NoError(t, err)
+	require.Equal(t, pipeline.RunStatusCompleted, original.State)
+	require.NotNil(t, original.ReplayBundle)
+	assert.Equal(t, `{"price":2}`, original.ReplayBundle.Fixtures.Tasks["prices[1].fetch"].Value)
+	require.Len(t, original.ReplayBundle.Results, 8)
+
+	s.Close() // replays never reach out to the network
+
+	orm.On("FindReplayBundle", mock.Anything, int64(1)).Return(*original.ReplayBundle, nil).Once()
+	orm.On("FindRun", mock.Anything, int64(1)).Return(pipeline.Run{ID: 1, PipelineSpec: spec}, nil).Once()
+
+	_, diffs, err := r.ReplayRun(testutils.Context(t), 1)
+	require.NoError(t, err)
+	require.Len(t, diffs, 8)
+	for _, diff := range diffs {
+		assert.True(t, diff.Match(), diff.DotID)
+	}
+}
+
+func forEachResult(t *testing.T, trrs pipeline.TaskRunResults, dotID string) pipeline.TaskRunResult {
+	for _, trr := range trrs {
+		if trr.Task.DotID() == dotID {
+			return trr
+		}
+	}
+	t.Fatalf("no result for task %s", dotID)
+	return pipeline.TaskRunResult{}
+}
+
+func TestForEachTask_Errors(t *testing.T) {
+	t.Parallel()
+
+	r := newSimulationRunner(t)
+	tests := []struct {
+		name        string
+		spec        string
+		vars        map[string]interface{}
+		expectedErr error
+	}{
+		{"values is not an array", `a [type=foreach values="$(foo)" spec=<b [type=multiply input="$(item)" times=2]>]`, map[string]interface{}{"foo": 42}, pipeline.ErrBadInput},
+		{"zero concurrency", `a [type=foreach values=<[1]> concurrency=0 spec=<b [type=multiply input="$(item)" times=2]>]`, nil, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{DotDagSource: test.spec}, pipeline.NewVarsFrom(test.vars))
+			require.NoError(t, err)
+			require.Len(t, trrs, 1)
+			assert.Equal(t, test.expectedErr, errors.Cause(trrs[0].Result.Error))
+		})
+	}
+
+	t.Run("not initialized", func(t *testing.T) {
+		task := pipeline.ForEachTask{
+			BaseTask: pipeline.NewBaseTask(0, "a", nil, nil, 0),
+			Values:   "[1]",
+			Spec:     `b [type=multiply input="$(item)" times=2]`,
+		}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.EqualError(t, result.Error, "foreach task is not initialized")
+	})
+}
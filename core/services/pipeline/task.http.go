@@ -24,6 +24,7 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	RetryOn                        string `json:"retryOn"`
 
 	config                 Config
 	httpClient             *http.Client
@@ -63,6 +64,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		requestData                    MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		retryOn                        Uint64SliceParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
@@ -73,6 +75,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&retryOn, From(VarExpr(t.RetryOn, vars), NonemptyString(t.RetryOn), nil)), "retryOn"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -108,7 +111,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
 		}
-		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err), SkipRetries: !retryOnStatusCode(retryOn, statusCode)}
 	}
 
 	lggr.Debugw("HTTP task got response",
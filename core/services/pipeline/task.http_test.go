@@ -288,6 +288,56 @@ func TestHTTPTask_ErrorMessage(t *testing.T) {
 	require.Nil(t, result.Value)
 }
 
+func TestHTTPTask_RetryOn(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		retryOn     string
+		skipRetries bool
+	}{
+		{"retry on any error by default", "", false},
+		{"status code listed", "[502, 503]", false},
+		{"status code not listed", "[429, 503]", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := clhttptest.NewTestLocalOnlyHTTPClient()
+			task := pipeline.HTTPTask{
+				Method:  "GET",
+				URL:     server.URL,
+				RetryOn: test.retryOn,
+			}
+			task.HelperSetDependencies(config.JobPipeline(), c, c)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.Error(t, result.Error)
+			assert.True(t, runInfo.IsRetryable)
+			assert.Equal(t, test.skipRetries, runInfo.SkipRetries)
+		})
+	}
+
+	t.Run("invalid retryOn", func(t *testing.T) {
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task := pipeline.HTTPTask{
+			Method:  "GET",
+			URL:     server.URL,
+			RetryOn: "[-1]",
+		}
+		task.HelperSetDependencies(config.JobPipeline(), c, c)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+	})
+}
+
 func TestHTTPTask_OnlyErrorMessage(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,133 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/jmespath/go-jmespath"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// JMESPathTask queries JSON data with a JMESPath expression (https://jmespath.org),
+// supporting filters, projections, wildcards and functions, e.g.
+//
+//	query [type=jmespath expression="data.prices[?symbol=='ETH'].price | [0]"]
+//
+// Numbers are decoded as float64, as required by JMESPath comparisons and
+// functions, unless they are integers too large to be represented exactly.
+// Those are returned as int64, uint64 or *big.Int, but cannot be compared or
+// passed to functions.
+//
+// Return types:
+//
+//	float64
+//	int64
+//	uint64
+//	*big.Int
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type JMESPathTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+	Data       string `json:"data"`
+	// Lax when disabled will return an error if the expression evaluates to null
+	// Lax when enabled will return nil with no error if the expression evaluates to null
+	Lax string
+}
+
+var _ Task = (*JMESPathTask)(nil)
+
+func (t *JMESPathTask) Type() TaskType {
+	return TaskTypeJMESPath
+}
+
+func (t *JMESPathTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		expression StringParam
+		data       BytesParam
+		lax        BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&expression, From(VarExpr(t.Expression, vars), NonemptyString(t.Expression))), "expression"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	query, err := jmespath.Compile(string(expression))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "invalid JMESPath expression %q: %v", expression, err)}, runInfo
+	}
+
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err = d.Decode(&decoded); err != nil {
+		return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+	}
+	decoded, err = jsonserializable.ReinterpretJSONNumbers(decoded)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+	decoded = exactIntegersToFloat64(decoded)
+
+	value, err := jmespathSearch(query, decoded)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "JMESPath expression %q: %v", expression, err)}, runInfo
+	}
+	if value == nil && !bool(lax) {
+		return Result{Error: errors.Wrapf(ErrKeypathNotFound, "JMESPath expression %q evaluated to null in %s", expression, data)}, runInfo
+	}
+
+	return Result{Value: value}, runInfo
+}
+
+// maxExactFloat64Integer is the largest integer from which all smaller integers can be represented exactly as float64.
+const maxExactFloat64Integer = 1 << 53
+
+// exactIntegersToFloat64 converts the integers of data which can be represented exactly as float64, so that they can be
+// used in JMESPath comparisons and functions. Larger integers are left as they are to preserve their precision.
+func exactIntegersToFloat64(data interface{}) interface{} {
+	switch v := data.(type) {
+	case int64:
+		if v >= -maxExactFloat64Integer && v <= maxExactFloat64Integer {
+			return float64(v)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = exactIntegersToFloat64(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = exactIntegersToFloat64(v[k])
+		}
+	}
+	return data
+}
+
+// jmespathSearch evaluates query against data, recovering from panics in the
+// interpreter which may be triggered by unexpected input.
+func jmespathSearch(query *jmespath.JMESPath, data interface{}) (value interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic: %v", r)
+		}
+	}()
+	return query.Search(data)
+}
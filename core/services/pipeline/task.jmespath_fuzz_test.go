@@ -0,0 +1,42 @@
+//go:build go1.18
+
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func FuzzJMESPathTask(f *testing.F) {
+	f.Add(`data.updatedAt`, jmespathPrices)
+	f.Add(`data.prices[?symbol=='ETH'].price | [0]`, jmespathPrices)
+	f.Add("data.prices[?volume > `100`].symbol", jmespathPrices)
+	f.Add(`data.prices[*].symbol`, jmespathPrices)
+	f.Add(`data.prices[].venues[]`, jmespathPrices)
+	f.Add(`data.prices[0].{s: symbol, p: price}`, jmespathPrices)
+	f.Add(`max(data.prices[*].price)`, jmespathPrices)
+	f.Add(`sort_by(data.prices, &price)[-1].symbol`, jmespathPrices)
+	f.Add(`[0][1][2]`, `[[0, [1, 2, 3]]]`)
+	f.Add(`*.*`, `{"a": {"b": 1}, "c": [1, 2]}`)
+	f.Add(`foo[::-1]`, `{"foo": [1, 2, 3]}`)
+	f.Add(`data.prices[?`, jmespathPrices)
+	f.Add(`abs(@)`, `"abc"`)
+	f.Add(`@`, `null`)
+	f.Fuzz(func(t *testing.T, expression string, data string) {
+		if len(expression) > 10_000 || len(data) > 1_000_000 {
+			t.Skip()
+		}
+		task := pipeline.JMESPathTask{
+			BaseTask:   pipeline.NewBaseTask(0, "jmespath", nil, nil, 0),
+			Expression: expression,
+			Lax:        "true",
+		}
+		result, _ := task.Run(testutils.Context(t), logger.NullLogger, pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: data}})
+		if result.Error != nil {
+			t.Skip()
+		}
+	})
+}
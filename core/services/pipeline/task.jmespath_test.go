@@ -0,0 +1,264 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const jmespathPrices = `{
+	"data": {
+		"prices": [
+			{"symbol": "BTC", "price": 65000.5, "volume": 120, "venues": ["a", "b"]},
+			{"symbol": "ETH", "price": 3400.25, "volume": 900, "venues": ["b"]},
+			{"symbol": "LINK", "price": 14.1, "volume": 40, "venues": []}
+		],
+		"updatedAt": "2024-01-01T00:00:00Z"
+	}
+}`
+
+func TestJMESPathTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		data              string
+		expression        string
+		lax               string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			"simple path",
+			"",
+			"data.updatedAt",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			"2024-01-01T00:00:00Z",
+			nil,
+			"",
+		},
+		{
+			"filter",
+			"",
+			"data.prices[?symbol=='ETH'].price | [0]",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			3400.25,
+			nil,
+			"",
+		},
+		{
+			"filter with comparison",
+			"",
+			"data.prices[?volume > `100`].symbol",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			[]interface{}{"BTC", "ETH"},
+			nil,
+			"",
+		},
+		{
+			"wildcard projection",
+			"",
+			"data.prices[*].symbol",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			[]interface{}{"BTC", "ETH", "LINK"},
+			nil,
+			"",
+		},
+		{
+			"flatten projection",
+			"",
+			"data.prices[].venues[]",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			[]interface{}{"a", "b", "b"},
+			nil,
+			"",
+		},
+		{
+			"multiselect hash",
+			"",
+			"data.prices[0].{s: symbol, p: price}",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			map[string]interface{}{"s": "BTC", "p": 65000.5},
+			nil,
+			"",
+		},
+		{
+			"functions",
+			"",
+			"max(data.prices[*].price)",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			65000.5,
+			nil,
+			"",
+		},
+		{
+			"large integer",
+			"",
+			"data.balances[?symbol=='ETH'].wei | [0]",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data": {"balances": [{"symbol": "ETH", "wei": 9007199254740993}]}}`}},
+			int64(9007199254740993),
+			nil,
+			"",
+		},
+		{
+			"integer larger than uint64",
+			"",
+			"data.balances[*].wei",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data": {"balances": [{"symbol": "ETH", "wei": 123456789012345678901234567890}, {"symbol": "LINK", "wei": 1}]}}`}},
+			[]interface{}{mustBigInt(t, "123456789012345678901234567890"), float64(1)},
+			nil,
+			"",
+		},
+		{
+			"data and expression from vars",
+			"$(foo.bar)",
+			"$(query)",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo":   map[string]interface{}{"bar": jmespathPrices},
+				"query": "length(data.prices)",
+			}),
+			[]pipeline.Result{},
+			float64(3),
+			nil,
+			"",
+		},
+		{
+			"no match",
+			"",
+			"data.prices[?symbol=='DOGE'].price | [0]",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+			"evaluated to null",
+		},
+		{
+			"no match lax",
+			"",
+			"data.prices[?symbol=='DOGE'].price | [0]",
+			"true",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			nil,
+			nil,
+			"",
+		},
+		{
+			"invalid expression",
+			"",
+			"data.prices[?",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			nil,
+			pipeline.ErrBadInput,
+			"invalid JMESPath expression",
+		},
+		{
+			"invalid function argument",
+			"",
+			"abs(data.updatedAt)",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			nil,
+			pipeline.ErrBadInput,
+			"abs",
+		},
+		{
+			"missing expression",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: jmespathPrices}},
+			nil,
+			pipeline.ErrParameterEmpty,
+			"expression",
+		},
+		{
+			"malformed data",
+			"",
+			"data",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":`}},
+			nil,
+			pipeline.ErrBadInput,
+			"",
+		},
+		{
+			"input error",
+			"",
+			"data",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Error: errors.New("foo")}},
+			nil,
+			pipeline.ErrTooManyErrors,
+			"task inputs",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JMESPathTask{
+				BaseTask:   pipeline.NewBaseTask(0, "jmespath", nil, nil, 0),
+				Expression: test.expression,
+				Data:       test.data,
+				Lax:        test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
+
+func mustBigInt(t *testing.T, s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	require.True(t, ok)
+	return n
+}
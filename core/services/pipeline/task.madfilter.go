@@ -0,0 +1,105 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// MADFilterTask rejects outliers using the median absolute deviation (MAD):
+// a value is discarded if it deviates from the median of the values by more
+// than threshold times the MAD. The threshold defaults to 3. Note that if more
+// than half of the values are equal the MAD is zero, and every other value is
+// discarded.
+//
+// The remaining values are returned in their original order, so that they can
+// be aggregated further, e.g.
+//
+//	filter [type=madfilter threshold=2.5]
+//	mean   [type=mean values="$(filter.result)"]
+//
+// The output records the inputs which were discarded, either because they
+// errored or were outliers:
+//
+//	{"result": []decimal.Decimal, "discarded": [{"index": 0, "value": decimal.Decimal, "reason": "outlier"}]}
+//
+// Return types:
+//
+//	map[string]interface{}
+type MADFilterTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Threshold     string `json:"threshold"`
+	AllowedFaults string `json:"allowedFaults"`
+}
+
+var _ Task = (*MADFilterTask)(nil)
+
+func (t *MADFilterTask) Type() TaskType {
+	return TaskTypeMADFilter
+}
+
+func (t *MADFilterTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		threshold          DecimalParam
+		valuesAndErrs      SliceParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&threshold, From(VarExpr(t.Threshold, vars), NonemptyString(t.Threshold), 3)), "threshold"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if threshold.Decimal().IsNegative() {
+		return Result{Error: errors.Wrapf(ErrBadInput, "threshold must not be negative, got %v", threshold.Decimal())}, runInfo
+	}
+
+	values, discarded, err := resolveAggregateInputs(t.Type(), valuesAndErrs, maybeAllowedFaults)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	sorted := make([]decimal.Decimal, len(values))
+	for i, v := range values {
+		sorted[i] = v.value
+	}
+	median := medianOf(sorted)
+
+	deviations := make([]decimal.Decimal, len(values))
+	for i, v := range values {
+		deviations[i] = v.value.Sub(median).Abs()
+	}
+	sortedDeviations := append([]decimal.Decimal(nil), deviations...)
+	sort.Slice(sortedDeviations, func(i, j int) bool {
+		return sortedDeviations[i].LessThan(sortedDeviations[j])
+	})
+	maxDeviation := medianOf(sortedDeviations).Mul(threshold.Decimal())
+
+	kept := make([]aggregateInput, 0, len(values))
+	for i, v := range values {
+		if deviations[i].GreaterThan(maxDeviation) {
+			discarded = append(discarded, discardedAggregateInput(v, DiscardReasonOutlier))
+			continue
+		}
+		kept = append(kept, v)
+	}
+
+	// restore the original order of the values
+	sort.Slice(kept, func(i, j int) bool {
+		return kept[i].index < kept[j].index
+	})
+	filtered := make([]interface{}, len(kept))
+	for i, v := range kept {
+		filtered[i] = v.value
+	}
+	return Result{Value: aggregateResult(filtered, discarded)}, runInfo
+}
@@ -0,0 +1,141 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestMADFilterTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		threshold     string
+		allowedFaults string
+		want          []string
+		wantErr       error
+		wantDiscarded map[int]string
+	}{
+		{
+			// median 11, deviations [0 1 1 2 89], MAD 1
+			"rejects outliers",
+			[]pipeline.Result{{Value: "10"}, {Value: "11"}, {Value: "100"}, {Value: "9"}, {Value: "12"}},
+			"",
+			"",
+			[]string{"10", "11", "9", "12"},
+			nil,
+			map[int]string{2: pipeline.DiscardReasonOutlier},
+		},
+		{
+			"custom threshold",
+			[]pipeline.Result{{Value: "10"}, {Value: "11"}, {Value: "100"}, {Value: "9"}, {Value: "12"}},
+			"1.5",
+			"",
+			[]string{"10", "11", "12"},
+			nil,
+			map[int]string{2: pipeline.DiscardReasonOutlier, 3: pipeline.DiscardReasonOutlier},
+		},
+		{
+			"no outliers",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}, {Value: "3"}},
+			"",
+			"",
+			[]string{"1", "2", "3"},
+			nil,
+			map[int]string{},
+		},
+		{
+			"zero MAD",
+			[]pipeline.Result{{Value: "5"}, {Value: "5"}, {Value: "5"}, {Value: "6"}},
+			"",
+			"",
+			[]string{"5", "5", "5"},
+			nil,
+			map[int]string{3: pipeline.DiscardReasonOutlier},
+		},
+		{
+			"errors within allowed faults",
+			[]pipeline.Result{{Error: errors.New("boom")}, {Value: "10"}, {Value: "11"}, {Value: "-100"}, {Value: "9"}},
+			"",
+			"1",
+			[]string{"10", "11", "9"},
+			nil,
+			map[int]string{0: pipeline.DiscardReasonErrored, 3: pipeline.DiscardReasonOutlier},
+		},
+		{
+			"more errors than allowed faults",
+			[]pipeline.Result{{Error: errors.New("boom")}, {Error: errors.New("boom")}, {Value: "9"}},
+			"",
+			"1",
+			nil,
+			pipeline.ErrTooManyErrors,
+			nil,
+		},
+		{
+			"negative threshold",
+			[]pipeline.Result{{Value: "1"}},
+			"-1",
+			"",
+			nil,
+			pipeline.ErrBadInput,
+			nil,
+		},
+		{
+			"zero inputs",
+			[]pipeline.Result{},
+			"",
+			"0",
+			nil,
+			pipeline.ErrWrongInputCardinality,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.MADFilterTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Threshold:     test.threshold,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				requireAggregateResult(t, output, test.want, test.wantDiscarded)
+			}
+		})
+	}
+}
+
+func TestMADFilterTask_Pipeline(t *testing.T) {
+	t.Parallel()
+
+	r := newSimulationRunner(t)
+	spec := pipeline.Spec{DotDagSource: `
+filter [type=madfilter values=<[ $(a), $(b), $(c), $(d) ]>]
+mean   [type=mean values="$(filter.result)"]
+
+filter -> mean
+`}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"a": 100, "b": 102, "c": 98, "d": 1000})
+
+	_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+	require.NoError(t, err)
+	final := trrs.FinalResult()
+	require.False(t, final.HasFatalErrors())
+	require.Len(t, final.Values, 1)
+	assert.Equal(t, "100", final.Values[0].(interface{ String() string }).String())
+}
@@ -0,0 +1,88 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// TrimmedMeanTask discards the lowest and highest values before taking the
+// mean. Trim is the fraction of values discarded at each end, rounded down,
+// e.g. trim=0.2 over 10 values discards the lowest 2 and the highest 2.
+//
+// The output records the inputs which were discarded, either because they
+// errored or were trimmed:
+//
+//	{"result": decimal.Decimal, "discarded": [{"index": 0, "value": decimal.Decimal, "reason": "trimmed"}]}
+//
+// Return types:
+//
+//	map[string]interface{}
+type TrimmedMeanTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Trim          string `json:"trim"`
+	AllowedFaults string `json:"allowedFaults"`
+	Precision     string `json:"precision"`
+}
+
+var _ Task = (*TrimmedMeanTask)(nil)
+
+func (t *TrimmedMeanTask) Type() TaskType {
+	return TaskTypeTrimmedMean
+}
+
+func (t *TrimmedMeanTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		maybePrecision     MaybeInt32Param
+		trim               DecimalParam
+		valuesAndErrs      SliceParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&trim, From(VarExpr(t.Trim, vars), NonemptyString(t.Trim))), "trim"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if trim.Decimal().IsNegative() || trim.Decimal().GreaterThanOrEqual(decimal.NewFromFloat(0.5)) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "trim must be in the range [0, 0.5), got %v", trim.Decimal())}, runInfo
+	}
+
+	values, discarded, err := resolveAggregateInputs(t.Type(), valuesAndErrs, maybeAllowedFaults)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	k := int(decimal.NewFromInt(int64(len(values))).Mul(trim.Decimal()).IntPart())
+	for _, v := range values[:k] {
+		discarded = append(discarded, discardedAggregateInput(v, DiscardReasonTrimmed))
+	}
+	for _, v := range values[len(values)-k:] {
+		discarded = append(discarded, discardedAggregateInput(v, DiscardReasonTrimmed))
+	}
+	values = values[k : len(values)-k]
+
+	total := decimal.NewFromInt(0)
+	for _, v := range values {
+		total = total.Add(v.value)
+	}
+	numValues := decimal.NewFromInt(int64(len(values)))
+
+	var mean decimal.Decimal
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		mean = total.DivRound(numValues, precision)
+	} else {
+		// Note that decimal library defaults to rounding to 16 precision
+		mean = total.Div(numValues)
+	}
+	return Result{Value: aggregateResult(mean, discarded)}, runInfo
+}
@@ -0,0 +1,196 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// requireAggregateResult checks the output of an outlier-filtering aggregation
+// task, with discarded inputs given as a map of index to reason.
+func requireAggregateResult(t *testing.T, output pipeline.Result, want interface{}, wantDiscarded map[int]string) {
+	t.Helper()
+	require.NoError(t, output.Error)
+	value, ok := output.Value.(map[string]interface{})
+	require.True(t, ok, "expected map[string]interface{}, got %T", output.Value)
+
+	switch result := value["result"].(type) {
+	case decimal.Decimal:
+		assert.Equal(t, want.(*decimal.Decimal).String(), result.String())
+	case []interface{}:
+		var got []string
+		for _, v := range result {
+			got = append(got, v.(decimal.Decimal).String())
+		}
+		assert.Equal(t, want, got)
+	default:
+		t.Fatalf("unexpected result %T", result)
+	}
+
+	discarded := map[int]string{}
+	prevIndex := -1
+	for _, d := range value["discarded"].([]interface{}) {
+		entry := d.(map[string]interface{})
+		index := entry["index"].(int)
+		assert.Greater(t, index, prevIndex, "discarded inputs must be ordered by index")
+		prevIndex = index
+		discarded[index] = entry["reason"].(string)
+	}
+	assert.Equal(t, wantDiscarded, discarded)
+}
+
+func TestTrimmedMeanTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		trim          string
+		allowedFaults string
+		precision     string
+		want          pipeline.Result
+		wantDiscarded map[int]string
+	}{
+		{
+			"trims both ends",
+			[]pipeline.Result{{Value: "100"}, {Value: "3"}, {Value: "1"}, {Value: "2"}, {Value: "-50"}},
+			"0.2",
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2")},
+			map[int]string{0: pipeline.DiscardReasonTrimmed, 4: pipeline.DiscardReasonTrimmed},
+		},
+		{
+			"trim is rounded down",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}, {Value: "3"}, {Value: "10"}},
+			"0.2",
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "4")},
+			map[int]string{},
+		},
+		{
+			"zero trim",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}, {Value: "6"}},
+			"0",
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+			map[int]string{},
+		},
+		{
+			"precision",
+			[]pipeline.Result{{Value: "1"}, {Value: "1"}, {Value: "2"}, {Value: "2"}, {Value: "9"}},
+			"0.2",
+			"",
+			"2",
+			pipeline.Result{Value: mustDecimal(t, "1.67")},
+			map[int]string{0: pipeline.DiscardReasonTrimmed, 4: pipeline.DiscardReasonTrimmed},
+		},
+		{
+			"errors within allowed faults",
+			[]pipeline.Result{{Value: "1"}, {Error: errors.New("boom")}, {Value: "2"}, {Value: "3"}, {Value: "4"}, {Value: "5"}},
+			"0.2",
+			"1",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+			map[int]string{0: pipeline.DiscardReasonTrimmed, 1: pipeline.DiscardReasonErrored, 5: pipeline.DiscardReasonTrimmed},
+		},
+		{
+			"more errors than allowed faults",
+			[]pipeline.Result{{Value: "1"}, {Error: errors.New("boom")}, {Error: errors.New("boom")}},
+			"0.1",
+			"1",
+			"",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+			nil,
+		},
+		{
+			"trim too large",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}},
+			"0.5",
+			"",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+			nil,
+		},
+		{
+			"negative trim",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}},
+			"-0.1",
+			"",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+			nil,
+		},
+		{
+			"missing trim",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}},
+			"",
+			"",
+			"",
+			pipeline.Result{Error: pipeline.ErrParameterEmpty},
+			nil,
+		},
+		{
+			"zero inputs",
+			[]pipeline.Result{},
+			"0.1",
+			"0",
+			"",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+			nil,
+		},
+		{
+			"non-numeric input",
+			[]pipeline.Result{{Value: "foo"}},
+			"0.1",
+			"",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.TrimmedMeanTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Trim:          test.trim,
+				AllowedFaults: test.allowedFaults,
+				Precision:     test.precision,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				requireAggregateResult(t, output, test.want.Value, test.wantDiscarded)
+			}
+		})
+	}
+
+	t.Run("with vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"foo":  map[string]interface{}{"bar": []interface{}{"5", "1", "3"}},
+			"trim": "0.34",
+		})
+		task := pipeline.TrimmedMeanTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Values:   "$(foo.bar)",
+			Trim:     "$(trim)",
+		}
+		output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		requireAggregateResult(t, output, mustDecimal(t, "3"), map[int]string{0: pipeline.DiscardReasonTrimmed, 1: pipeline.DiscardReasonTrimmed})
+	})
+}
@@ -0,0 +1,102 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// WeightedMedianTask takes the median of values where each value carries the
+// weight of its source, e.g.
+//
+//	median [type=weightedmedian values=<[ $(a), $(b), $(c) ]> weights="[1, 2, 1]"]
+//
+// Weights must be non-negative, and there must be one weight per value. The
+// result is the value at which the cumulative weight reaches half of the total
+// weight, or the average of the two values either side of it if it is reached
+// exactly. With equal weights this is the same as the plain median. Errored
+// inputs and their weights are left out.
+//
+// The output records the inputs which were discarded because they errored:
+//
+//	{"result": decimal.Decimal, "discarded": [{"index": 0, "error": "...", "reason": "errored"}]}
+//
+// Return types:
+//
+//	map[string]interface{}
+type WeightedMedianTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Weights       string `json:"weights"`
+	AllowedFaults string `json:"allowedFaults"`
+}
+
+var _ Task = (*WeightedMedianTask)(nil)
+
+func (t *WeightedMedianTask) Type() TaskType {
+	return TaskTypeWeightedMedian
+}
+
+func (t *WeightedMedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+		weights            DecimalSliceParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&weights, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, false))), "weights"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if len(weights) != len(valuesAndErrs) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "got %d weights for %d values", len(weights), len(valuesAndErrs))}, runInfo
+	}
+	for i, w := range weights {
+		if w.IsNegative() {
+			return Result{Error: errors.Wrapf(ErrBadInput, "weight %d is negative: %v", i, w)}, runInfo
+		}
+	}
+
+	values, discarded, err := resolveAggregateInputs(t.Type(), valuesAndErrs, maybeAllowedFaults)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	total := decimal.NewFromInt(0)
+	for _, v := range values {
+		total = total.Add(weights[v.index])
+	}
+	if !total.IsPositive() {
+		return Result{Error: errors.Wrap(ErrBadInput, "total weight of values is zero")}, runInfo
+	}
+	half := total.Div(decimal.NewFromInt(2))
+
+	cumulative := decimal.NewFromInt(0)
+	var median decimal.Decimal
+	for i, v := range values {
+		cumulative = cumulative.Add(weights[v.index])
+		if cumulative.LessThan(half) {
+			continue
+		}
+		median = v.value
+		if cumulative.Equal(half) {
+			// average with the next value carrying any weight
+			for _, next := range values[i+1:] {
+				if weights[next.index].IsPositive() {
+					median = median.Add(next.value).Div(decimal.NewFromInt(2))
+					break
+				}
+			}
+		}
+		break
+	}
+	return Result{Value: aggregateResult(median, discarded)}, runInfo
+}
@@ -0,0 +1,141 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestWeightedMedianTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		weights       string
+		allowedFaults string
+		want          pipeline.Result
+		wantDiscarded map[int]string
+	}{
+		{
+			"weights shift the median",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}, {Value: "3"}},
+			"[1, 1, 5]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+			map[int]string{},
+		},
+		{
+			"equal weights",
+			[]pipeline.Result{{Value: "4"}, {Value: "1"}, {Value: "3"}, {Value: "2"}},
+			"[1, 1, 1, 1]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2.5")},
+			map[int]string{},
+		},
+		{
+			"half weight reached exactly",
+			[]pipeline.Result{{Value: "10"}, {Value: "20"}, {Value: "30"}},
+			"[0.5, 0, 0.5]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "20")},
+			map[int]string{},
+		},
+		{
+			"zero weights",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}, {Value: "3"}},
+			"[0, 0, 1]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+			map[int]string{},
+		},
+		{
+			"errored inputs and their weights are left out",
+			[]pipeline.Result{{Value: "1"}, {Error: errors.New("boom")}, {Value: "3"}, {Value: "4"}},
+			"[1, 10, 1, 1]",
+			"1",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+			map[int]string{1: pipeline.DiscardReasonErrored},
+		},
+		{
+			"more errors than allowed faults",
+			[]pipeline.Result{{Value: "1"}, {Error: errors.New("boom")}, {Error: errors.New("boom")}},
+			"[1, 1, 1]",
+			"1",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+			nil,
+		},
+		{
+			"weights mismatch",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}},
+			"[1]",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+			nil,
+		},
+		{
+			"negative weight",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}},
+			"[1, -1]",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+			nil,
+		},
+		{
+			"zero total weight",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}},
+			"[0, 0]",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+			nil,
+		},
+		{
+			"zero inputs",
+			[]pipeline.Result{},
+			"[]",
+			"0",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.WeightedMedianTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Weights:       test.weights,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				requireAggregateResult(t, output, test.want.Value, test.wantDiscarded)
+			}
+		})
+	}
+
+	t.Run("with vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"a": 100, "b": 200, "c": 300,
+			"weights": map[string]interface{}{"a": 3, "b": 1},
+		})
+		task := pipeline.WeightedMedianTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Values:   "[ $(a), $(b), $(c) ]",
+			Weights:  "[ $(weights.a), $(weights.b), 1 ]",
+		}
+		output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		requireAggregateResult(t, output, mustDecimal(t, "100"), map[int]string{})
+	})
+}
@@ -594,6 +594,44 @@ func (s *DecimalSliceParam) UnmarshalPipelineParam(val interface{}) error {
 	return nil
 }
 
+type Uint64SliceParam []uint64
+
+func (s *Uint64SliceParam) UnmarshalPipelineParam(val interface{}) error {
+	var usp Uint64SliceParam
+	switch v := val.(type) {
+	case nil:
+		usp = nil
+	case []uint64:
+		usp = v
+	case []interface{}:
+		return s.UnmarshalPipelineParam(SliceParam(v))
+	case SliceParam:
+		for _, x := range v {
+			var u Uint64Param
+			err := u.UnmarshalPipelineParam(x)
+			if err != nil {
+				return err
+			}
+			usp = append(usp, uint64(u))
+		}
+	case string:
+		return s.UnmarshalPipelineParam([]byte(v))
+
+	case []byte:
+		var theSlice []interface{}
+		err := json.Unmarshal(v, &theSlice)
+		if err != nil {
+			return errors.Wrap(ErrBadInput, err.Error())
+		}
+		return s.UnmarshalPipelineParam(SliceParam(theSlice))
+
+	default:
+		return errors.Wrapf(ErrBadInput, "expected unsigned integers, got %T", val)
+	}
+	*s = usp
+	return nil
+}
+
 type HashSliceParam []common.Hash
 
 func (s *HashSliceParam) UnmarshalPipelineParam(val interface{}) error {
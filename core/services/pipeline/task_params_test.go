@@ -632,6 +632,37 @@ func TestDecimalSliceParam_UnmarshalPipelineParam(t *testing.T) {
 	}
 }
 
+func TestUint64SliceParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	expected := pipeline.Uint64SliceParam{429, 502, 503}
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected interface{}
+		err      error
+	}{
+		{"[]interface{}", []interface{}{429, "502", float64(503)}, expected, nil},
+		{"string", `[429, "502", 503]`, expected, nil},
+		{"[]byte", []byte(`[429, 502, 503]`), expected, nil},
+		{"[]uint64", []uint64{429, 502, 503}, expected, nil},
+		{"[]interface{} with error", `[429, -1]`, pipeline.Uint64SliceParam(nil), pipeline.ErrBadInput},
+		{"malformed string", `429,502`, pipeline.Uint64SliceParam(nil), pipeline.ErrBadInput},
+		{"bool", true, pipeline.Uint64SliceParam(nil), pipeline.ErrBadInput},
+		{"nil", nil, pipeline.Uint64SliceParam(nil), nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var p pipeline.Uint64SliceParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			require.Equal(t, test.expected, p)
+		})
+	}
+}
+
 func TestJSONPathParam_UnmarshalPipelineParam(t *testing.T) {
 	t.Parallel()
 
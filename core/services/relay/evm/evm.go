@@ -36,6 +36,7 @@ import (
 	coretypes "github.com/smartcontractkit/chainlink-common/pkg/types/core"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	txm "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -802,6 +803,8 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 			checker,
 			configWatcher.chain.ID(),
 			ethKeystore,
+			// Reports are only valid for a short time, so they're sent ahead of any other transactions of the key.
+			ocrcommon.WithPriority(txmgrtypes.TxPriorityCritical),
 		)
 	case commontypes.CCIPExecution:
 		transmitter, err = cciptransmitter.NewTransmitterWithStatusChecker(
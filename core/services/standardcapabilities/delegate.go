@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/compute"
 	gatewayconnector "github.com/smartcontractkit/chainlink/v2/core/capabilities/gateway_connector"
+	crontrigger "github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi"
 	webapitarget "github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi/target"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi/trigger"
@@ -57,6 +59,7 @@ const (
 	commandOverrideForWebAPITrigger       = "__builtin_web-api-trigger"
 	commandOverrideForWebAPITarget        = "__builtin_web-api-target"
 	commandOverrideForCustomComputeAction = "__builtin_custom-compute-action"
+	commandOverrideForCronTrigger         = "__builtin_cron-trigger"
 )
 
 type NewOracleFactoryFn func(generic.OracleFactoryParams) (core.OracleFactory, error)
@@ -258,6 +261,11 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		return []job.ServiceCtx{computeSrvc}, nil
 	}
 
+	if spec.StandardCapabilitiesSpec.Command == commandOverrideForCronTrigger {
+		triggerSrvc := crontrigger.NewTriggerService(log, d.registry, clockwork.NewRealClock())
+		return []job.ServiceCtx{triggerSrvc}, nil
+	}
+
 	standardCapability := newStandardCapabilities(log, spec.StandardCapabilitiesSpec, d.cfg, telemetryService, kvStore, d.registry, errorLog,
 		pr, relayerSet, oracleFactory)
 
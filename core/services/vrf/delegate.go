@@ -157,6 +157,9 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 			if jb.VRFSpec.CustomRevertsPipelineEnabled {
 				return nil, errors.New("Custom Reverted Txns Pipeline is not supported for VRF V2 Plus")
 			}
+			if err2 := chain.TxManager().RegisterRevertABI(jb.VRFSpec.CoordinatorAddress.Address(), vrf_coordinator_v2_5.VRFCoordinatorV25ABI); err2 != nil {
+				return nil, errors.Wrap(err2, "RegisterRevertABI")
+			}
 
 			// Get the LINKNATIVEFEED address with retries
 			// This is needed because the RPC endpoint may be down so we need to
@@ -229,6 +232,9 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 			if vrfOwner == nil {
 				lV2.Infow("Running without VRFOwnerAddress set on the spec")
 			}
+			if err2 := chain.TxManager().RegisterRevertABI(jb.VRFSpec.CoordinatorAddress.Address(), vrf_coordinator_v2.VRFCoordinatorV2ABI); err2 != nil {
+				return nil, errors.Wrap(err2, "RegisterRevertABI")
+			}
 
 			return []job.ServiceCtx{v2.New(
 				chain.Config().EVM(),
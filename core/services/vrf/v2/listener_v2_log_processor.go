@@ -605,6 +605,7 @@ func (lsn *listenerV2) enqueueForceFulfillment(
 		EncodedPayload: txData,
 		FeeLimit:       estimateGasLimit,
 		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		Priority:       txmgrtypes.TxPriorityBulk,
 		Meta: &txmgr.TxMeta{
 			RequestID:     &requestID,
 			SubID:         ptr(subID.Uint64()),
@@ -822,6 +823,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 						RequestTxHash: &requestTxHash,
 					},
 					Strategy: txmgrcommon.NewSendEveryStrategy(),
+					Priority: txmgrtypes.TxPriorityBulk,
 					Checker: txmgr.TransmitCheckerSpec{
 						CheckerType:           lsn.transmitCheckerType(),
 						VRFCoordinatorAddress: &coordinatorAddress,
@@ -19,6 +19,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -706,6 +707,7 @@ func (lsn *listenerV2) enqueueForceFulfillmentForRevertedTxn(
 		EncodedPayload: txData,
 		FeeLimit:       estimateGasLimit,
 		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		Priority:       txmgrtypes.TxPriorityBulk,
 		Meta: &txmgr.TxMeta{
 			RequestID:               &reqID,
 			SubID:                   &revertedTxn.DBReceipt.SubID,
@@ -0,0 +1,165 @@
+package workflows
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// admission is the outcome of a trigger event being admitted for execution.
+type admission int
+
+const (
+	// admissionStarted means the execution can be started right away.
+	admissionStarted admission = iota
+	// admissionQueued means the execution will be started once another one finishes.
+	admissionQueued
+	// admissionCoalesced means the execution replaced a previously queued one.
+	admissionCoalesced
+	// admissionDropped means the execution won't be started.
+	admissionDropped
+	// admissionDuplicate means the execution is already in progress or queued.
+	admissionDuplicate
+)
+
+// pendingExecution is an execution which was admitted but not started yet.
+type pendingExecution struct {
+	executionID    string
+	triggerEventID string
+	event          *values.Map
+}
+
+// executionLimiter enforces the concurrency controls of a workflow.
+// It tracks the executions in progress, in the order they were started,
+// as well as those queued to be started.
+type executionLimiter struct {
+	mu sync.Mutex
+	// maxConcurrent is the maximum number of executions in progress; zero means unlimited.
+	maxConcurrent int
+	mode          job.WorkflowConcurrencyMode
+	maxQueued     int
+
+	running []string
+	queued  []pendingExecution
+}
+
+func newExecutionLimiter(maxConcurrent int, mode job.WorkflowConcurrencyMode, maxQueued int) *executionLimiter {
+	if mode == "" {
+		mode = job.WorkflowConcurrencyModeQueue
+	}
+	return &executionLimiter{maxConcurrent: maxConcurrent, mode: mode, maxQueued: maxQueued}
+}
+
+// admit decides what to do with a new execution. With job.WorkflowConcurrencyModeCancelPrevious,
+// the oldest execution in progress to be cancelled in favour of the new one is also returned.
+func (l *executionLimiter) admit(pe pendingExecution) (admission, string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if slices.Contains(l.running, pe.executionID) || slices.ContainsFunc(l.queued, func(q pendingExecution) bool {
+		return q.executionID == pe.executionID
+	}) {
+		return admissionDuplicate, ""
+	}
+
+	if l.maxConcurrent == 0 || len(l.running) < l.maxConcurrent {
+		l.running = append(l.running, pe.executionID)
+		return admissionStarted, ""
+	}
+
+	switch l.mode {
+	case job.WorkflowConcurrencyModeDrop:
+		return admissionDropped, ""
+	case job.WorkflowConcurrencyModeQueueLatest:
+		if len(l.queued) > 0 {
+			l.queued[0] = pe
+			return admissionCoalesced, ""
+		}
+		l.queued = append(l.queued, pe)
+		return admissionQueued, ""
+	case job.WorkflowConcurrencyModeCancelPrevious:
+		// The new execution starts right away, and the oldest one is released once it's finished as cancelled.
+		oldest := l.running[len(l.running)-l.maxConcurrent]
+		l.running = append(l.running, pe.executionID)
+		return admissionStarted, oldest
+	default:
+		if len(l.queued) >= l.maxQueued {
+			return admissionDropped, ""
+		}
+		l.queued = append(l.queued, pe)
+		return admissionQueued, ""
+	}
+}
+
+// track counts an execution which was started without being admitted, e.g. when resuming it, towards the limit.
+func (l *executionLimiter) track(executionID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !slices.Contains(l.running, executionID) {
+		l.running = append(l.running, executionID)
+	}
+}
+
+// release frees the slot held by the given execution. If a queued execution can take its place, it is returned.
+func (l *executionLimiter) release(executionID string) (pendingExecution, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.running = slices.DeleteFunc(l.running, func(id string) bool { return id == executionID })
+	if len(l.queued) == 0 || (l.maxConcurrent != 0 && len(l.running) >= l.maxConcurrent) {
+		return pendingExecution{}, false
+	}
+
+	next := l.queued[0]
+	l.queued = l.queued[1:]
+	l.running = append(l.running, next.executionID)
+	return next, true
+}
+
+// admitExecution starts, queues or drops an execution for the given trigger event
+// according to the workflow's concurrency controls.
+func (e *Engine) admitExecution(ctx context.Context, pe pendingExecution) {
+	l := e.logger.With(eIDKey, pe.executionID)
+	admission, cancel := e.executionLimiter.admit(pe)
+	switch admission {
+	case admissionDuplicate:
+		l.Debug("execution already in progress or queued, ignoring trigger event")
+	case admissionDropped:
+		l.Warn("too many executions in progress, dropping trigger event")
+		e.metrics.incrementDroppedExecutionsCounter(ctx)
+	case admissionCoalesced:
+		l.Debug("too many executions in progress, replacing queued trigger event")
+		e.metrics.incrementCoalescedExecutionsCounter(ctx)
+	case admissionQueued:
+		l.Debug("too many executions in progress, queueing trigger event")
+	case admissionStarted:
+		if cancel != "" {
+			l.With("cancelledExecutionID", cancel).Info("too many executions in progress, cancelling the oldest one")
+			e.stepUpdatesChMap.cancel(cancel)
+		}
+		if err := e.startExecution(ctx, pe.executionID, pe.triggerEventID, pe.event); err != nil {
+			l.Errorf("failed to start execution: %v", err)
+			e.releaseExecution(ctx, pe.executionID)
+		}
+	}
+}
+
+// releaseExecution frees the slot held by a finished execution, starting a queued execution in its place.
+func (e *Engine) releaseExecution(ctx context.Context, executionID string) {
+	for {
+		next, ok := e.executionLimiter.release(executionID)
+		if !ok {
+			return
+		}
+		err := e.startExecution(ctx, next.executionID, next.triggerEventID, next.event)
+		if err == nil {
+			return
+		}
+		e.logger.With(eIDKey, next.executionID).Errorf("failed to start queued execution: %v", err)
+		executionID = next.executionID
+	}
+}
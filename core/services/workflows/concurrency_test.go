@@ -0,0 +1,110 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func TestExecutionLimiter(t *testing.T) {
+	t.Parallel()
+
+	pe := func(id string) pendingExecution {
+		return pendingExecution{executionID: id, triggerEventID: "event-" + id}
+	}
+	admit := func(t *testing.T, l *executionLimiter, id string, expected admission) {
+		t.Helper()
+		got, cancel := l.admit(pe(id))
+		assert.Equal(t, expected, got)
+		assert.Empty(t, cancel)
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+		l := newExecutionLimiter(0, "", 10)
+		for _, id := range []string{"1", "2", "3"} {
+			admit(t, l, id, admissionStarted)
+		}
+		admit(t, l, "2", admissionDuplicate)
+		_, ok := l.release("1")
+		assert.False(t, ok)
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		l := newExecutionLimiter(1, "", 2)
+		admit(t, l, "1", admissionStarted)
+		admit(t, l, "2", admissionQueued)
+		admit(t, l, "2", admissionDuplicate)
+		admit(t, l, "3", admissionQueued)
+		admit(t, l, "4", admissionDropped)
+
+		next, ok := l.release("1")
+		require.True(t, ok)
+		assert.Equal(t, pe("2"), next)
+		admit(t, l, "4", admissionQueued)
+
+		next, ok = l.release("2")
+		require.True(t, ok)
+		assert.Equal(t, pe("3"), next)
+		next, ok = l.release("3")
+		require.True(t, ok)
+		assert.Equal(t, pe("4"), next)
+		_, ok = l.release("4")
+		assert.False(t, ok)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		l := newExecutionLimiter(2, job.WorkflowConcurrencyModeDrop, 10)
+		admit(t, l, "1", admissionStarted)
+		admit(t, l, "2", admissionStarted)
+		admit(t, l, "3", admissionDropped)
+		_, ok := l.release("1")
+		assert.False(t, ok)
+		admit(t, l, "3", admissionStarted)
+	})
+
+	t.Run("queue latest", func(t *testing.T) {
+		l := newExecutionLimiter(1, job.WorkflowConcurrencyModeQueueLatest, 10)
+		admit(t, l, "1", admissionStarted)
+		admit(t, l, "2", admissionQueued)
+		admit(t, l, "3", admissionCoalesced)
+		admit(t, l, "4", admissionCoalesced)
+
+		next, ok := l.release("1")
+		require.True(t, ok)
+		assert.Equal(t, pe("4"), next)
+		_, ok = l.release("4")
+		assert.False(t, ok)
+	})
+
+	t.Run("cancel previous", func(t *testing.T) {
+		l := newExecutionLimiter(2, job.WorkflowConcurrencyModeCancelPrevious, 10)
+		admit(t, l, "1", admissionStarted)
+		admit(t, l, "2", admissionStarted)
+
+		got, cancel := l.admit(pe("3"))
+		assert.Equal(t, admissionStarted, got)
+		assert.Equal(t, "1", cancel)
+		// executions being cancelled aren't cancelled again
+		got, cancel = l.admit(pe("4"))
+		assert.Equal(t, admissionStarted, got)
+		assert.Equal(t, "2", cancel)
+
+		_, ok := l.release("1")
+		assert.False(t, ok)
+		_, ok = l.release("2")
+		assert.False(t, ok)
+		got, cancel = l.admit(pe("5"))
+		assert.Equal(t, admissionStarted, got)
+		assert.Equal(t, "3", cancel)
+	})
+
+	t.Run("tracked executions count towards the limit", func(t *testing.T) {
+		l := newExecutionLimiter(1, job.WorkflowConcurrencyModeDrop, 10)
+		l.track("1")
+		admit(t, l, "1", admissionDuplicate)
+		admit(t, l, "2", admissionDropped)
+	})
+}
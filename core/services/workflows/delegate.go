@@ -18,6 +18,8 @@ type Delegate struct {
 	registry core.CapabilitiesRegistry
 	logger   logger.Logger
 	store    store.Store
+	engines  *EngineRegistry
+	secrets  SecretsStore
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -47,15 +49,23 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 	}
 
 	cfg := Config{
-		Lggr:          d.logger,
-		Workflow:      sdkSpec,
-		WorkflowID:    spec.WorkflowSpec.WorkflowID,
-		WorkflowOwner: spec.WorkflowSpec.WorkflowOwner,
-		WorkflowName:  spec.WorkflowSpec.WorkflowName,
-		Registry:      d.registry,
-		Store:         d.store,
-		Config:        []byte(spec.WorkflowSpec.Config),
-		Binary:        binary,
+		Lggr:            d.logger,
+		Workflow:        sdkSpec,
+		WorkflowID:      spec.WorkflowSpec.WorkflowID,
+		WorkflowOwner:   spec.WorkflowSpec.WorkflowOwner,
+		WorkflowName:    spec.WorkflowSpec.WorkflowName,
+		WorkflowVersion: spec.WorkflowSpec.WorkflowVersion,
+		Registry:        d.registry,
+		Store:           d.store,
+		Config:          []byte(spec.WorkflowSpec.Config),
+		Binary:          binary,
+
+		MaxConcurrentExecutions: int(spec.WorkflowSpec.MaxConcurrentExecutions),
+		ConcurrencyMode:         spec.WorkflowSpec.ConcurrencyMode,
+		EngineRegistry:          d.engines,
+		Secrets:                 d.secrets,
+		AllowedSecrets:          spec.WorkflowSpec.AllowedSecrets,
+		Retired:                 spec.WorkflowSpec.Retired,
 	}
 	engine, err := NewEngine(cfg)
 	if err != nil {
@@ -68,8 +78,10 @@ func NewDelegate(
 	logger logger.Logger,
 	registry core.CapabilitiesRegistry,
 	store store.Store,
+	engines *EngineRegistry,
+	secrets SecretsStore,
 ) *Delegate {
-	return &Delegate{logger: logger, registry: registry, store: store}
+	return &Delegate{logger: logger, registry: registry, store: store, engines: engines, secrets: secrets}
 }
 
 func ValidatedWorkflowJobSpec(ctx context.Context, tomlString string) (job.Job, error) {
@@ -76,6 +76,58 @@ targets:
 			true,
 		},
 
+		{
+			"valid concurrency controls",
+			func() string {
+				return testspecs.DefaultWorkflowJobSpec(t).Toml() + `
+max_concurrent_executions = 2
+concurrency_mode = "cancel_previous"
+`
+			},
+			true,
+		},
+
+		{
+			"invalid concurrency mode",
+			func() string {
+				return testspecs.DefaultWorkflowJobSpec(t).Toml() + `
+max_concurrent_executions = 2
+concurrency_mode = "latest"
+`
+			},
+			false,
+		},
+
+		{
+			"concurrency mode without limit",
+			func() string {
+				return testspecs.DefaultWorkflowJobSpec(t).Toml() + `
+concurrency_mode = "drop"
+`
+			},
+			false,
+		},
+
+		{
+			"allowed secrets",
+			func() string {
+				return testspecs.DefaultWorkflowJobSpec(t).Toml() + `
+allowed_secrets = ["API_KEY", "OTHER_KEY"]
+`
+			},
+			true,
+		},
+
+		{
+			"invalid allowed secret name",
+			func() string {
+				return testspecs.DefaultWorkflowJobSpec(t).Toml() + `
+allowed_secrets = ["API-KEY"]
+`
+			},
+			false,
+		},
+
 		{
 			"parse error",
 			func() string {
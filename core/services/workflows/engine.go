@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/monitoring"
 
 	"github.com/jonboulle/clockwork"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/workflows/exec"
 	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/transmission"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 )
 
@@ -35,6 +37,28 @@ type stepRequest struct {
 type stepUpdateChannel struct {
 	executionID string
 	ch          chan store.WorkflowExecutionStep
+	// ctx is done once the execution is finished or cancelled.
+	// The steps of the execution are executed within it.
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	// span is the span of the execution, if it's traced, and ends with the stepUpdateLoop.
+	span trace.Span
+}
+
+var (
+	errExecutionFinished  = errors.New("execution finished")
+	errExecutionCancelled = errors.New("execution cancelled")
+)
+
+func newStepUpdateChannel(ctx context.Context, executionID string) stepUpdateChannel {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return stepUpdateChannel{
+		executionID: executionID,
+		ch:          make(chan store.WorkflowExecutionStep),
+		ctx:         ctx,
+		cancel:      cancel,
+		span:        trace.SpanFromContext(ctx),
+	}
 }
 
 type stepUpdateManager struct {
@@ -58,15 +82,31 @@ func (sucm *stepUpdateManager) add(executionID string, ch stepUpdateChannel) (ad
 	return true
 }
 
+func (sucm *stepUpdateManager) get(executionID string) (stepUpdateChannel, bool) {
+	sucm.mu.RLock()
+	defer sucm.mu.RUnlock()
+	ch, ok := sucm.m[executionID]
+	return ch, ok
+}
+
 func (sucm *stepUpdateManager) remove(executionID string) {
 	sucm.mu.Lock()
 	defer sucm.mu.Unlock()
-	if _, ok := sucm.m[executionID]; ok {
-		close(sucm.m[executionID].ch)
+	if ch, ok := sucm.m[executionID]; ok {
+		ch.cancel(errExecutionFinished)
 		delete(sucm.m, executionID)
 	}
 }
 
+// cancel cancels the execution, which is finished by its stepUpdateLoop.
+func (sucm *stepUpdateManager) cancel(executionID string) {
+	sucm.mu.RLock()
+	defer sucm.mu.RUnlock()
+	if ch, ok := sucm.m[executionID]; ok {
+		ch.cancel(errExecutionCancelled)
+	}
+}
+
 func (sucm *stepUpdateManager) send(ctx context.Context, executionID string, stepUpdate store.WorkflowExecutionStep) error {
 	sucm.mu.RLock()
 	stepUpdateCh, ok := sucm.m[executionID]
@@ -78,6 +118,8 @@ func (sucm *stepUpdateManager) send(ctx context.Context, executionID string, ste
 	select {
 	case <-ctx.Done():
 		return fmt.Errorf("context canceled before step update could be issued: %w", context.Cause(ctx))
+	case <-stepUpdateCh.ctx.Done():
+		return fmt.Errorf("execution is no longer in progress, dropping step update: %w", context.Cause(stepUpdateCh.ctx))
 	case stepUpdateCh.ch <- stepUpdate:
 		return nil
 	}
@@ -103,11 +145,23 @@ type Engine struct {
 	pendingStepRequests  chan stepRequest
 	triggerEvents        chan capabilities.TriggerResponse
 	stepUpdatesChMap     stepUpdateManager
+	executionLimiter     *executionLimiter
+	engineRegistry       *EngineRegistry
+	secrets              SecretsStore
 	wg                   sync.WaitGroup
 	stopCh               services.StopChan
 	newWorkerTimeout     time.Duration
 	maxExecutionDuration time.Duration
 
+	// triggersMu guards the registration of the workflow's triggers, which are deregistered while draining.
+	triggersMu sync.Mutex
+	// initialized is set once the workflow's capabilities are resolved, so that its triggers can be registered.
+	initialized bool
+	// draining is set while the engine runs a retired version of the workflow.
+	draining bool
+	// triggersStopCh stops forwarding the events of the registered triggers; it's nil while they aren't registered.
+	triggersStopCh services.StopChan
+
 	// testing lifecycle hook to signal when an execution is finished.
 	onExecutionFinished func(string)
 	// testing lifecycle hook to signal initialization status
@@ -135,6 +189,12 @@ func (e *Engine) Start(_ context.Context) error {
 			return fmt.Errorf("could not initialize monitoring resources: %w", err)
 		}
 
+		if e.engineRegistry != nil {
+			if err = e.engineRegistry.add(e.workflow.id, e); err != nil {
+				return err
+			}
+		}
+
 		e.wg.Add(e.maxWorkerLimit)
 		for i := 0; i < e.maxWorkerLimit; i++ {
 			go e.worker(ctx)
@@ -339,9 +399,25 @@ func (e *Engine) init(ctx context.Context) {
 		e.logger.Errorf("failed to resume in-progress workflows: %v", err)
 	}
 
+	e.triggersMu.Lock()
+	e.initialized = true
+	if e.draining {
+		e.logger.Info("workflow version is retired, not registering triggers")
+	} else {
+		e.registerTriggers(ctx)
+	}
+	e.triggersMu.Unlock()
+
+	e.logger.Info("engine initialized")
+	e.afterInit(true)
+}
+
+// registerTriggers binds all the triggers to this workflow. The caller must hold triggersMu.
+func (e *Engine) registerTriggers(ctx context.Context) {
 	e.logger.Debug("registering triggers")
+	e.triggersStopCh = make(services.StopChan)
 	for idx, t := range e.workflow.triggers {
-		terr := e.registerTrigger(ctx, t, idx)
+		terr := e.registerTrigger(ctx, t, idx, e.triggersStopCh)
 		if terr != nil {
 			e.logger.With(cIDKey, t.ID).Errorf("failed to register trigger: %s", terr)
 			cerr := e.cma.With(cIDKey, t.ID).SendLogAsCustomMessage(fmt.Sprintf("failed to register trigger: %s", terr))
@@ -350,9 +426,21 @@ func (e *Engine) init(ctx context.Context) {
 			}
 		}
 	}
+}
 
-	e.logger.Info("engine initialized")
-	e.afterInit(true)
+// deregisterTriggers unbinds the triggers registered by registerTriggers, if any. The caller must hold triggersMu.
+func (e *Engine) deregisterTriggers(ctx context.Context) error {
+	if e.triggersStopCh == nil {
+		return nil
+	}
+	close(e.triggersStopCh)
+	e.triggersStopCh = nil
+
+	var err error
+	for idx, t := range e.workflow.triggers {
+		err = errors.Join(err, e.deregisterTrigger(ctx, t, idx))
+	}
+	return err
 }
 
 var (
@@ -360,7 +448,7 @@ var (
 )
 
 func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
-	wipExecutions, err := e.executionStates.GetUnfinished(ctx, defaultOffset, defaultLimit)
+	wipExecutions, err := e.executionStates.GetUnfinished(ctx, e.workflow.id, defaultOffset, defaultLimit)
 	if err != nil {
 		return err
 	}
@@ -397,15 +485,17 @@ func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
 			}
 
 			for _, sd := range sds {
-				ch := make(chan store.WorkflowExecutionStep)
-				added := e.stepUpdatesChMap.add(execution.ExecutionID, stepUpdateChannel{
-					ch:          ch,
-					executionID: execution.ExecutionID,
-				})
+				ch := newStepUpdateChannel(e.startExecutionSpan(ctx, execution.ExecutionID), execution.ExecutionID)
+				added := e.stepUpdatesChMap.add(execution.ExecutionID, ch)
 				if added {
+					// Resumed executions count towards the workflow's concurrency limit.
+					e.executionLimiter.track(execution.ExecutionID)
 					// We trigger the `stepUpdateLoop` for this execution, since the loop is not running atm.
 					e.wg.Add(1)
-					go e.stepUpdateLoop(ctx, execution.ExecutionID, ch, execution.CreatedAt)
+					go e.stepUpdateLoop(ctx, ch, execution.CreatedAt)
+				} else {
+					ch.cancel(nil)
+					ch.span.End()
 				}
 				e.queueIfReady(execution, sd)
 			}
@@ -418,8 +508,9 @@ func generateTriggerId(workflowID string, triggerIdx int) string {
 	return fmt.Sprintf("wf_%s_trigger_%d", workflowID, triggerIdx)
 }
 
-// registerTrigger is used during the initialization phase to bind a trigger to this workflow
-func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability, triggerIdx int) error {
+// registerTrigger is used during the initialization phase to bind a trigger to this workflow.
+// Its events are forwarded until stopCh is closed.
+func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability, triggerIdx int, stopCh services.StopChan) error {
 	triggerID := generateTriggerId(e.workflow.id, triggerIdx)
 
 	tc, err := values.NewMap(t.Config)
@@ -468,6 +559,8 @@ func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability, trig
 			select {
 			case <-e.stopCh:
 				return
+			case <-stopCh:
+				return
 			case event, isOpen := <-eventsCh:
 				if !isOpen {
 					return
@@ -476,6 +569,8 @@ func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability, trig
 				select {
 				case <-e.stopCh:
 					return
+				case <-stopCh:
+					return
 				case e.triggerEvents <- event:
 				}
 			}
@@ -492,8 +587,10 @@ func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability, trig
 // This is important to avoid data races, and any accesses of `executionState` by any other
 // goroutine should happen via a `stepRequest` message containing a copy of the latest
 // `executionState`.
-func (e *Engine) stepUpdateLoop(ctx context.Context, executionID string, stepUpdateCh chan store.WorkflowExecutionStep, workflowCreatedAt *time.Time) {
+func (e *Engine) stepUpdateLoop(ctx context.Context, stepUpdateCh stepUpdateChannel, workflowCreatedAt *time.Time) {
 	defer e.wg.Done()
+	defer stepUpdateCh.span.End()
+	executionID := stepUpdateCh.executionID
 	lggr := e.logger.With(eIDKey, executionID)
 	e.logger.Debugf("running stepUpdateLoop for execution %s", executionID)
 	for {
@@ -501,11 +598,18 @@ func (e *Engine) stepUpdateLoop(ctx context.Context, executionID string, stepUpd
 		case <-ctx.Done():
 			lggr.Debug("shutting down stepUpdateLoop")
 			return
-		case stepUpdate, open := <-stepUpdateCh:
-			if !open {
-				lggr.Debug("stepUpdate channel closed, shutting down stepUpdateLoop")
-				return
+		case <-stepUpdateCh.ctx.Done():
+			// Only this loop finishes the execution, so it's still in progress
+			// unless it was finished by a previous step update.
+			if _, inProgress := e.stepUpdatesChMap.get(executionID); inProgress && errors.Is(context.Cause(stepUpdateCh.ctx), errExecutionCancelled) {
+				lggr.Info("execution cancelled")
+				if err := e.finishExecution(ctx, executionID, store.StatusCancelled); err != nil {
+					lggr.Errorf("failed to finish cancelled execution: %v", err)
+				}
 			}
+			lggr.Debug("execution no longer in progress, shutting down stepUpdateLoop")
+			return
+		case stepUpdate := <-stepUpdateCh.ch:
 			// Executed synchronously to ensure we correctly schedule subsequent tasks.
 			e.logger.Debugw(fmt.Sprintf("received step update for execution %s", stepUpdate.ExecutionID),
 				eIDKey, stepUpdate.ExecutionID, sRKey, stepUpdate.Ref)
@@ -518,6 +622,17 @@ func (e *Engine) stepUpdateLoop(ctx context.Context, executionID string, stepUpd
 	}
 }
 
+// runStepUpdateLoop runs the stepUpdateLoop of an execution until it finishes or the engine stops.
+// The loop doesn't depend on the caller's context, since executions can outlive the requests starting them.
+func (e *Engine) runStepUpdateLoop(ch stepUpdateChannel, workflowCreatedAt *time.Time) {
+	ctx, cancel := e.stopCh.NewCtx()
+	e.wg.Add(1)
+	go func() {
+		defer cancel()
+		e.stepUpdateLoop(ctx, ch, workflowCreatedAt)
+	}()
+}
+
 func generateExecutionID(workflowID, eventID string) (string, error) {
 	s := sha256.New()
 	_, err := s.Write([]byte(workflowID))
@@ -534,7 +649,7 @@ func generateExecutionID(workflowID, eventID string) (string, error) {
 }
 
 // startExecution kicks off a new workflow execution when a trigger event is received.
-func (e *Engine) startExecution(ctx context.Context, executionID string, event *values.Map) error {
+func (e *Engine) startExecution(ctx context.Context, executionID, triggerEventID string, event *values.Map) error {
 	lggr := e.logger.With("event", event, eIDKey, executionID)
 	lggr.Debug("executing on a trigger event")
 	ec := &store.WorkflowExecution{
@@ -548,9 +663,11 @@ func (e *Engine) startExecution(ctx context.Context, executionID string, event *
 				Ref:         workflows.KeywordTrigger,
 			},
 		},
-		WorkflowID:  e.workflow.id,
-		ExecutionID: executionID,
-		Status:      store.StatusStarted,
+		WorkflowID:      e.workflow.id,
+		WorkflowVersion: e.workflow.version,
+		ExecutionID:     executionID,
+		TriggerEventID:  triggerEventID,
+		Status:          store.StatusStarted,
 	}
 
 	dbWex, err := e.executionStates.Add(ctx, ec)
@@ -566,18 +683,16 @@ func (e *Engine) startExecution(ctx context.Context, executionID string, event *
 		return err
 	}
 
-	ch := make(chan store.WorkflowExecutionStep)
-	added := e.stepUpdatesChMap.add(executionID, stepUpdateChannel{
-		ch:          ch,
-		executionID: executionID,
-	})
+	ch := newStepUpdateChannel(context.WithoutCancel(e.startExecutionSpan(ctx, executionID)), executionID)
+	added := e.stepUpdatesChMap.add(executionID, ch)
 	if !added {
+		ch.cancel(nil)
+		ch.span.End()
 		// skip this execution since there's already a stepUpdateLoop running for the execution ID
 		lggr.Debugf("won't start execution for execution %s, execution was already started", executionID)
 		return nil
 	}
-	e.wg.Add(1)
-	go e.stepUpdateLoop(ctx, executionID, ch, dbWex.CreatedAt)
+	e.runStepUpdateLoop(ch, dbWex.CreatedAt)
 
 	for _, td := range triggerDependents {
 		e.queueIfReady(*ec, td)
@@ -601,6 +716,13 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 		return err
 	}
 
+	if stepUpdate.Status == store.StatusSkipped {
+		state, err = e.skipDependents(ctx, state, stepUpdate.Ref)
+		if err != nil {
+			return err
+		}
+	}
+
 	workflowIsFullyProcessed, status, err := e.isWorkflowFullyProcessed(ctx, state)
 	if err != nil {
 		return err
@@ -641,6 +763,36 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 	return nil
 }
 
+// skipDependents marks every step downstream of the skipped step with the given ref as skipped too,
+// since none of them will be executed.
+func (e *Engine) skipDependents(ctx context.Context, state store.WorkflowExecution, ref string) (store.WorkflowExecution, error) {
+	queue := []string{ref}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		dependents, err := e.workflow.dependents(current)
+		if err != nil {
+			return state, err
+		}
+		for _, sd := range dependents {
+			if _, ok := state.Steps[sd.Ref]; ok {
+				continue
+			}
+			state, err = e.executionStates.UpsertStep(ctx, &store.WorkflowExecutionStep{
+				ExecutionID: state.ExecutionID,
+				Ref:         sd.Ref,
+				Status:      store.StatusSkipped,
+			})
+			if err != nil {
+				return state, err
+			}
+			queue = append(queue, sd.Ref)
+		}
+	}
+	return state, nil
+}
+
 func (e *Engine) queueIfReady(state store.WorkflowExecution, step *step) {
 	// Check if all dependencies are completed for the current step
 	var waitingOnDependencies bool
@@ -687,9 +839,16 @@ func (e *Engine) finishExecution(ctx context.Context, executionID string, status
 
 	executionDuration := execState.FinishedAt.Sub(*execState.CreatedAt).Milliseconds()
 
+	if ch, ok := e.stepUpdatesChMap.get(executionID); ok {
+		setSpanStatus(ch.span, status, nil)
+	}
 	e.stepUpdatesChMap.remove(executionID)
 	metrics.updateTotalWorkflowsGauge(ctx, e.stepUpdatesChMap.len())
 	metrics.updateWorkflowExecutionLatencyGauge(ctx, executionDuration)
+	e.releaseExecution(ctx, executionID)
+	if e.isDraining() && e.stepUpdatesChMap.len() == 0 {
+		e.logger.Info("all the executions of the retired workflow version finished, its job can be deleted")
+	}
 	e.onExecutionFinished(executionID)
 	return nil
 }
@@ -717,6 +876,11 @@ func (e *Engine) worker(ctx context.Context) {
 
 			te := resp.Event
 
+			if e.isDraining() {
+				e.logger.With(tIDKey, te.ID).Debug("workflow version is retired; not executing")
+				continue
+			}
+
 			if te.ID == "" {
 				e.logger.With(tIDKey, te.TriggerType).Error("trigger event ID is empty; not executing")
 				continue
@@ -728,10 +892,11 @@ func (e *Engine) worker(ctx context.Context) {
 				continue
 			}
 
-			err = e.startExecution(ctx, executionID, resp.Event.Outputs)
-			if err != nil {
-				e.logger.With(eIDKey, executionID).Errorf("failed to start execution: %v", err)
-			}
+			e.admitExecution(ctx, pendingExecution{
+				executionID:    executionID,
+				triggerEventID: te.ID,
+				event:          resp.Event.Outputs,
+			})
 		case <-ctx.Done():
 			return
 		}
@@ -744,6 +909,15 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	l := e.logger.With(sRKey, msg.stepRef, eIDKey, msg.state.ExecutionID)
 	cma := e.cma.With(sRKey, msg.stepRef, eIDKey, msg.state.ExecutionID)
 
+	// Steps are executed within the context of their execution, so that they're aborted if it's cancelled.
+	execution, ok := e.stepUpdatesChMap.get(msg.state.ExecutionID)
+	if !ok {
+		l.Debug("execution is no longer in progress, dropping step request")
+		return
+	}
+	ctx, span := e.startStepSpan(execution.ctx, msg.state.ExecutionID, msg.stepRef)
+	defer span.End()
+
 	l.Debug("executing on a step event")
 	stepState := &store.WorkflowExecutionStep{
 		Outputs:     store.StepOutput{},
@@ -756,9 +930,17 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	if err != nil {
 		return
 	}
-	inputs, outputs, err := e.executeStep(ctx, msg)
+	inputs, outputs, attempts, err := e.executeStep(ctx, l, msg)
 	var stepStatus string
 	switch {
+	case errors.Is(err, errStepSkipped):
+		l.Info("step skipped since its condition doesn't hold")
+		cmErr := cma.SendLogAsCustomMessage("step skipped")
+		if cmErr != nil {
+			l.Errorf("failed to send custom message with msg: %s", "step skipped")
+		}
+		stepStatus = store.StatusSkipped
+		err = nil
 	case errors.Is(capabilities.ErrStopExecution, err):
 		lmsg := "step executed successfully with a termination"
 		l.Info(lmsg)
@@ -786,10 +968,17 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 		stepStatus = store.StatusCompleted
 	}
 
+	if stepStatus == store.StatusErrored {
+		setSpanStatus(span, stepStatus, err)
+	} else {
+		setSpanStatus(span, stepStatus, nil)
+	}
+
 	stepState.Status = stepStatus
 	stepState.Outputs.Value = outputs
 	stepState.Outputs.Err = err
 	stepState.Inputs = inputs
+	stepState.Attempts = attempts
 
 	// Let's try and emit the stepUpdate.
 	// If the context is canceled, we'll just drop the update.
@@ -832,27 +1021,37 @@ func (e *Engine) configForStep(ctx context.Context, executionID string, step *st
 		donID = e.localNode.WorkflowDON.ID
 	}
 
+	config := step.config
 	capConfig, err := e.registry.ConfigForCapability(ctx, ID, donID)
 	if err != nil {
 		e.logger.Warnw(fmt.Sprintf("could not retrieve config from remote registry: %s", err), "executionID", executionID, "capabilityID", ID)
-		return step.config, nil
-	}
-
-	if capConfig.DefaultConfig == nil {
-		return step.config, nil
+	} else if capConfig.DefaultConfig != nil {
+		// Merge the configs with registry config overriding the step config.  This is because
+		// some config fields are sensitive and could affect the safe running of the capability,
+		// so we avoid user provided values by overriding them with config from the capabilities registry.
+		config = merge(step.config, capConfig.DefaultConfig)
 	}
 
-	// Merge the configs with registry config overriding the step config.  This is because
-	// some config fields are sensitive and could affect the safe running of the capability,
-	// so we avoid user provided values by overriding them with config from the capabilities registry.
-	return merge(step.config, capConfig.DefaultConfig), nil
+	// Secrets are resolved last, and on every execution, so that they never end up in the workflow spec.
+	return e.resolveSecrets(config)
 }
 
-// executeStep executes the referenced capability within a step and returns the result.
-func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map, values.Value, error) {
+// executeStep executes the referenced capability within a step, according to the step's policy,
+// and returns the result along with the attempts made.
+func (e *Engine) executeStep(ctx context.Context, l logger.Logger, msg stepRequest) (*values.Map, values.Value, []store.StepAttempt, error) {
 	step, err := e.workflow.Vertex(msg.stepRef)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+
+	if step.flow.runIf != nil {
+		run, err := evaluateStepExpression(step.flow.runIf, msg.state)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to evaluate %s: %w", stepRunIfKey, err)
+		}
+		if !run {
+			return nil, nil, nil, errStepSkipped
+		}
 	}
 
 	var inputs any
@@ -864,17 +1063,17 @@ func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map,
 
 	i, err := exec.FindAndInterpolateAllKeys(inputs, msg.state)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	inputsMap, err := values.NewMap(i.(map[string]any))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	config, err := e.configForStep(ctx, msg.state.ExecutionID, step)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	tr := capabilities.CapabilityRequest{
@@ -891,13 +1090,54 @@ func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map,
 		},
 	}
 
-	e.metrics.incrementCapabilityInvocationCounter(ctx)
-	output, err := step.capability.Execute(ctx, tr)
-	if err != nil {
-		return inputsMap, nil, err
+	if step.flow.loop == nil {
+		output, attempts, err := e.executeWithPolicy(ctx, l, step, tr)
+		if err != nil {
+			return inputsMap, nil, attempts, err
+		}
+		return inputsMap, output.Value, attempts, nil
 	}
 
-	return inputsMap, output.Value, err
+	output, attempts, err := e.executeLoop(ctx, l, step, tr, msg.state)
+	return inputsMap, output, attempts, err
+}
+
+// executeLoop repeatedly executes the capability of a step until its loop's `until` expression holds,
+// returning the outputs of the last iteration along with the attempts made across all iterations.
+func (e *Engine) executeLoop(ctx context.Context, l logger.Logger, s *step, req capabilities.CapabilityRequest, state store.WorkflowExecution) (values.Value, []store.StepAttempt, error) {
+	var attempts []store.StepAttempt
+	for iteration := 1; ; iteration++ {
+		output, iterationAttempts, err := e.executeWithPolicy(ctx, l, s, req)
+		attempts = append(attempts, iterationAttempts...)
+		if err != nil {
+			return nil, attempts, err
+		}
+
+		// Expose the result of this iteration to the `until` expression under the step's own ref.
+		state.Steps[s.Ref] = &store.WorkflowExecutionStep{
+			ExecutionID: state.ExecutionID,
+			Ref:         s.Ref,
+			Inputs:      req.Inputs,
+			Outputs:     store.StepOutput{Value: output.Value},
+		}
+		done, err := evaluateStepExpression(s.flow.loop.until, state)
+		if err != nil {
+			return nil, attempts, fmt.Errorf("failed to evaluate until of %s: %w", stepLoopKey, err)
+		}
+		if done {
+			return output.Value, attempts, nil
+		}
+		if iteration >= s.flow.loop.maxIterations {
+			return nil, attempts, fmt.Errorf("%s did not finish within %d iterations", stepLoopKey, iteration)
+		}
+
+		l.Debugw("loop condition not met, iterating", "iteration", iteration, "maxIterations", s.flow.loop.maxIterations)
+		select {
+		case <-ctx.Done():
+			return nil, attempts, ctx.Err()
+		case <-e.clock.After(s.flow.loop.interval):
+		}
+	}
 }
 
 func (e *Engine) deregisterTrigger(ctx context.Context, t *triggerCapability, triggerIdx int) error {
@@ -929,9 +1169,9 @@ func (e *Engine) isWorkflowFullyProcessed(ctx context.Context, state store.Workf
 	// we need to first propagate the status of the errored status if it exists...
 	err := e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
 		stateStep, ok := state.Steps[s.Ref]
-		if !ok {
-			// The step not existing on the state means that it has not been processed yet.
-			// So ignore it.
+		if !ok || stateStep.Status == store.StatusStarted {
+			// The step not existing on the state, or being started when its execution is resumed,
+			// means that it has not been processed yet. So ignore it.
 			return nil
 		}
 		statuses[s.Ref] = stateStep.Status
@@ -1002,6 +1242,7 @@ func (e *Engine) isWorkflowFullyProcessed(ctx context.Context, state store.Workf
 
 	// The `errored` status has precedence over the other statuses to be returned, based on occurrence.
 	// Status precedence: `errored` -> `timed_out` -> `completed_early_exit` -> `completed`.
+	// Skipped steps don't affect the status of the execution.
 	if hasErrored {
 		return workflowProcessed, store.StatusErrored, nil
 	}
@@ -1018,21 +1259,24 @@ func (e *Engine) Close() error {
 	return e.StopOnce("Engine", func() error {
 		e.logger.Info("shutting down engine")
 		ctx := context.Background()
+		if e.engineRegistry != nil {
+			e.engineRegistry.remove(e.workflow.id, e)
+		}
 		// To shut down the engine, we'll start by deregistering
 		// any triggers to ensure no new executions are triggered,
 		// then we'll close down any background goroutines,
 		// and finally, we'll deregister any workflow steps.
-		for idx, t := range e.workflow.triggers {
-			err := e.deregisterTrigger(ctx, t, idx)
-			if err != nil {
-				return err
-			}
+		e.triggersMu.Lock()
+		err := e.deregisterTriggers(ctx)
+		e.triggersMu.Unlock()
+		if err != nil {
+			return err
 		}
 
 		close(e.stopCh)
 		e.wg.Wait()
 
-		err := e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		err = e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
 			if s.Ref == workflows.KeywordTrigger {
 				return nil
 			}
@@ -1077,6 +1321,7 @@ type Config struct {
 	WorkflowID           string
 	WorkflowOwner        string
 	WorkflowName         string
+	WorkflowVersion      string
 	Lggr                 logger.Logger
 	Registry             core.CapabilitiesRegistry
 	MaxWorkerLimit       int
@@ -1087,6 +1332,21 @@ type Config struct {
 	Config               []byte
 	Binary               []byte
 
+	// MaxConcurrentExecutions limits the number of executions in progress at once; zero means unlimited.
+	MaxConcurrentExecutions int
+	// ConcurrencyMode controls what happens to trigger events received while
+	// MaxConcurrentExecutions executions are in progress.
+	ConcurrencyMode job.WorkflowConcurrencyMode
+	// EngineRegistry, if set, makes the engine available to operator actions while it's running.
+	EngineRegistry *EngineRegistry
+	// Secrets resolves the `$(secrets.NAME)` placeholders in step configs. If nil, they're left as is.
+	Secrets SecretsStore
+	// AllowedSecrets are the names of the Secrets the workflow may reference. Steps referencing any other one error.
+	AllowedSecrets []string
+	// Retired starts the engine draining: it doesn't register the workflow's triggers,
+	// and only runs the executions in progress to completion.
+	Retired bool
+
 	// For testing purposes only
 	maxRetries          int
 	retryMs             int
@@ -1159,6 +1419,7 @@ func NewEngine(cfg Config) (engine *Engine, err error) {
 	workflow.id = cfg.WorkflowID
 	workflow.owner = cfg.WorkflowOwner
 	workflow.name = hex.EncodeToString([]byte(cfg.WorkflowName))
+	workflow.version = cfg.WorkflowVersion
 
 	engine = &Engine{
 		logger:   cfg.Lggr.Named("WorkflowEngine").With("workflowID", cfg.WorkflowID),
@@ -1173,6 +1434,10 @@ func NewEngine(cfg Config) (engine *Engine, err error) {
 		executionStates:      cfg.Store,
 		pendingStepRequests:  make(chan stepRequest, cfg.QueueSize),
 		stepUpdatesChMap:     stepUpdateManager{m: map[string]stepUpdateChannel{}},
+		executionLimiter:     newExecutionLimiter(cfg.MaxConcurrentExecutions, cfg.ConcurrencyMode, cfg.QueueSize),
+		engineRegistry:       cfg.EngineRegistry,
+		secrets:              newAllowedSecrets(cfg.Secrets, cfg.AllowedSecrets),
+		draining:             cfg.Retired,
 		triggerEvents:        make(chan capabilities.TriggerResponse),
 		stopCh:               make(chan struct{}),
 		newWorkerTimeout:     cfg.NewWorkerTimeout,
@@ -0,0 +1,50 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrEngineNotFound is returned when no engine is running the requested workflow on this node.
+var ErrEngineNotFound = errors.New("workflow is not running on this node")
+
+// EngineRegistry keeps track of the running engines, by workflow ID,
+// so that operator actions can reach the executions of a workflow.
+type EngineRegistry struct {
+	mu      sync.RWMutex
+	engines map[string]*Engine
+}
+
+func NewEngineRegistry() *EngineRegistry {
+	return &EngineRegistry{engines: map[string]*Engine{}}
+}
+
+// Get returns the engine running the given workflow.
+func (r *EngineRegistry) Get(workflowID string) (*Engine, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	engine, ok := r.engines[workflowID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrEngineNotFound, workflowID)
+	}
+	return engine, nil
+}
+
+func (r *EngineRegistry) add(workflowID string, engine *Engine) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.engines[workflowID]; ok {
+		return fmt.Errorf("an engine is already running workflow %s", workflowID)
+	}
+	r.engines[workflowID] = engine
+	return nil
+}
+
+func (r *EngineRegistry) remove(workflowID string, engine *Engine) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.engines[workflowID] == engine {
+		delete(r.engines, workflowID)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -485,6 +486,346 @@ func TestEngine_ErrorsTheWorkflowIfAStepErrors(t *testing.T) {
 	assert.Equal(t, state.Steps["evm_median"].Status, store.StatusErrored)
 }
 
+const retryingWorkflow = `
+triggers:
+  - id: "mercury-trigger@1.0.0"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+
+consensus:
+  - id: "offchain_reporting@1.0.0"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+      stepPolicy:
+        timeout: "%s"
+        maxAttempts: 3
+        initialBackoff: "10ms"
+        retryOn: %s
+
+targets:
+  - id: "write_polygon-testnet-mumbai@1.0.0"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+      params: ["$(report)"]
+      abi: "receive(report bytes)"
+`
+
+func TestEngine_RetriesFailedSteps(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	consensus := mockConsensus("")
+	var calls int
+	succeed := consensus.transform
+	consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		assert.NotContains(t, req.Config.Underlying, stepPolicyKey)
+		calls++
+		if calls < 3 {
+			return capabilities.CapabilityResponse{}, errors.New("consensus unavailable")
+		}
+		return succeed(req)
+	}
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, fmt.Sprintf(retryingWorkflow, "1s", `["error"]`), func(c *Config) {
+		c.clock = clockwork.NewRealClock()
+	})
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	attempts := state.Steps["evm_median"].Attempts
+	require.Len(t, attempts, 3)
+	assert.Equal(t, "consensus unavailable", attempts[0].Err)
+	assert.Equal(t, "consensus unavailable", attempts[1].Err)
+	assert.Empty(t, attempts[2].Err)
+	assert.GreaterOrEqual(t, attempts[1].StartedAt.Sub(attempts[0].FinishedAt), 10*time.Millisecond)
+	assert.GreaterOrEqual(t, attempts[2].StartedAt.Sub(attempts[1].FinishedAt), 20*time.Millisecond, "backoff doubles")
+}
+
+func TestEngine_TimesOutSteps(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, &blockingCapability{mockConsensus("")}))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	// timeouts are not retried
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, fmt.Sprintf(retryingWorkflow, "50ms", `["error"]`), func(c *Config) {
+		c.clock = clockwork.NewRealClock()
+	})
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusErrored, state.Status)
+	step := state.Steps["evm_median"]
+	assert.Equal(t, store.StatusErrored, step.Status)
+	assert.ErrorContains(t, step.Outputs.Err, "step did not finish within 50ms")
+	require.Len(t, step.Attempts, 1)
+}
+
+// blockingCapability blocks until the request is cancelled.
+type blockingCapability struct {
+	*mockCapability
+}
+
+func (b *blockingCapability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	<-ctx.Done()
+	return capabilities.CapabilityResponse{}, ctx.Err()
+}
+
+const conditionalWorkflow = `
+triggers:
+  - id: "mercury-trigger@1.0.0"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+
+actions:
+  - id: "read_chain_action@1.0.0"
+    ref: "read_chain_action"
+    inputs:
+      action:
+        - "$(trigger.outputs)"
+    config:
+      loop:
+        until: "read_chain_action.outputs.output == foo"
+        maxIterations: %d
+        interval: "10ms"
+
+consensus:
+  - id: "offchain_reporting@1.0.0"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+        - "$(read_chain_action.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+      runIf: "read_chain_action.outputs.output == %s"
+
+targets:
+  - id: "write_polygon-testnet-mumbai@1.0.0"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+      params: ["$(report)"]
+      abi: "receive(report bytes)"
+`
+
+func TestEngine_ConditionalSteps(t *testing.T) {
+	t.Parallel()
+
+	t.Run("condition holds", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		reg := coreCap.NewRegistry(logger.TestLogger(t))
+		trigger, _ := mockTrigger(t)
+		require.NoError(t, reg.Add(ctx, trigger))
+		action, _ := mockAction(t)
+		require.NoError(t, reg.Add(ctx, action))
+		consensus := mockConsensus("")
+		execute := consensus.transform
+		consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			assert.NotContains(t, req.Config.Underlying, stepRunIfKey)
+			return execute(req)
+		}
+		require.NoError(t, reg.Add(ctx, consensus))
+		require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+		eng, hooks := newTestEngineWithYAMLSpec(t, reg, fmt.Sprintf(conditionalWorkflow, 1, "foo"))
+		servicetest.Run(t, eng)
+
+		eid := getExecutionId(t, eng, hooks)
+		state, err := eng.executionStates.Get(ctx, eid)
+		require.NoError(t, err)
+
+		assert.Equal(t, store.StatusCompleted, state.Status)
+		assert.Equal(t, store.StatusCompleted, state.Steps["evm_median"].Status)
+		assert.Equal(t, store.StatusCompleted, state.Steps["write_polygon-testnet-mumbai@1.0.0"].Status)
+	})
+
+	t.Run("condition does not hold", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		reg := coreCap.NewRegistry(logger.TestLogger(t))
+		trigger, _ := mockTrigger(t)
+		require.NoError(t, reg.Add(ctx, trigger))
+		action, _ := mockAction(t)
+		require.NoError(t, reg.Add(ctx, action))
+		consensus := mockConsensus("")
+		require.NoError(t, reg.Add(ctx, consensus))
+		target := mockTarget("")
+		require.NoError(t, reg.Add(ctx, target))
+
+		eng, hooks := newTestEngineWithYAMLSpec(t, reg, fmt.Sprintf(conditionalWorkflow, 1, "bar"))
+		servicetest.Run(t, eng)
+
+		eid := getExecutionId(t, eng, hooks)
+		state, err := eng.executionStates.Get(ctx, eid)
+		require.NoError(t, err)
+
+		assert.Equal(t, store.StatusCompleted, state.Status)
+		assert.Equal(t, store.StatusCompleted, state.Steps["read_chain_action"].Status)
+		// skipping a step skips every step downstream of it
+		assert.Equal(t, store.StatusSkipped, state.Steps["evm_median"].Status)
+		assert.Equal(t, store.StatusSkipped, state.Steps["write_polygon-testnet-mumbai@1.0.0"].Status)
+		assert.Empty(t, consensus.response)
+		assert.Empty(t, target.response)
+	})
+}
+
+func TestEngine_LoopingSteps(t *testing.T) {
+	t.Parallel()
+
+	newAction := func(t *testing.T) *mockCapability {
+		action, _ := mockAction(t)
+		execute := action.transform
+		var calls int
+		action.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			assert.NotContains(t, req.Config.Underlying, stepLoopKey)
+			calls++
+			if calls < 3 {
+				outputs, err := values.NewMap(map[string]any{"output": "pending"})
+				return capabilities.CapabilityResponse{Value: outputs}, err
+			}
+			return execute(req)
+		}
+		return action
+	}
+
+	t.Run("until holds", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		reg := coreCap.NewRegistry(logger.TestLogger(t))
+		trigger, _ := mockTrigger(t)
+		require.NoError(t, reg.Add(ctx, trigger))
+		require.NoError(t, reg.Add(ctx, newAction(t)))
+		require.NoError(t, reg.Add(ctx, mockConsensus("")))
+		require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+		eng, hooks := newTestEngineWithYAMLSpec(t, reg, fmt.Sprintf(conditionalWorkflow, 3, "foo"), func(c *Config) {
+			c.clock = clockwork.NewRealClock()
+		})
+		servicetest.Run(t, eng)
+
+		eid := getExecutionId(t, eng, hooks)
+		state, err := eng.executionStates.Get(ctx, eid)
+		require.NoError(t, err)
+
+		assert.Equal(t, store.StatusCompleted, state.Status)
+		step := state.Steps["read_chain_action"]
+		assert.Equal(t, store.StatusCompleted, step.Status)
+		require.Len(t, step.Attempts, 3)
+		assert.GreaterOrEqual(t, step.Attempts[1].StartedAt.Sub(step.Attempts[0].FinishedAt), 10*time.Millisecond)
+		outputs, err := step.Outputs.Value.Unwrap()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"output": "foo"}, outputs)
+	})
+
+	t.Run("iterations exhausted", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		reg := coreCap.NewRegistry(logger.TestLogger(t))
+		trigger, _ := mockTrigger(t)
+		require.NoError(t, reg.Add(ctx, trigger))
+		require.NoError(t, reg.Add(ctx, newAction(t)))
+		require.NoError(t, reg.Add(ctx, mockConsensus("")))
+		require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+		eng, hooks := newTestEngineWithYAMLSpec(t, reg, fmt.Sprintf(conditionalWorkflow, 2, "foo"), func(c *Config) {
+			c.clock = clockwork.NewRealClock()
+		})
+		servicetest.Run(t, eng)
+
+		eid := getExecutionId(t, eng, hooks)
+		state, err := eng.executionStates.Get(ctx, eid)
+		require.NoError(t, err)
+
+		assert.Equal(t, store.StatusErrored, state.Status)
+		step := state.Steps["read_chain_action"]
+		assert.Equal(t, store.StatusErrored, step.Status)
+		assert.ErrorContains(t, step.Outputs.Err, "loop did not finish within 2 iterations")
+		assert.Len(t, step.Attempts, 2)
+	})
+}
+
+func TestEngine_CancelsPreviousExecutions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, tr := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	consensus := &blockingOnceCapability{mockCapability: mockConsensus(""), started: make(chan struct{})}
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.MaxConcurrentExecutions = 1
+		c.ConcurrencyMode = job.WorkflowConcurrencyModeCancelPrevious
+	})
+	servicetest.Run(t, eng)
+
+	// the first execution blocks until it's cancelled by the second one
+	<-consensus.started
+	second := tr
+	second.Event.ID = "second-event"
+	trigger.(*mockTriggerCapability).ch <- second
+
+	cancelledID := getExecutionId(t, eng, hooks)
+	expectedID, err := generateExecutionID(testWorkflowId, tr.Event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, expectedID, cancelledID)
+	state, err := eng.executionStates.Get(ctx, cancelledID)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCancelled, state.Status)
+
+	completedID := getExecutionId(t, eng, hooks)
+	expectedID, err = generateExecutionID(testWorkflowId, second.Event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, expectedID, completedID)
+	state, err = eng.executionStates.Get(ctx, completedID)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+}
+
+// blockingOnceCapability blocks its first execution until the request is cancelled.
+type blockingOnceCapability struct {
+	*mockCapability
+	started chan struct{}
+	once    sync.Once
+}
+
+func (b *blockingOnceCapability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	var first bool
+	b.once.Do(func() { first = true })
+	if first {
+		close(b.started)
+		<-ctx.Done()
+		return capabilities.CapabilityResponse{}, ctx.Err()
+	}
+	return b.mockCapability.Execute(ctx, req)
+}
+
 func TestEngine_GracefulEarlyTermination(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -0,0 +1,128 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+var (
+	// ErrExecutionNotResumable is returned when resuming an execution which didn't fail or time out.
+	ErrExecutionNotResumable = errors.New("only errored or timed out executions can be resumed")
+	// ErrExecutionInProgress is returned when acting on an execution which is still in progress.
+	ErrExecutionInProgress = errors.New("execution is in progress")
+)
+
+// ResumeExecution restarts a failed or timed out execution from the steps which didn't complete,
+// reusing the stored outputs of the completed ones.
+func (e *Engine) ResumeExecution(_ context.Context, executionID string) error {
+	ctx, cancel := e.stopCh.NewCtx()
+	defer cancel()
+	execution, err := e.executionStates.Get(ctx, executionID)
+	if err != nil {
+		return err
+	}
+	if execution.WorkflowID != e.workflow.id {
+		return fmt.Errorf("execution %s does not belong to workflow %s", executionID, e.workflow.id)
+	}
+	if execution.Status != store.StatusErrored && execution.Status != store.StatusTimeout {
+		return fmt.Errorf("%w: execution %s is %s", ErrExecutionNotResumable, executionID, execution.Status)
+	}
+
+	// The execution outlives the request, so it isn't cancelled along with it.
+	ch := newStepUpdateChannel(context.WithoutCancel(e.startExecutionSpan(ctx, executionID)), executionID)
+	if added := e.stepUpdatesChMap.add(executionID, ch); !added {
+		ch.cancel(nil)
+		ch.span.End()
+		return fmt.Errorf("%w: %s", ErrExecutionInProgress, executionID)
+	}
+
+	var failed []*step
+	for _, s := range execution.Steps {
+		if s.Status != store.StatusErrored && s.Status != store.StatusTimeout {
+			continue
+		}
+		vertex, verr := e.workflow.Graph.Vertex(s.Ref)
+		if verr != nil {
+			err = verr
+			break
+		}
+		// Failed steps are reset so that they're pending again, and are executed from scratch.
+		execution, err = e.executionStates.UpsertStep(ctx, &store.WorkflowExecutionStep{
+			ExecutionID: executionID,
+			Ref:         s.Ref,
+			Status:      store.StatusStarted,
+		})
+		if err != nil {
+			break
+		}
+		failed = append(failed, vertex)
+	}
+	if err == nil {
+		err = e.executionStates.UpdateStatus(ctx, executionID, store.StatusStarted)
+	}
+	if err != nil {
+		e.stepUpdatesChMap.remove(executionID)
+		ch.span.End()
+		return err
+	}
+
+	e.logger.With(eIDKey, executionID).Info("resuming execution")
+	// Resumed executions count towards the workflow's concurrency limit.
+	e.executionLimiter.track(executionID)
+	// The resumed execution gets the full execution duration again.
+	now := e.clock.Now()
+	e.runStepUpdateLoop(ch, &now)
+
+	for _, s := range failed {
+		e.queueIfReady(execution, s)
+	}
+	return nil
+}
+
+// RerunExecution starts a new execution of the workflow from scratch, with the trigger event of the given one.
+// It returns the ID of the new execution.
+func (e *Engine) RerunExecution(_ context.Context, executionID string) (string, error) {
+	ctx, cancel := e.stopCh.NewCtx()
+	defer cancel()
+	execution, err := e.executionStates.Get(ctx, executionID)
+	if err != nil {
+		return "", err
+	}
+	if execution.WorkflowID != e.workflow.id {
+		return "", fmt.Errorf("execution %s does not belong to workflow %s", executionID, e.workflow.id)
+	}
+	if execution.Status == store.StatusStarted {
+		return "", fmt.Errorf("%w: %s", ErrExecutionInProgress, executionID)
+	}
+
+	trigger, ok := execution.Steps[workflows.KeywordTrigger]
+	if !ok {
+		return "", fmt.Errorf("execution %s has no trigger event", executionID)
+	}
+	event, ok := trigger.Outputs.Value.(*values.Map)
+	if !ok {
+		return "", fmt.Errorf("execution %s has an invalid trigger event of type %T", executionID, trigger.Outputs.Value)
+	}
+
+	// The trigger event ID is kept, but the execution needs a new ID since it's derived from it.
+	rerunID, err := generateExecutionID(e.workflow.id, uuid.NewString())
+	if err != nil {
+		return "", err
+	}
+
+	e.logger.With(eIDKey, rerunID, "rerunExecutionID", executionID).Info("re-running execution")
+	e.executionLimiter.track(rerunID)
+	if err = e.startExecution(ctx, rerunID, execution.TriggerEventID, event); err != nil {
+		e.releaseExecution(ctx, rerunID)
+		return "", err
+	}
+	return rerunID, nil
+}
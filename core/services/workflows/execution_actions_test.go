@@ -0,0 +1,137 @@
+package workflows
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const targetRef = "write_polygon-testnet-mumbai@1.0.0"
+
+// newFlakyTargetEngine runs an engine for simpleWorkflow whose target fails on its first call,
+// returning the counts of consensus and target calls.
+func newFlakyTargetEngine(t *testing.T) (*Engine, *testHooks, *EngineRegistry, *atomic.Int32, *atomic.Int32) {
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	var consensusCalls, targetCalls atomic.Int32
+	consensus := mockConsensus("")
+	succeed := consensus.transform
+	consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		consensusCalls.Add(1)
+		return succeed(req)
+	}
+	require.NoError(t, reg.Add(ctx, consensus))
+	target := mockTarget("")
+	write := target.transform
+	target.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		if targetCalls.Add(1) == 1 {
+			return capabilities.CapabilityResponse{}, errors.New("transmission failed")
+		}
+		return write(req)
+	}
+	require.NoError(t, reg.Add(ctx, target))
+
+	engines := NewEngineRegistry()
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.Store = store.NewMemoryStore(c.clock)
+		c.EngineRegistry = engines
+	})
+	servicetest.Run(t, eng)
+	return eng, hooks, engines, &consensusCalls, &targetCalls
+}
+
+func TestEngine_ResumeExecution(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	eng, hooks, engines, consensusCalls, targetCalls := newFlakyTargetEngine(t)
+
+	running, err := engines.Get(testWorkflowId)
+	require.NoError(t, err)
+	assert.Equal(t, eng, running)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	require.Equal(t, store.StatusErrored, state.Status)
+	require.Equal(t, store.StatusErrored, state.Steps[targetRef].Status)
+
+	require.NoError(t, eng.ResumeExecution(ctx, eid))
+	assert.Equal(t, eid, getExecutionId(t, eng, hooks))
+
+	state, err = eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.Equal(t, store.StatusCompleted, state.Steps[targetRef].Status)
+	assert.Equal(t, int32(1), consensusCalls.Load(), "completed steps aren't executed again")
+	assert.Equal(t, int32(2), targetCalls.Load())
+
+	err = eng.ResumeExecution(ctx, eid)
+	assert.ErrorIs(t, err, ErrExecutionNotResumable)
+	assert.ErrorContains(t, eng.ResumeExecution(ctx, "unknown"), "not found")
+}
+
+func TestEngine_RerunExecution(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	eng, hooks, _, consensusCalls, targetCalls := newFlakyTargetEngine(t)
+
+	eid := getExecutionId(t, eng, hooks)
+	original, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	require.Equal(t, store.StatusErrored, original.Status)
+
+	rerunID, err := eng.RerunExecution(ctx, eid)
+	require.NoError(t, err)
+	assert.NotEqual(t, eid, rerunID)
+	assert.Equal(t, rerunID, getExecutionId(t, eng, hooks))
+
+	rerun, err := eng.executionStates.Get(ctx, rerunID)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, rerun.Status)
+	assert.Equal(t, original.TriggerEventID, rerun.TriggerEventID)
+	assert.Equal(t, original.Steps["trigger"].Outputs.Value, rerun.Steps["trigger"].Outputs.Value)
+	assert.Equal(t, int32(2), consensusCalls.Load(), "all steps are executed again")
+	assert.Equal(t, int32(2), targetCalls.Load())
+
+	// the original execution is left as is
+	original, err = eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusErrored, original.Status)
+}
+
+func TestEngineRegistry(t *testing.T) {
+	t.Parallel()
+	engines := NewEngineRegistry()
+	_, err := engines.Get("workflow")
+	assert.ErrorIs(t, err, ErrEngineNotFound)
+
+	eng := &Engine{}
+	require.NoError(t, engines.add("workflow", eng))
+	assert.Error(t, engines.add("workflow", &Engine{}))
+	got, err := engines.Get("workflow")
+	require.NoError(t, err)
+	assert.Same(t, eng, got)
+
+	// only the registered engine can remove itself
+	engines.remove("workflow", &Engine{})
+	_, err = engines.Get("workflow")
+	require.NoError(t, err)
+	engines.remove("workflow", eng)
+	_, err = engines.Get("workflow")
+	assert.ErrorIs(t, err, ErrEngineNotFound)
+}
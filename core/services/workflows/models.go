@@ -20,9 +20,10 @@ import (
 // treated differently due to their nature of being the starting
 // point of a workflow.
 type workflow struct {
-	id    string
-	owner string
-	name  string
+	id      string
+	owner   string
+	name    string
+	version string
 	graph.Graph[string, *step]
 
 	triggers []*triggerCapability
@@ -85,6 +86,8 @@ type step struct {
 	capability capabilities.ExecutableCapability
 	info       capabilities.CapabilityInfo
 	config     *values.Map
+	policy     stepPolicy
+	flow       stepFlow
 }
 
 type triggerCapability struct {
@@ -142,7 +145,16 @@ func createWorkflow(wf2 *workflows.DependencyGraph) (*workflow, error) {
 		if innerErr != nil {
 			return nil, fmt.Errorf("failed to retrieve vertex for %s: %w", vertexRef, innerErr)
 		}
-		innerErr = g.AddVertex(&step{Vertex: *v})
+		s := &step{Vertex: *v}
+		s.policy, s.Config, innerErr = parseStepPolicy(v.Config)
+		if innerErr != nil {
+			return nil, fmt.Errorf("failed to parse policy of step %s: %w", vertexRef, innerErr)
+		}
+		s.flow, s.Config, innerErr = parseStepFlow(v.Ref, v.Dependencies, s.Config)
+		if innerErr != nil {
+			return nil, fmt.Errorf("failed to parse flow of step %s: %w", vertexRef, innerErr)
+		}
+		innerErr = g.AddVertex(s)
 		if innerErr != nil {
 			return nil, fmt.Errorf("failed to add vertex to executable workflow %s: %w", vertexRef, innerErr)
 		}
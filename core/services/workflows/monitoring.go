@@ -15,6 +15,8 @@ var workflowsRunningGauge metric.Int64Gauge
 var capabilityInvocationCounter metric.Int64Counter
 var workflowExecutionLatencyGauge metric.Int64Gauge //ms
 var workflowStepErrorCounter metric.Int64Counter
+var droppedExecutionsCounter metric.Int64Counter
+var coalescedExecutionsCounter metric.Int64Counter
 
 func initMonitoringResources() (err error) {
 	registerTriggerFailureCounter, err = beholder.GetMeter().Int64Counter("RegisterTriggerFailure")
@@ -42,6 +44,16 @@ func initMonitoringResources() (err error) {
 		return fmt.Errorf("failed to register workflow step error counter: %w", err)
 	}
 
+	droppedExecutionsCounter, err = beholder.GetMeter().Int64Counter("WorkflowExecutionDropped")
+	if err != nil {
+		return fmt.Errorf("failed to register dropped executions counter: %w", err)
+	}
+
+	coalescedExecutionsCounter, err = beholder.GetMeter().Int64Counter("WorkflowExecutionCoalesced")
+	if err != nil {
+		return fmt.Errorf("failed to register coalesced executions counter: %w", err)
+	}
+
 	return nil
 }
 
@@ -75,6 +87,16 @@ func (c workflowsMetricLabeler) incrementTotalWorkflowStepErrorsCounter(ctx cont
 	workflowStepErrorCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
 }
 
+func (c workflowsMetricLabeler) incrementDroppedExecutionsCounter(ctx context.Context) {
+	otelLabels := monitoring.KvMapToOtelAttributes(c.Labels)
+	droppedExecutionsCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
+}
+
+func (c workflowsMetricLabeler) incrementCoalescedExecutionsCounter(ctx context.Context) {
+	otelLabels := monitoring.KvMapToOtelAttributes(c.Labels)
+	coalescedExecutionsCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
+}
+
 func (c workflowsMetricLabeler) updateTotalWorkflowsGauge(ctx context.Context, val int64) {
 	otelLabels := monitoring.KvMapToOtelAttributes(c.Labels)
 	workflowsRunningGauge.Record(ctx, val, metric.WithAttributes(otelLabels...))
@@ -90,6 +112,7 @@ const (
 	woIDKey = "workflowOwner"
 	sIDKey  = "stepID"
 	sRKey   = "stepRef"
+	dIDKey  = "donID"
 )
 
 var orderedLabelKeys = []string{sRKey, sIDKey, tIDKey, cIDKey, eIDKey, wIDKey}
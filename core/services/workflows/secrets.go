@@ -0,0 +1,102 @@
+package workflows
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowsecret"
+)
+
+// secretRe matches the placeholders referencing workflow secrets in step configs, e.g. `$(secrets.API_KEY)`.
+// They may make up a whole config value, or part of one, as in `Bearer $(secrets.API_KEY)`.
+var secretRe = regexp.MustCompile(`\$\(secrets\.([A-Za-z0-9_]+)\)`)
+
+// SecretsStore holds the secrets which step configs reference by name.
+type SecretsStore interface {
+	Get(name string) (workflowsecret.Secret, error)
+}
+
+// allowedSecrets scopes the SecretsStore of the node to the secrets a workflow is allowed to reference.
+type allowedSecrets struct {
+	secrets SecretsStore
+	names   map[string]bool
+}
+
+// newAllowedSecrets returns nil if secrets is nil, for the placeholders to be left as is.
+func newAllowedSecrets(secrets SecretsStore, names []string) SecretsStore {
+	if secrets == nil {
+		return nil
+	}
+	a := allowedSecrets{secrets: secrets, names: make(map[string]bool, len(names))}
+	for _, name := range names {
+		a.names[name] = true
+	}
+	return a
+}
+
+func (a allowedSecrets) Get(name string) (workflowsecret.Secret, error) {
+	if !a.names[name] {
+		return workflowsecret.Secret{}, fmt.Errorf("secret %s is not in the allowed secrets of the workflow", name)
+	}
+	return a.secrets.Get(name)
+}
+
+// resolveSecrets returns a copy of the config of a step, with the secrets it references substituted in.
+// The resolved config is only handed to the capability: it's neither logged nor persisted.
+func (e *Engine) resolveSecrets(config *values.Map) (*values.Map, error) {
+	if e.secrets == nil || config == nil {
+		return config, nil
+	}
+	resolved, err := resolveSecretsIn(config, e.secrets)
+	if err != nil {
+		return nil, err
+	}
+	return resolved.(*values.Map), nil
+}
+
+func resolveSecretsIn(v values.Value, secrets SecretsStore) (values.Value, error) {
+	switch tv := v.(type) {
+	case *values.String:
+		var err error
+		resolved := secretRe.ReplaceAllStringFunc(tv.Underlying, func(placeholder string) string {
+			name := secretRe.FindStringSubmatch(placeholder)[1]
+			secret, serr := secrets.Get(name)
+			if serr != nil {
+				err = fmt.Errorf("failed to resolve secret %s: %w", name, serr)
+				return placeholder
+			}
+			return secret.Value()
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resolved == tv.Underlying {
+			return tv, nil
+		}
+		return values.NewString(resolved), nil
+	case *values.Map:
+		m := &values.Map{Underlying: make(map[string]values.Value, len(tv.Underlying))}
+		for k, el := range tv.Underlying {
+			resolved, err := resolveSecretsIn(el, secrets)
+			if err != nil {
+				return nil, err
+			}
+			m.Underlying[k] = resolved
+		}
+		return m, nil
+	case *values.List:
+		l := &values.List{Underlying: make([]values.Value, len(tv.Underlying))}
+		for i, el := range tv.Underlying {
+			resolved, err := resolveSecretsIn(el, secrets)
+			if err != nil {
+				return nil, err
+			}
+			l.Underlying[i] = resolved
+		}
+		return l, nil
+	default:
+		return v, nil
+	}
+}
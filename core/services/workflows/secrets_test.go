@@ -0,0 +1,121 @@
+package workflows
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowsecret"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+type testSecretsStore map[string]string
+
+func (s testSecretsStore) Get(name string) (workflowsecret.Secret, error) {
+	value, ok := s[name]
+	if !ok {
+		return workflowsecret.Secret{}, keystore.KeyNotFoundError{ID: name, KeyType: "workflow secret"}
+	}
+	return workflowsecret.New(name, value)
+}
+
+// secretWorkflow is simpleWorkflow with a target config referencing a secret.
+var secretWorkflow = strings.Replace(simpleWorkflow, `      abi: "receive(report bytes)"`, `      abi: "receive(report bytes)"
+      api_key: "$(secrets.API_KEY)"
+      headers:
+        - "Authorization: Bearer $(secrets.API_KEY)"`, 1)
+
+func newSecretTargetEngine(t *testing.T, secrets SecretsStore, allowed ...string) (*Engine, *testHooks, chan *values.Map) {
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	configs := make(chan *values.Map, 1)
+	target := mockTarget("")
+	write := target.transform
+	target.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		configs <- req.Config
+		return write(req)
+	}
+	require.NoError(t, reg.Add(ctx, target))
+
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, secretWorkflow, func(c *Config) {
+		c.Store = store.NewMemoryStore(c.clock)
+		c.Secrets = secrets
+		c.AllowedSecrets = allowed
+	})
+	servicetest.Run(t, eng)
+	return eng, hooks, configs
+}
+
+func TestEngine_ResolvesSecretsInStepConfigs(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	eng, hooks, configs := newSecretTargetEngine(t, testSecretsStore{"API_KEY": "hunter2"}, "API_KEY")
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+
+	config, err := (<-configs).Unwrap()
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", config.(map[string]any)["api_key"])
+	assert.Equal(t, []any{"Authorization: Bearer hunter2"}, config.(map[string]any)["headers"])
+
+	// the step config keeps the placeholders, so the secret is only ever held by the request
+	s, err := eng.workflow.Vertex(targetRef)
+	require.NoError(t, err)
+	assert.Equal(t, values.NewString("$(secrets.API_KEY)"), s.config.Underlying["api_key"])
+}
+
+func TestEngine_ErrorsTheStepIfASecretIsMissing(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	eng, hooks, configs := newSecretTargetEngine(t, testSecretsStore{}, "API_KEY")
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusErrored, state.Status)
+	assert.Equal(t, store.StatusErrored, state.Steps[targetRef].Status)
+	assert.Empty(t, configs, "the target isn't called")
+}
+
+func TestEngine_ErrorsTheStepIfASecretIsNotAllowed(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	eng, hooks, configs := newSecretTargetEngine(t, testSecretsStore{"API_KEY": "hunter2", "OTHER_KEY": "hunter3"}, "OTHER_KEY")
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusErrored, state.Status)
+	assert.Equal(t, store.StatusErrored, state.Steps[targetRef].Status)
+	assert.Empty(t, configs, "the target isn't called")
+}
+
+func TestAllowedSecrets(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, newAllowedSecrets(nil, []string{"API_KEY"}))
+
+	secrets := newAllowedSecrets(testSecretsStore{"API_KEY": "hunter2", "OTHER_KEY": "hunter3"}, []string{"API_KEY"})
+	secret, err := secrets.Get("API_KEY")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", secret.Value())
+	_, err = secrets.Get("OTHER_KEY")
+	require.ErrorContains(t, err, "secret OTHER_KEY is not in the allowed secrets of the workflow")
+}
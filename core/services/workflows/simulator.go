@@ -0,0 +1,416 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/compute"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// SimulationFixtures describe the world a workflow is simulated in: the events
+// emitted by its triggers, and canned responses of the capabilities of its steps.
+type SimulationFixtures struct {
+	// Events are emitted one at a time, each execution finishing before the next event is emitted.
+	Events []SimulatedTriggerEvent `json:"events"`
+	// Steps are keyed by step ref.
+	Steps map[string]SimulatedStepResponse `json:"steps"`
+}
+
+// SimulatedTriggerEvent is an event emitted by the trigger with the given capability ID,
+// which may be omitted for workflows with a single trigger.
+type SimulatedTriggerEvent struct {
+	TriggerID string         `json:"triggerID,omitempty"`
+	ID        string         `json:"id"`
+	Outputs   map[string]any `json:"outputs"`
+}
+
+// SimulatedStepResponse is returned in place of executing the capability of a step.
+// Steps without one echo their inputs as outputs, except for targets which return nothing.
+//
+// The custom compute capability of WASM workflows is executed for real, fetching aside.
+type SimulatedStepResponse struct {
+	Outputs map[string]any `json:"outputs"`
+	Error   string         `json:"error,omitempty"`
+}
+
+type SimulationConfig struct {
+	Lggr          logger.Logger
+	Workflow      sdk.WorkflowSpec
+	WorkflowID    string
+	WorkflowOwner string
+	WorkflowName  string
+	Config        []byte
+	Binary        []byte
+	Fixtures      SimulationFixtures
+}
+
+// SimulationResult is the trace of a simulation.
+type SimulationResult struct {
+	// WorkflowID is computed from the workflow like when it's added as a job, and makes up the IDs of the executions.
+	WorkflowID string               `json:"workflowID"`
+	Executions []SimulatedExecution `json:"executions"`
+	// TargetCalls are the calls made to targets, in order.
+	TargetCalls []SimulatedTargetCall `json:"targetCalls"`
+}
+
+type SimulatedExecution struct {
+	ExecutionID    string `json:"executionID"`
+	TriggerEventID string `json:"triggerEventID"`
+	Status         string `json:"status"`
+	// Steps are the steps which were reached, in the order of the workflow graph.
+	Steps []SimulatedStep `json:"steps"`
+}
+
+type SimulatedStep struct {
+	Ref      string              `json:"ref"`
+	Status   string              `json:"status"`
+	Inputs   any                 `json:"inputs,omitempty"`
+	Outputs  any                 `json:"outputs,omitempty"`
+	Error    string              `json:"error,omitempty"`
+	Attempts []store.StepAttempt `json:"attempts,omitempty"`
+}
+
+type SimulatedTargetCall struct {
+	ExecutionID  string `json:"executionID"`
+	Ref          string `json:"ref"`
+	CapabilityID string `json:"capabilityID"`
+	Inputs       any    `json:"inputs,omitempty"`
+	Config       any    `json:"config,omitempty"`
+}
+
+// Simulate runs a workflow locally, against stub capabilities and an in-memory store,
+// for each of the trigger events of the fixtures in turn.
+func Simulate(ctx context.Context, cfg SimulationConfig) (SimulationResult, error) {
+	result := SimulationResult{WorkflowID: cfg.WorkflowID}
+
+	triggers, err := newSimulatedTriggers(cfg.Workflow, cfg.Fixtures.Events)
+	if err != nil {
+		return result, err
+	}
+
+	reg := coreCap.NewRegistry(cfg.Lggr)
+	reg.SetLocalRegistry(simulatedMetadataRegistry{})
+	for _, t := range triggers {
+		if err = reg.Add(ctx, t); err != nil {
+			return result, err
+		}
+	}
+
+	recorder := &targetCallRecorder{}
+	added := map[string]bool{}
+	for _, s := range cfg.Workflow.Steps() {
+		if added[s.ID] {
+			continue
+		}
+		added[s.ID] = true
+
+		if s.ID == compute.CapabilityIDCompute && len(cfg.Binary) > 0 {
+			c := compute.NewAction(webapi.ServiceConfig{}, cfg.Lggr, reg, nil, func() string { return "" })
+			if err = c.Start(ctx); err != nil {
+				return result, err
+			}
+			defer c.Close()
+			continue
+		}
+
+		info, err := capabilities.NewCapabilityInfo(s.ID, s.CapabilityType, "simulated capability")
+		if err != nil {
+			return result, err
+		}
+		err = reg.Add(ctx, &simulatedCapability{CapabilityInfo: info, responses: cfg.Fixtures.Steps, recorder: recorder})
+		if err != nil {
+			return result, err
+		}
+	}
+
+	initialized := make(chan bool, 1)
+	// Each event starts at most one execution, so finishing executions never blocks the engine.
+	finished := make(chan string, len(cfg.Fixtures.Events))
+	engine, err := NewEngine(Config{
+		Lggr:          cfg.Lggr,
+		Workflow:      cfg.Workflow,
+		WorkflowID:    cfg.WorkflowID,
+		WorkflowOwner: cfg.WorkflowOwner,
+		WorkflowName:  cfg.WorkflowName,
+		Registry:      reg,
+		Store:         store.NewMemoryStore(clockwork.NewRealClock()),
+		Config:        cfg.Config,
+		Binary:        cfg.Binary,
+		// Every capability is registered upfront, so there's nothing to wait for during initialization.
+		maxRetries:          1,
+		retryMs:             1,
+		afterInit:           func(success bool) { initialized <- success },
+		onExecutionFinished: func(weid string) { finished <- weid },
+	})
+	if err != nil {
+		return result, err
+	}
+	if err = engine.Start(ctx); err != nil {
+		return result, err
+	}
+	defer engine.Close()
+
+	select {
+	case <-ctx.Done():
+		return result, ctx.Err()
+	case ok := <-initialized:
+		if !ok {
+			return result, errors.New("failed to initialize workflow, see the logs for details")
+		}
+	}
+
+	for i, event := range cfg.Fixtures.Events {
+		executionID, err := generateExecutionID(cfg.WorkflowID, event.ID)
+		if err != nil {
+			return result, err
+		}
+		outputs, err := values.NewMap(event.Outputs)
+		if err != nil {
+			return result, fmt.Errorf("invalid outputs of event %d: %w", i, err)
+		}
+		err = triggers[event.TriggerID].emit(ctx, capabilities.TriggerResponse{
+			Event: capabilities.TriggerEvent{TriggerType: event.TriggerID, ID: event.ID, Outputs: outputs},
+		})
+		if err != nil {
+			return result, err
+		}
+
+		if err = waitForExecution(ctx, finished, executionID); err != nil {
+			return result, fmt.Errorf("execution for event %s did not finish: %w", event.ID, err)
+		}
+		execution, err := engine.executionStates.Get(ctx, executionID)
+		if err != nil {
+			return result, err
+		}
+		simulated, err := simulatedExecution(engine.workflow, execution)
+		if err != nil {
+			return result, err
+		}
+		result.Executions = append(result.Executions, simulated)
+	}
+
+	result.TargetCalls = recorder.calls
+	return result, nil
+}
+
+func waitForExecution(ctx context.Context, finished <-chan string, executionID string) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case id := <-finished:
+			if id == executionID {
+				return nil
+			}
+		}
+	}
+}
+
+// simulatedExecution converts an execution into its trace, ordering steps as in the workflow graph.
+func simulatedExecution(wf *workflow, execution store.WorkflowExecution) (SimulatedExecution, error) {
+	simulated := SimulatedExecution{
+		ExecutionID:    execution.ExecutionID,
+		TriggerEventID: execution.TriggerEventID,
+		Status:         execution.Status,
+	}
+	err := wf.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		es, ok := execution.Steps[s.Ref]
+		if !ok {
+			return nil
+		}
+		ss := SimulatedStep{Ref: es.Ref, Status: es.Status, Attempts: es.Attempts}
+		if es.Inputs != nil {
+			inputs, err := es.Inputs.Unwrap()
+			if err != nil {
+				return fmt.Errorf("failed to unwrap inputs of step %s: %w", s.Ref, err)
+			}
+			ss.Inputs = inputs
+		}
+		// Capabilities responding with nothing have typed nil outputs.
+		if m, isMap := es.Outputs.Value.(*values.Map); es.Outputs.Value != nil && (!isMap || m != nil) {
+			outputs, err := values.Unwrap(es.Outputs.Value)
+			if err != nil {
+				return fmt.Errorf("failed to unwrap outputs of step %s: %w", s.Ref, err)
+			}
+			ss.Outputs = outputs
+		}
+		if es.Outputs.Err != nil {
+			ss.Error = es.Outputs.Err.Error()
+		}
+		simulated.Steps = append(simulated.Steps, ss)
+		return nil
+	})
+	return simulated, err
+}
+
+// simulatedTrigger emits the trigger events of the fixtures.
+// simulatedMetadataRegistry describes the node running the simulation as the only member of
+// a workflow DON of its own, with every capability using its default configuration.
+type simulatedMetadataRegistry struct{}
+
+func (simulatedMetadataRegistry) LocalNode(context.Context) (capabilities.Node, error) {
+	peerID := p2ptypes.PeerID{}
+	return capabilities.Node{
+		PeerID: &peerID,
+		WorkflowDON: capabilities.DON{
+			ID:               1,
+			ConfigVersion:    1,
+			Members:          []p2ptypes.PeerID{peerID},
+			F:                0,
+			AcceptsWorkflows: true,
+		},
+	}, nil
+}
+
+func (simulatedMetadataRegistry) ConfigForCapability(context.Context, string, uint32) (registrysyncer.CapabilityConfiguration, error) {
+	return registrysyncer.CapabilityConfiguration{}, nil
+}
+
+type simulatedTrigger struct {
+	capabilities.CapabilityInfo
+	ch chan capabilities.TriggerResponse
+}
+
+var _ capabilities.TriggerCapability = (*simulatedTrigger)(nil)
+
+// newSimulatedTriggers creates a trigger for each trigger capability of the workflow, keyed by ID,
+// and checks that the events are addressed to one of them, filling in the trigger ID if omitted.
+func newSimulatedTriggers(spec sdk.WorkflowSpec, events []SimulatedTriggerEvent) (map[string]*simulatedTrigger, error) {
+	triggers := map[string]*simulatedTrigger{}
+	for _, t := range spec.Triggers {
+		info, err := capabilities.NewCapabilityInfo(t.ID, capabilities.CapabilityTypeTrigger, "simulated trigger")
+		if err != nil {
+			return nil, err
+		}
+		triggers[t.ID] = &simulatedTrigger{CapabilityInfo: info, ch: make(chan capabilities.TriggerResponse)}
+	}
+
+	seen := map[string]bool{}
+	for i := range events {
+		e := &events[i]
+		if e.ID == "" {
+			e.ID = fmt.Sprintf("event-%d", i+1)
+		}
+		if seen[e.ID] {
+			return nil, fmt.Errorf("duplicate event ID %s", e.ID)
+		}
+		seen[e.ID] = true
+
+		if e.TriggerID == "" {
+			if len(spec.Triggers) != 1 {
+				return nil, fmt.Errorf("event %s must specify a triggerID, since the workflow has %d triggers", e.ID, len(spec.Triggers))
+			}
+			e.TriggerID = spec.Triggers[0].ID
+		}
+		if _, ok := triggers[e.TriggerID]; !ok {
+			return nil, fmt.Errorf("event %s is for trigger %s, which is not a trigger of the workflow", e.ID, e.TriggerID)
+		}
+	}
+	return triggers, nil
+}
+
+func (t *simulatedTrigger) emit(ctx context.Context, resp capabilities.TriggerResponse) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case t.ch <- resp:
+		return nil
+	}
+}
+
+func (t *simulatedTrigger) RegisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	return t.ch, nil
+}
+
+func (t *simulatedTrigger) UnregisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) error {
+	return nil
+}
+
+// simulatedCapability responds to requests with the canned responses of the fixtures.
+type simulatedCapability struct {
+	capabilities.CapabilityInfo
+	responses map[string]SimulatedStepResponse
+	recorder  *targetCallRecorder
+}
+
+var _ capabilities.ExecutableCapability = (*simulatedCapability)(nil)
+
+func (c *simulatedCapability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	isTarget := c.CapabilityType == capabilities.CapabilityTypeTarget
+	if isTarget {
+		if err := c.recorder.record(c.ID, req); err != nil {
+			return capabilities.CapabilityResponse{}, err
+		}
+	}
+
+	if resp, ok := c.responses[req.Metadata.ReferenceID]; ok {
+		if resp.Error != "" {
+			return capabilities.CapabilityResponse{}, errors.New(resp.Error)
+		}
+		outputs, err := values.NewMap(resp.Outputs)
+		if err != nil {
+			return capabilities.CapabilityResponse{}, fmt.Errorf("invalid outputs of step %s: %w", req.Metadata.ReferenceID, err)
+		}
+		return capabilities.CapabilityResponse{Value: outputs}, nil
+	}
+
+	if isTarget {
+		return capabilities.CapabilityResponse{}, nil
+	}
+	return capabilities.CapabilityResponse{Value: req.Inputs}, nil
+}
+
+func (c *simulatedCapability) RegisterToWorkflow(ctx context.Context, req capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (c *simulatedCapability) UnregisterFromWorkflow(ctx context.Context, req capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
+
+type targetCallRecorder struct {
+	mu    sync.Mutex
+	calls []SimulatedTargetCall
+}
+
+func (r *targetCallRecorder) record(capabilityID string, req capabilities.CapabilityRequest) error {
+	call := SimulatedTargetCall{
+		ExecutionID:  req.Metadata.WorkflowExecutionID,
+		Ref:          req.Metadata.ReferenceID,
+		CapabilityID: capabilityID,
+	}
+	if req.Inputs != nil {
+		inputs, err := req.Inputs.Unwrap()
+		if err != nil {
+			return err
+		}
+		call.Inputs = inputs
+	}
+	if req.Config != nil {
+		config, err := req.Config.Unwrap()
+		if err != nil {
+			return err
+		}
+		call.Config = config
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+	return nil
+}
@@ -0,0 +1,85 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestSimulate(t *testing.T) {
+	ctx := testutils.Context(t)
+	spec, err := (&job.WorkflowSpec{Workflow: simpleWorkflow, SpecType: job.YamlSpec}).SDKSpec(ctx)
+	require.NoError(t, err)
+
+	simulate := func(t *testing.T, fixtures SimulationFixtures) (SimulationResult, error) {
+		return Simulate(ctx, SimulationConfig{
+			Lggr:       logger.TestLogger(t),
+			Workflow:   spec,
+			WorkflowID: testWorkflowId,
+			Fixtures:   fixtures,
+		})
+	}
+	events := []SimulatedTriggerEvent{
+		{ID: "first", Outputs: map[string]any{"feedId": "0x1111"}},
+		{Outputs: map[string]any{"feedId": "0x2222"}},
+	}
+
+	t.Run("completed", func(t *testing.T) {
+		result, err := simulate(t, SimulationFixtures{
+			Events: events,
+			Steps: map[string]SimulatedStepResponse{
+				"evm_median": {Outputs: map[string]any{"report": "0xabcd"}},
+			},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, result.Executions, 2)
+		assert.Equal(t, "first", result.Executions[0].TriggerEventID)
+		assert.Equal(t, "event-2", result.Executions[1].TriggerEventID)
+		for _, execution := range result.Executions {
+			assert.Equal(t, store.StatusCompleted, execution.Status)
+			require.Len(t, execution.Steps, 3)
+			assert.Equal(t, "trigger", execution.Steps[0].Ref)
+			assert.Equal(t, "evm_median", execution.Steps[1].Ref)
+			assert.Equal(t, map[string]any{"report": "0xabcd"}, execution.Steps[1].Outputs)
+			assert.Equal(t, store.StatusCompleted, execution.Steps[2].Status)
+		}
+
+		require.Len(t, result.TargetCalls, 2)
+		assert.Equal(t, result.Executions[0].ExecutionID, result.TargetCalls[0].ExecutionID)
+		assert.Equal(t, "write_polygon-testnet-mumbai@1.0.0", result.TargetCalls[0].CapabilityID)
+		assert.Equal(t, map[string]any{"report": "0xabcd"}, result.TargetCalls[0].Inputs)
+	})
+
+	t.Run("errored", func(t *testing.T) {
+		result, err := simulate(t, SimulationFixtures{
+			Events: events[:1],
+			Steps: map[string]SimulatedStepResponse{
+				"evm_median": {Error: "no quorum"},
+			},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, result.Executions, 1)
+		execution := result.Executions[0]
+		assert.Equal(t, store.StatusErrored, execution.Status)
+		require.Len(t, execution.Steps, 2)
+		assert.Equal(t, store.StatusErrored, execution.Steps[1].Status)
+		assert.Contains(t, execution.Steps[1].Error, "no quorum")
+		assert.Empty(t, result.TargetCalls)
+	})
+
+	t.Run("invalid events", func(t *testing.T) {
+		_, err := simulate(t, SimulationFixtures{Events: []SimulatedTriggerEvent{{TriggerID: "cron-trigger@1.0.0"}}})
+		assert.ErrorContains(t, err, "not a trigger of the workflow")
+
+		_, err = simulate(t, SimulationFixtures{Events: []SimulatedTriggerEvent{{ID: "1"}, {ID: "1"}}})
+		assert.ErrorContains(t, err, "duplicate event ID 1")
+	})
+}
@@ -0,0 +1,194 @@
+package workflows
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-bexpr/grammar"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const (
+	// stepRunIfKey is the reserved step config key holding a boolean expression
+	// which must hold for the step to be executed. When it doesn't, the step
+	// and every step downstream of it are skipped, e.g.:
+	//
+	//	config:
+	//	  runIf: "consensus.outputs.changed == true"
+	//
+	// Selectors are of the form `<ref>.<inputs|outputs>[.<key>...]`, and may only
+	// reference the step's dependencies. See github.com/hashicorp/go-bexpr for the syntax.
+	stepRunIfKey = "runIf"
+	// stepLoopKey is the reserved step config key under which bounded iteration
+	// of a step is declared. The capability is executed repeatedly, with the same
+	// inputs, until the `until` expression holds or maxIterations is reached, e.g.:
+	//
+	//	config:
+	//	  loop:
+	//	    until: "poll.outputs.status == final"
+	//	    maxIterations: 10
+	//	    interval: 5s
+	//
+	// In addition to the step's dependencies, `until` may reference the step
+	// itself, which resolves to the result of the latest iteration.
+	stepLoopKey = "loop"
+)
+
+// errStepSkipped is returned when a step is not executed because its runIf condition doesn't hold.
+var errStepSkipped = errors.New("step skipped")
+
+// stepFlow controls whether and how many times the capability of a step is executed.
+// The zero value executes it exactly once.
+type stepFlow struct {
+	// runIf must evaluate to true for the step to be executed; nil means always.
+	runIf *bexpr.Evaluator
+	// loop repeats the step; nil means no iteration.
+	loop *stepLoop
+}
+
+type stepLoop struct {
+	until         *bexpr.Evaluator
+	maxIterations int
+	// interval is waited for between iterations.
+	interval time.Duration
+}
+
+type stepLoopConfig struct {
+	Until         string                 `json:"until"`
+	MaxIterations int                    `json:"maxIterations"`
+	Interval      *commonconfig.Duration `json:"interval"`
+}
+
+// parseStepFlow extracts the runIf condition and loop declaration from the config of the step
+// with the given ref, returning the config without them.
+func parseStepFlow(ref string, dependencies []string, config map[string]any) (stepFlow, map[string]any, error) {
+	var flow stepFlow
+	rawRunIf, hasRunIf := config[stepRunIfKey]
+	rawLoop, hasLoop := config[stepLoopKey]
+	if !hasRunIf && !hasLoop {
+		return flow, config, nil
+	}
+
+	stripped := make(map[string]any, len(config))
+	for k, v := range config {
+		if k != stepRunIfKey && k != stepLoopKey {
+			stripped[k] = v
+		}
+	}
+
+	if hasRunIf {
+		expr, ok := rawRunIf.(string)
+		if !ok {
+			return flow, nil, fmt.Errorf("invalid %s: expected a string, got %T", stepRunIfKey, rawRunIf)
+		}
+		evaluator, err := parseStepExpression(expr, dependencies)
+		if err != nil {
+			return flow, nil, fmt.Errorf("invalid %s: %w", stepRunIfKey, err)
+		}
+		flow.runIf = evaluator
+	}
+
+	if hasLoop {
+		b, err := json.Marshal(rawLoop)
+		if err != nil {
+			return flow, nil, fmt.Errorf("invalid %s: %w", stepLoopKey, err)
+		}
+		var cfg stepLoopConfig
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		if err = dec.Decode(&cfg); err != nil {
+			return flow, nil, fmt.Errorf("invalid %s: %w", stepLoopKey, err)
+		}
+		if cfg.Until == "" {
+			return flow, nil, fmt.Errorf("invalid %s: until is required", stepLoopKey)
+		}
+		if cfg.MaxIterations < 1 {
+			return flow, nil, fmt.Errorf("invalid %s: maxIterations must be at least 1, got %d", stepLoopKey, cfg.MaxIterations)
+		}
+		until, err := parseStepExpression(cfg.Until, append(slices.Clone(dependencies), ref))
+		if err != nil {
+			return flow, nil, fmt.Errorf("invalid %s: until: %w", stepLoopKey, err)
+		}
+		flow.loop = &stepLoop{until: until, maxIterations: cfg.MaxIterations}
+		if cfg.Interval != nil {
+			flow.loop.interval = cfg.Interval.Duration()
+		}
+	}
+
+	return flow, stripped, nil
+}
+
+// parseStepExpression parses a boolean expression, checking that its selectors only reference the given steps.
+func parseStepExpression(expr string, refs []string) (*bexpr.Evaluator, error) {
+	ast, err := grammar.Parse("", []byte(expr))
+	if err != nil {
+		return nil, err
+	}
+	var selectors []grammar.Selector
+	collectSelectors(ast.(grammar.Expression), &selectors)
+	for _, sel := range selectors {
+		if len(sel.Path) < 2 {
+			return nil, fmt.Errorf("selector %q must be of the form <ref>.<inputs|outputs>", sel)
+		}
+		if !slices.Contains(refs, sel.Path[0]) {
+			return nil, fmt.Errorf("selector %q references step %q, which is not one of %v", sel, sel.Path[0], refs)
+		}
+		if sel.Path[1] != "inputs" && sel.Path[1] != "outputs" {
+			return nil, fmt.Errorf("selector %q must be of the form <ref>.<inputs|outputs>", sel)
+		}
+	}
+	return bexpr.CreateEvaluator(expr)
+}
+
+func collectSelectors(expr grammar.Expression, selectors *[]grammar.Selector) {
+	switch e := expr.(type) {
+	case *grammar.UnaryExpression:
+		collectSelectors(e.Operand, selectors)
+	case *grammar.BinaryExpression:
+		collectSelectors(e.Left, selectors)
+		collectSelectors(e.Right, selectors)
+	case *grammar.MatchExpression:
+		*selectors = append(*selectors, e.Selector)
+	}
+}
+
+// evaluateStepExpression evaluates a boolean expression over the inputs and outputs of the steps in the given state.
+// Selecting a value which is missing or nil is an error.
+func evaluateStepExpression(evaluator *bexpr.Evaluator, state store.WorkflowExecution) (result bool, err error) {
+	datum := make(map[string]any, len(state.Steps))
+	for ref, s := range state.Steps {
+		results := map[string]any{}
+		if s.Inputs != nil {
+			inputs, err := s.Inputs.Unwrap()
+			if err != nil {
+				return false, fmt.Errorf("failed to unwrap inputs of step %s: %w", ref, err)
+			}
+			results["inputs"] = inputs
+		}
+		if s.Outputs.Value != nil {
+			outputs, err := values.Unwrap(s.Outputs.Value)
+			if err != nil {
+				return false, fmt.Errorf("failed to unwrap outputs of step %s: %w", ref, err)
+			}
+			results["outputs"] = outputs
+		}
+		datum[ref] = results
+	}
+
+	// bexpr panics on nil values nested within the datum.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to evaluate expression: %v", r)
+		}
+	}()
+	return evaluator.Evaluate(datum)
+}
@@ -0,0 +1,112 @@
+package workflows
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestParseStepFlow(t *testing.T) {
+	t.Parallel()
+
+	deps := []string{"trigger", "consensus"}
+
+	t.Run("no flow", func(t *testing.T) {
+		config := map[string]any{"foo": "bar"}
+		flow, stripped, err := parseStepFlow("target", deps, config)
+		require.NoError(t, err)
+		assert.Nil(t, flow.runIf)
+		assert.Nil(t, flow.loop)
+		assert.Equal(t, config, stripped)
+	})
+
+	t.Run("runIf and loop", func(t *testing.T) {
+		flow, stripped, err := parseStepFlow("target", deps, map[string]any{
+			"foo":        "bar",
+			stepRunIfKey: "consensus.outputs.changed == true",
+			stepLoopKey: map[string]any{
+				"until":         "target.outputs.status == final and trigger.inputs is not empty",
+				"maxIterations": 5,
+				"interval":      "1s",
+			},
+		})
+		require.NoError(t, err)
+		assert.NotNil(t, flow.runIf)
+		require.NotNil(t, flow.loop)
+		assert.Equal(t, 5, flow.loop.maxIterations)
+		assert.Equal(t, time.Second, flow.loop.interval)
+		assert.Equal(t, map[string]any{"foo": "bar"}, stripped)
+	})
+
+	for _, tc := range []struct {
+		name   string
+		config map[string]any
+		err    string
+	}{
+		{"runIf not a string", map[string]any{stepRunIfKey: true}, "expected a string"},
+		{"runIf unparsable", map[string]any{stepRunIfKey: "consensus.outputs =="}, "invalid runIf"},
+		{"runIf references itself", map[string]any{stepRunIfKey: "target.outputs.x == 1"}, `references step "target"`},
+		{"runIf references unknown step", map[string]any{stepRunIfKey: "other.outputs.x == 1"}, `references step "other"`},
+		{"runIf invalid selector", map[string]any{stepRunIfKey: "consensus.config == 1"}, "must be of the form"},
+		{"loop without until", map[string]any{stepLoopKey: map[string]any{"maxIterations": 2}}, "until is required"},
+		{"loop without iterations", map[string]any{stepLoopKey: map[string]any{"until": "target.outputs.x == 1"}}, "maxIterations must be at least 1"},
+		{"loop unknown field", map[string]any{stepLoopKey: map[string]any{"while": "target.outputs.x == 1"}}, `unknown field "while"`},
+		{"loop references unknown step", map[string]any{stepLoopKey: map[string]any{"until": "other.outputs.x == 1", "maxIterations": 2}}, `references step "other"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := parseStepFlow("target", deps, tc.config)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestEvaluateStepExpression(t *testing.T) {
+	t.Parallel()
+
+	outputs, err := values.NewMap(map[string]any{"changed": false, "count": 3, "feeds": []any{"eth", "btc"}, "empty": nil})
+	require.NoError(t, err)
+	state := store.WorkflowExecution{
+		Steps: map[string]*store.WorkflowExecutionStep{
+			"consensus": {Ref: "consensus", Outputs: store.StepOutput{Value: outputs}},
+			"pending":   {Ref: "pending"},
+		},
+	}
+
+	for _, tc := range []struct {
+		expr     string
+		expected bool
+	}{
+		{"consensus.outputs.changed == true", false},
+		{"consensus.outputs.changed == false", true},
+		{"consensus.outputs.count == 3 and consensus.outputs.changed != true", true},
+		{"btc in consensus.outputs.feeds", true},
+		{"not (ltc in consensus.outputs.feeds)", true},
+	} {
+		t.Run(tc.expr, func(t *testing.T) {
+			evaluator, err := parseStepExpression(tc.expr, []string{"consensus"})
+			require.NoError(t, err)
+			result, err := evaluateStepExpression(evaluator, state)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+
+	for _, expr := range []string{
+		"consensus.outputs.unknown == 1",
+		"consensus.inputs is empty",
+		"consensus.outputs.empty is empty",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			evaluator, err := parseStepExpression(expr, []string{"consensus"})
+			require.NoError(t, err)
+			_, err = evaluateStepExpression(evaluator, state)
+			assert.Error(t, err)
+		})
+	}
+}
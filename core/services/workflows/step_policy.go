@@ -0,0 +1,202 @@
+package workflows
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// stepPolicyKey is the reserved step config key under which a step's
+// execution policy is declared. It is removed from the config before it's
+// passed to the capability, e.g.:
+//
+//	config:
+//	  stepPolicy:
+//	    timeout: 30s
+//	    maxAttempts: 3
+//	    initialBackoff: 1s
+//	    maxBackoff: 10s
+//	    backoffMultiplier: 2
+//	    retryOn: ["timeout"]
+const stepPolicyKey = "stepPolicy"
+
+// Error classes a step can be retried on.
+const (
+	// errorClassTimeout matches attempts which did not finish within the step's timeout.
+	errorClassTimeout = "timeout"
+	// errorClassError matches any other error returned by the capability.
+	errorClassError = "error"
+)
+
+var errStepTimeout = errors.New("step timed out")
+
+const (
+	defaultStepInitialBackoff    = time.Second
+	defaultStepMaxBackoff        = time.Minute
+	defaultStepBackoffMultiplier = 2.0
+)
+
+// stepPolicy controls how the capability of a step is executed.
+// The zero value executes it once, bounded only by the workflow's MaxExecutionDuration.
+type stepPolicy struct {
+	// Timeout bounds each attempt; zero means no timeout.
+	Timeout time.Duration
+	// MaxAttempts is the maximum number of times the capability is executed.
+	MaxAttempts       int
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	BackoffMultiplier float64
+	// RetryOn are the error classes failed attempts are retried on.
+	RetryOn map[string]bool
+}
+
+type stepPolicyConfig struct {
+	Timeout           *commonconfig.Duration `json:"timeout"`
+	MaxAttempts       *int                   `json:"maxAttempts"`
+	InitialBackoff    *commonconfig.Duration `json:"initialBackoff"`
+	MaxBackoff        *commonconfig.Duration `json:"maxBackoff"`
+	BackoffMultiplier *float64               `json:"backoffMultiplier"`
+	RetryOn           []string               `json:"retryOn"`
+}
+
+// parseStepPolicy extracts the execution policy from a step's config,
+// returning the config without it.
+func parseStepPolicy(config map[string]any) (stepPolicy, map[string]any, error) {
+	policy := stepPolicy{MaxAttempts: 1}
+	raw, ok := config[stepPolicyKey]
+	if !ok {
+		return policy, config, nil
+	}
+
+	stripped := make(map[string]any, len(config)-1)
+	for k, v := range config {
+		if k != stepPolicyKey {
+			stripped[k] = v
+		}
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return policy, nil, fmt.Errorf("invalid %s: %w", stepPolicyKey, err)
+	}
+	var cfg stepPolicyConfig
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&cfg); err != nil {
+		return policy, nil, fmt.Errorf("invalid %s: %w", stepPolicyKey, err)
+	}
+
+	policy.InitialBackoff = defaultStepInitialBackoff
+	policy.MaxBackoff = defaultStepMaxBackoff
+	policy.BackoffMultiplier = defaultStepBackoffMultiplier
+	policy.RetryOn = map[string]bool{errorClassTimeout: true, errorClassError: true}
+
+	if cfg.Timeout != nil {
+		policy.Timeout = cfg.Timeout.Duration()
+	}
+	if cfg.MaxAttempts != nil {
+		if *cfg.MaxAttempts < 1 {
+			return policy, nil, fmt.Errorf("invalid %s: maxAttempts must be at least 1, got %d", stepPolicyKey, *cfg.MaxAttempts)
+		}
+		policy.MaxAttempts = *cfg.MaxAttempts
+	}
+	if cfg.InitialBackoff != nil {
+		policy.InitialBackoff = cfg.InitialBackoff.Duration()
+	}
+	if cfg.MaxBackoff != nil {
+		policy.MaxBackoff = cfg.MaxBackoff.Duration()
+	}
+	if policy.MaxBackoff < policy.InitialBackoff {
+		return policy, nil, fmt.Errorf("invalid %s: maxBackoff (%s) must not be less than initialBackoff (%s)", stepPolicyKey, policy.MaxBackoff, policy.InitialBackoff)
+	}
+	if cfg.BackoffMultiplier != nil {
+		if *cfg.BackoffMultiplier < 1 {
+			return policy, nil, fmt.Errorf("invalid %s: backoffMultiplier must be at least 1, got %v", stepPolicyKey, *cfg.BackoffMultiplier)
+		}
+		policy.BackoffMultiplier = *cfg.BackoffMultiplier
+	}
+	if cfg.RetryOn != nil {
+		policy.RetryOn = map[string]bool{}
+		for _, class := range cfg.RetryOn {
+			if class != errorClassTimeout && class != errorClassError {
+				return policy, nil, fmt.Errorf("invalid %s: unknown error class %q in retryOn, expected %q or %q", stepPolicyKey, class, errorClassTimeout, errorClassError)
+			}
+			policy.RetryOn[class] = true
+		}
+	}
+
+	return policy, stripped, nil
+}
+
+// backoff returns how long to wait after the given failed attempt, counting from 1.
+func (p stepPolicy) backoff(attempt int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.BackoffMultiplier, float64(attempt-1))
+	if d > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(d)
+}
+
+// executeWithPolicy executes the capability of a step, retrying failed attempts according to its policy.
+// Every attempt is recorded, in order.
+func (e *Engine) executeWithPolicy(ctx context.Context, l logger.Logger, s *step, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, []store.StepAttempt, error) {
+	var attempts []store.StepAttempt
+	for attempt := 1; ; attempt++ {
+		record := store.StepAttempt{StartedAt: e.clock.Now()}
+		output, timedOut, err := e.executeAttempt(ctx, s, req)
+		record.FinishedAt = e.clock.Now()
+		if err != nil {
+			record.Err = err.Error()
+		}
+		attempts = append(attempts, record)
+
+		switch {
+		case err == nil || errors.Is(capabilities.ErrStopExecution, err):
+			return output, attempts, err
+		case ctx.Err() != nil:
+			return output, attempts, err
+		case timedOut && !s.policy.RetryOn[errorClassTimeout]:
+			return output, attempts, err
+		case !timedOut && !s.policy.RetryOn[errorClassError]:
+			return output, attempts, err
+		case attempt >= s.policy.MaxAttempts && attempt > 1:
+			return output, attempts, fmt.Errorf("step failed after %d attempts: %w", attempt, err)
+		case attempt >= s.policy.MaxAttempts:
+			return output, attempts, err
+		}
+
+		backoff := s.policy.backoff(attempt)
+		l.Warnw("step attempt failed, retrying", "attempt", attempt, "maxAttempts", s.policy.MaxAttempts, "backoff", backoff, "err", err)
+		select {
+		case <-ctx.Done():
+			return output, attempts, err
+		case <-e.clock.After(backoff):
+		}
+	}
+}
+
+// executeAttempt executes the capability of a step once, within the step's timeout.
+func (e *Engine) executeAttempt(ctx context.Context, s *step, req capabilities.CapabilityRequest) (output capabilities.CapabilityResponse, timedOut bool, err error) {
+	if s.policy.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, s.policy.Timeout, errStepTimeout)
+		defer cancel()
+	}
+
+	e.metrics.incrementCapabilityInvocationCounter(ctx)
+	output, err = s.capability.Execute(ctx, req)
+	if err != nil && errors.Is(context.Cause(ctx), errStepTimeout) {
+		return output, true, fmt.Errorf("step did not finish within %s: %w", s.policy.Timeout, err)
+	}
+	return output, false, err
+}
@@ -0,0 +1,93 @@
+package workflows
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStepPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no policy", func(t *testing.T) {
+		config := map[string]any{"foo": "bar"}
+		policy, stripped, err := parseStepPolicy(config)
+		require.NoError(t, err)
+		assert.Equal(t, stepPolicy{MaxAttempts: 1}, policy)
+		assert.Equal(t, config, stripped)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		policy, stripped, err := parseStepPolicy(map[string]any{
+			"foo":         "bar",
+			stepPolicyKey: map[string]any{"maxAttempts": 3},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, stepPolicy{
+			MaxAttempts:       3,
+			InitialBackoff:    defaultStepInitialBackoff,
+			MaxBackoff:        defaultStepMaxBackoff,
+			BackoffMultiplier: defaultStepBackoffMultiplier,
+			RetryOn:           map[string]bool{errorClassTimeout: true, errorClassError: true},
+		}, policy)
+		assert.Equal(t, map[string]any{"foo": "bar"}, stripped)
+	})
+
+	t.Run("full policy", func(t *testing.T) {
+		policy, stripped, err := parseStepPolicy(map[string]any{
+			stepPolicyKey: map[string]any{
+				"timeout":           "30s",
+				"maxAttempts":       5,
+				"initialBackoff":    "100ms",
+				"maxBackoff":        "1s",
+				"backoffMultiplier": 3,
+				"retryOn":           []any{"timeout"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, stepPolicy{
+			Timeout:           30 * time.Second,
+			MaxAttempts:       5,
+			InitialBackoff:    100 * time.Millisecond,
+			MaxBackoff:        time.Second,
+			BackoffMultiplier: 3,
+			RetryOn:           map[string]bool{errorClassTimeout: true},
+		}, policy)
+		assert.Empty(t, stripped)
+	})
+
+	for _, tc := range []struct {
+		name   string
+		policy any
+		err    string
+	}{
+		{"not a map", "30s", "cannot unmarshal"},
+		{"unknown field", map[string]any{"retries": 3}, `unknown field "retries"`},
+		{"invalid duration", map[string]any{"timeout": "soon"}, "invalid duration"},
+		{"no attempts", map[string]any{"maxAttempts": 0}, "maxAttempts must be at least 1"},
+		{"max backoff below initial backoff", map[string]any{"initialBackoff": "10s", "maxBackoff": "1s"}, "must not be less than initialBackoff"},
+		{"shrinking backoff", map[string]any{"backoffMultiplier": 0.5}, "backoffMultiplier must be at least 1"},
+		{"unknown error class", map[string]any{"retryOn": []any{"panic"}}, `unknown error class "panic"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := parseStepPolicy(map[string]any{stepPolicyKey: tc.policy})
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestStepPolicy_Backoff(t *testing.T) {
+	t.Parallel()
+
+	policy := stepPolicy{
+		InitialBackoff:    100 * time.Millisecond,
+		MaxBackoff:        time.Second,
+		BackoffMultiplier: 3,
+	}
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 300*time.Millisecond, policy.backoff(2))
+	assert.Equal(t, 900*time.Millisecond, policy.backoff(3))
+	assert.Equal(t, time.Second, policy.backoff(4))
+}
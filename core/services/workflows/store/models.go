@@ -15,6 +15,12 @@ const (
 	StatusTimeout            = "timeout"
 	StatusCompleted          = "completed"
 	StatusCompletedEarlyExit = "completed_early_exit"
+	// StatusSkipped is only used for steps, which weren't executed
+	// because a condition upstream of them didn't hold.
+	StatusSkipped = "skipped"
+	// StatusCancelled is only used for executions, which were cancelled
+	// in favour of a newer one by the workflow's concurrency controls.
+	StatusCancelled = "cancelled"
 )
 
 var ValidStatuses = map[string]bool{
@@ -23,6 +29,8 @@ var ValidStatuses = map[string]bool{
 	StatusTimeout:            true,
 	StatusCompleted:          true,
 	StatusCompletedEarlyExit: true,
+	StatusSkipped:            true,
+	StatusCancelled:          true,
 }
 
 type StepOutput struct {
@@ -37,14 +45,28 @@ type WorkflowExecutionStep struct {
 
 	Inputs  *values.Map
 	Outputs StepOutput
+	// Attempts are the attempts made at executing the step's capability, in order
+	Attempts []StepAttempt
 
 	UpdatedAt *time.Time
 }
 
+// StepAttempt records a single attempt at executing a step's capability.
+type StepAttempt struct {
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	// Err is the error the attempt failed with, if any
+	Err string `json:"error,omitempty"`
+}
+
 type WorkflowExecution struct {
 	Steps       map[string]*WorkflowExecutionStep
 	ExecutionID string
 	WorkflowID  string
+	// WorkflowVersion is the version of the workflow the execution was started by, if any
+	WorkflowVersion string
+	// TriggerEventID is the ID of the trigger event the execution was started for, if known
+	TriggerEventID string
 
 	Status     string
 	CreatedAt  *time.Time
@@ -66,3 +88,14 @@ func (w WorkflowExecution) ResultForStep(s string) (*exec.Result, bool) {
 }
 
 var _ exec.Results = WorkflowExecution{}
+
+// ListFilter narrows down the executions returned by Store.List.
+// Zero values match every execution.
+type ListFilter struct {
+	WorkflowID     string
+	Status         string
+	TriggerEventID string
+	// CreatedAfter and CreatedBefore bound the creation time of executions, inclusively.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
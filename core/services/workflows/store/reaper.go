@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Reaper periodically deletes finished workflow executions, along with their
+// steps, so that `workflow_executions` and `workflow_steps` don't grow unbounded.
+type Reaper struct {
+	services.StateMachine
+	store     Store
+	lggr      logger.Logger
+	interval  time.Duration
+	threshold time.Duration
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+var _ services.Service = (*Reaper)(nil)
+
+// NewReaper returns a Reaper which deletes executions that finished longer than threshold ago, every interval.
+// An interval of zero disables the reaper.
+func NewReaper(store Store, lggr logger.Logger, interval, threshold time.Duration) *Reaper {
+	return &Reaper{
+		store:     store,
+		lggr:      lggr.Named("WorkflowExecutionReaper"),
+		interval:  interval,
+		threshold: threshold,
+		stopCh:    make(chan struct{}),
+	}
+}
+
+func (r *Reaper) Start(_ context.Context) error {
+	return r.StartOnce("WorkflowExecutionReaper", func() error {
+		if r.interval == 0 {
+			r.lggr.Info("Workflow execution reaper is disabled")
+			return nil
+		}
+		r.wg.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *Reaper) Close() error {
+	return r.StopOnce("WorkflowExecutionReaper", func() error {
+		close(r.stopCh)
+		r.wg.Wait()
+		return nil
+	})
+}
+
+func (r *Reaper) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *Reaper) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+func (r *Reaper) run() {
+	defer r.wg.Done()
+
+	ticker := services.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+			r.reap()
+		}
+	}
+}
+
+func (r *Reaper) reap() {
+	ctx, cancel := r.stopCh.CtxCancel(context.WithTimeout(context.Background(), r.interval))
+	defer cancel()
+
+	start := time.Now()
+	deleted, err := r.store.DeleteFinishedOlderThan(ctx, r.threshold)
+	if err != nil {
+		r.lggr.Errorw("Failed to delete finished workflow executions", "err", err)
+		r.SvcErrBuffer.Append(err)
+		return
+	}
+	r.lggr.Debugw("Deleted finished workflow executions", "deleted", deleted, "threshold", r.threshold, "duration", time.Since(start))
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 )
 
 type Store interface {
@@ -9,7 +10,13 @@ type Store interface {
 	UpsertStep(ctx context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error)
 	UpdateStatus(ctx context.Context, executionID string, status string) error
 	Get(ctx context.Context, executionID string) (WorkflowExecution, error)
-	GetUnfinished(ctx context.Context, offset, limit int) ([]WorkflowExecution, error)
+	// GetUnfinished returns the executions of the given workflow which are still in progress.
+	GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error)
+	// List returns a page of the executions matching filter, most recent first and without their steps,
+	// along with the total number of matching executions.
+	List(ctx context.Context, filter ListFilter, offset, limit int) ([]WorkflowExecution, int, error)
+	// DeleteFinishedOlderThan deletes the executions which finished longer than threshold ago, along with their steps.
+	DeleteFinishedOlderThan(ctx context.Context, threshold time.Duration) (int64, error)
 }
 
 var _ Store = (*DBStore)(nil)
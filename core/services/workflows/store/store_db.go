@@ -2,8 +2,11 @@ package store
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -16,6 +19,7 @@ import (
 	valuespb "github.com/smartcontractkit/chainlink-common/pkg/values/pb"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 // `DBStore` is a postgres-backed
@@ -29,12 +33,14 @@ type DBStore struct {
 // `workflowExecutionRow` describes a row
 // of the `workflow_executions` table
 type workflowExecutionRow struct {
-	ID         string
-	WorkflowID *string
-	Status     string
-	CreatedAt  *time.Time
-	UpdatedAt  *time.Time
-	FinishedAt *time.Time
+	ID              string
+	WorkflowID      *string
+	WorkflowVersion string `db:"workflow_version"`
+	TriggerEventID  *string
+	Status          string
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+	FinishedAt      *time.Time
 }
 
 // `workflowStepRow` describes a row
@@ -45,8 +51,9 @@ type workflowStepRow struct {
 	Ref                 string
 	Status              string
 	Inputs              []byte
-	OutputErr           *string    `db:"output_err"`
-	OutputValue         []byte     `db:"output_value"`
+	OutputErr           *string `db:"output_err"`
+	OutputValue         []byte  `db:"output_value"`
+	Attempts            []byte
 	UpdatedAt           *time.Time `db:"updated_at"`
 }
 
@@ -59,20 +66,24 @@ type workflowExecutionWithStep struct {
 	WSInputs              []byte     `db:"ws_inputs"`
 	WSOutputErr           *string    `db:"ws_output_err"`
 	WSOutputValue         []byte     `db:"ws_output_value"`
+	WSAttempts            []byte     `db:"ws_attempts"`
 	WSUpdatedAt           *time.Time `db:"ws_updated_at"`
 
 	// WorkflowExecution fields
-	WEID         string     `db:"we_id"`
-	WEWorkflowID *string    `db:"we_workflow_id"`
-	WEStatus     string     `db:"we_status"`
-	WECreatedAt  *time.Time `db:"we_created_at"`
-	WEUpdatedAt  *time.Time `db:"we_updated_at"`
-	WEFinishedAt *time.Time `db:"we_finished_at"`
+	WEID              string     `db:"we_id"`
+	WEWorkflowID      *string    `db:"we_workflow_id"`
+	WEWorkflowVersion string     `db:"we_workflow_version"`
+	WETriggerEventID  *string    `db:"we_trigger_event_id"`
+	WEStatus          string     `db:"we_status"`
+	WECreatedAt       *time.Time `db:"we_created_at"`
+	WEUpdatedAt       *time.Time `db:"we_updated_at"`
+	WEFinishedAt      *time.Time `db:"we_finished_at"`
 }
 
 // `UpdateStatus` updates the status of the given workflow execution
 func (d *DBStore) UpdateStatus(ctx context.Context, executionID string, status string) error {
-	sql := `UPDATE workflow_executions SET status = $1, updated_at = $2 WHERE id = $3`
+	// A started execution is unfinished, which also covers an execution being resumed.
+	sql := `UPDATE workflow_executions SET status = $1, updated_at = $2, finished_at = NULL WHERE id = $3`
 
 	// If we're completing the workflow execution, let's also set a finished_at timestamp.
 	if status != StatusStarted {
@@ -100,10 +111,12 @@ func (d *DBStore) UpsertStep(ctx context.Context, stepState *WorkflowExecutionSt
 
 // Get fetches the ExecutionState from the database.
 func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecution, error) {
-	sql := `
+	query := `
     SELECT
 			workflow_executions.id AS we_id,
 			workflow_executions.workflow_id AS we_workflow_id,
+			workflow_executions.workflow_version AS we_workflow_version,
+			workflow_executions.trigger_event_id AS we_trigger_event_id,
 			workflow_executions.status AS we_status,
 			workflow_executions.created_at AS we_created_at,
 			workflow_executions.updated_at AS we_updated_at,
@@ -114,13 +127,14 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 			workflow_steps.inputs AS ws_inputs,
 			workflow_steps.output_err AS ws_output_err,
 			workflow_steps.output_value AS ws_output_value,
+			workflow_steps.attempts AS ws_attempts,
 			workflow_steps.updated_at AS ws_updated_at
 	FROM workflow_executions JOIN workflow_steps
 	ON workflow_executions.id = workflow_steps.workflow_execution_id
 	WHERE workflow_executions.id = $1`
 
 	var records []workflowExecutionWithStep
-	err := d.db.SelectContext(ctx, &records, sql, executionID)
+	err := d.db.SelectContext(ctx, &records, query, executionID)
 	if err != nil {
 		return WorkflowExecution{}, err
 	}
@@ -130,7 +144,7 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 	}
 	state, ok := idToExecutionState[executionID]
 	if !ok {
-		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s", executionID)
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", executionID, sql.ErrNoRows)
 	}
 	return *state, nil
 }
@@ -142,15 +156,21 @@ func workflowExecutionsWithStepToWorkflowExecutions(wews []workflowExecutionWith
 		if jr.WEWorkflowID != nil {
 			wid = *jr.WEWorkflowID
 		}
+		var teid string
+		if jr.WETriggerEventID != nil {
+			teid = *jr.WETriggerEventID
+		}
 		if _, ok := idToExecutionState[jr.WEID]; !ok {
 			idToExecutionState[jr.WEID] = &WorkflowExecution{
-				ExecutionID: jr.WEID,
-				WorkflowID:  wid,
-				Status:      jr.WEStatus,
-				Steps:       map[string]*WorkflowExecutionStep{},
-				CreatedAt:   jr.WECreatedAt,
-				UpdatedAt:   jr.WEUpdatedAt,
-				FinishedAt:  jr.WEFinishedAt,
+				ExecutionID:     jr.WEID,
+				WorkflowID:      wid,
+				WorkflowVersion: jr.WEWorkflowVersion,
+				TriggerEventID:  teid,
+				Status:          jr.WEStatus,
+				Steps:           map[string]*WorkflowExecutionStep{},
+				CreatedAt:       jr.WECreatedAt,
+				UpdatedAt:       jr.WEUpdatedAt,
+				FinishedAt:      jr.WEFinishedAt,
 			}
 		}
 
@@ -159,6 +179,7 @@ func workflowExecutionsWithStepToWorkflowExecutions(wews []workflowExecutionWith
 			Ref:                 jr.WSRef,
 			OutputErr:           jr.WSOutputErr,
 			OutputValue:         jr.WSOutputValue,
+			Attempts:            jr.WSAttempts,
 			Inputs:              jr.WSInputs,
 			Status:              jr.WSStatus,
 			UpdatedAt:           jr.WSUpdatedAt,
@@ -211,6 +232,14 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 		}
 	}
 
+	var attempts []StepAttempt
+	if len(step.Attempts) != 0 {
+		err := json.Unmarshal(step.Attempts, &attempts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &WorkflowExecutionStep{
 		ExecutionID: step.WorkflowExecutionID,
 		Ref:         step.Ref,
@@ -220,6 +249,7 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 			Err:   outputErr,
 			Value: outputs,
 		},
+		Attempts: attempts,
 	}, nil
 }
 
@@ -255,6 +285,15 @@ func stateToStep(state *WorkflowExecutionStep) (workflowStepRow, error) {
 		errs := state.Outputs.Err.Error()
 		wsr.OutputErr = &errs
 	}
+
+	if len(state.Attempts) != 0 {
+		ab, err := json.Marshal(state.Attempts)
+		if err != nil {
+			return workflowStepRow{}, err
+		}
+
+		wsr.Attempts = ab
+	}
 	return wsr, nil
 }
 
@@ -264,15 +303,20 @@ func (d *DBStore) Add(ctx context.Context, state *WorkflowExecution) (WorkflowEx
 	l := d.lggr.With("executionID", state.ExecutionID, "workflowID", state.WorkflowID, "status", state.Status)
 	var workflowExecution WorkflowExecution
 	err := d.transact(ctx, func(db *DBStore) error {
-		var wid *string
+		var wid, teid *string
 		if state.WorkflowID != "" {
 			wid = &state.WorkflowID
 		}
+		if state.TriggerEventID != "" {
+			teid = &state.TriggerEventID
+		}
 
 		wex := &workflowExecutionRow{
-			ID:         state.ExecutionID,
-			WorkflowID: wid,
-			Status:     state.Status,
+			ID:              state.ExecutionID,
+			WorkflowID:      wid,
+			WorkflowVersion: state.WorkflowVersion,
+			TriggerEventID:  teid,
+			Status:          state.Status,
 		}
 		l.Debug("Adding workflow execution")
 
@@ -280,18 +324,8 @@ func (d *DBStore) Add(ctx context.Context, state *WorkflowExecution) (WorkflowEx
 		if err != nil {
 			return fmt.Errorf("could not insert workflow execution %s: %w", state.ExecutionID, err)
 		}
-		workflowExecution = WorkflowExecution{
-			ExecutionID: dbWex.ID,
-			Status:      dbWex.Status,
-			Steps:       state.Steps,
-			CreatedAt:   dbWex.CreatedAt,
-			UpdatedAt:   dbWex.UpdatedAt,
-			FinishedAt:  dbWex.FinishedAt,
-		}
-		// Tests are not passing the ID, so to avoid a nil-pointer dereference, we added this check.
-		if wid != nil {
-			workflowExecution.WorkflowID = *wid
-		}
+		workflowExecution = rowToExecution(dbWex)
+		workflowExecution.Steps = state.Steps
 		var ws []workflowStepRow
 		for _, step := range state.Steps {
 			step, err := stateToStep(step)
@@ -318,8 +352,8 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 
 	sql := `
 	INSERT INTO
-	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at)
-	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :updated_at)
+	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, attempts, updated_at)
+	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :attempts, :updated_at)
 	ON CONFLICT ON CONSTRAINT uniq_workflow_execution_id_ref
 	DO UPDATE SET
 		workflow_execution_id = EXCLUDED.workflow_execution_id,
@@ -328,6 +362,7 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 		inputs = EXCLUDED.inputs,
 		output_err = EXCLUDED.output_err,
 		output_value = EXCLUDED.output_value,
+		attempts = EXCLUDED.attempts,
 		updated_at = EXCLUDED.updated_at;
 	`
 	stmt, args, err := sqlx.Named(sql, steps)
@@ -342,14 +377,34 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 func (d *DBStore) insertWorkflowExecution(ctx context.Context, execution *workflowExecutionRow) (*workflowExecutionRow, error) {
 	sql := `
 	INSERT INTO
-	workflow_executions(id, workflow_id, status, created_at)
-	VALUES ($1, $2, $3, $4) RETURNING *
+	workflow_executions(id, workflow_id, workflow_version, trigger_event_id, status, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6) RETURNING *
 	`
 	wex := &workflowExecutionRow{}
-	err := d.db.GetContext(ctx, wex, sql, execution.ID, execution.WorkflowID, execution.Status, d.clock.Now())
+	err := d.db.GetContext(ctx, wex, sql, execution.ID, execution.WorkflowID, execution.WorkflowVersion, execution.TriggerEventID, execution.Status, d.clock.Now())
 	return wex, err
 }
 
+// rowToExecution converts a row of the `workflow_executions` table, without steps.
+func rowToExecution(row *workflowExecutionRow) WorkflowExecution {
+	wex := WorkflowExecution{
+		ExecutionID:     row.ID,
+		WorkflowVersion: row.WorkflowVersion,
+		Status:          row.Status,
+		CreatedAt:       row.CreatedAt,
+		UpdatedAt:       row.UpdatedAt,
+		FinishedAt:      row.FinishedAt,
+	}
+	// Tests are not passing the ID, so to avoid a nil-pointer dereference, we added this check.
+	if row.WorkflowID != nil {
+		wex.WorkflowID = *row.WorkflowID
+	}
+	if row.TriggerEventID != nil {
+		wex.TriggerEventID = *row.TriggerEventID
+	}
+	return wex
+}
+
 func (d *DBStore) transact(ctx context.Context, fn func(*DBStore) error) error {
 	return sqlutil.Transact(
 		ctx,
@@ -362,7 +417,8 @@ func (d *DBStore) transact(ctx context.Context, fn func(*DBStore) error) error {
 	)
 }
 
-func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]WorkflowExecution, error) {
+// GetUnfinished returns the executions of the given workflow which are still in progress.
+func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error) {
 	sql := `
 	SELECT
 		workflow_steps.workflow_execution_id AS ws_workflow_execution_id,
@@ -371,9 +427,12 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 		workflow_steps.inputs AS ws_inputs,
 		workflow_steps.output_err AS ws_output_err,
 		workflow_steps.output_value AS ws_output_value,
+		workflow_steps.attempts AS ws_attempts,
 		workflow_steps.updated_at AS ws_updated_at,
 		workflow_executions.id AS we_id,
 		workflow_executions.workflow_id AS we_workflow_id,
+		workflow_executions.workflow_version AS we_workflow_version,
+		workflow_executions.trigger_event_id AS we_trigger_event_id,
 		workflow_executions.status AS we_status,
 		workflow_executions.created_at AS we_created_at,
 		workflow_executions.updated_at AS we_updated_at,
@@ -381,13 +440,13 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 	FROM workflow_executions
 	JOIN workflow_steps
 	ON  workflow_steps.workflow_execution_id = workflow_executions.id
-	WHERE workflow_executions.status = $1
+	WHERE workflow_executions.workflow_id = $1 AND workflow_executions.status = $2
 	ORDER BY workflow_executions.created_at DESC
-	LIMIT $2
-	OFFSET $3
+	LIMIT $3
+	OFFSET $4
 	`
 	var joinRecords []workflowExecutionWithStep
-	err := d.db.SelectContext(ctx, &joinRecords, sql, StatusStarted, limit, offset)
+	err := d.db.SelectContext(ctx, &joinRecords, sql, workflowID, StatusStarted, limit, offset)
 	if err != nil {
 		return []WorkflowExecution{}, err
 	}
@@ -405,6 +464,86 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 	return states, nil
 }
 
+// List returns a page of the executions matching filter, most recent first and without their steps,
+// along with the total number of matching executions.
+func (d *DBStore) List(ctx context.Context, filter ListFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	where := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.WorkflowID != "" {
+		where("workflow_id = $%d", filter.WorkflowID)
+	}
+	if filter.Status != "" {
+		where("status = $%d", filter.Status)
+	}
+	if filter.TriggerEventID != "" {
+		where("trigger_event_id = $%d", filter.TriggerEventID)
+	}
+	if filter.CreatedAfter != nil {
+		where("created_at >= $%d", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		where("created_at <= $%d", *filter.CreatedBefore)
+	}
+	var whereClause string
+	if len(conditions) > 0 {
+		whereClause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var count int
+	err := d.db.GetContext(ctx, &count, `SELECT count(*) FROM workflow_executions`+whereClause, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count workflow executions: %w", err)
+	}
+
+	query := fmt.Sprintf(`SELECT * FROM workflow_executions%s ORDER BY created_at DESC, id ASC LIMIT $%d OFFSET $%d`,
+		whereClause, len(args)+1, len(args)+2)
+	var rows []workflowExecutionRow
+	err = d.db.SelectContext(ctx, &rows, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list workflow executions: %w", err)
+	}
+
+	executions := make([]WorkflowExecution, len(rows))
+	for i := range rows {
+		executions[i] = rowToExecution(&rows[i])
+	}
+	return executions, count, nil
+}
+
+// DeleteFinishedOlderThan deletes the executions which finished longer than threshold ago, in batches.
+// Their steps are deleted by cascade.
+func (d *DBStore) DeleteFinishedOlderThan(ctx context.Context, threshold time.Duration) (int64, error) {
+	before := d.clock.Now().Add(-threshold)
+
+	var deleted int64
+	err := pg.Batch(func(_, limit uint) (count uint, err error) {
+		result, err := d.db.ExecContext(ctx, `
+DELETE FROM workflow_executions
+WHERE id IN (
+	SELECT id FROM workflow_executions
+	WHERE finished_at < $1
+	ORDER BY finished_at ASC
+	LIMIT $2
+)`, before, limit)
+		if err != nil {
+			return count, fmt.Errorf("failed to delete finished workflow executions: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return count, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		deleted += rowsAffected
+		return uint(rowsAffected), nil
+	})
+	return deleted, err
+}
+
 func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock) *DBStore {
 	return &DBStore{db: ds, lggr: lggr.Named("WorkflowDBStore"), clock: clock}
 }
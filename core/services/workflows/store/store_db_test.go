@@ -2,9 +2,11 @@ package store
 
 import (
 	"crypto/rand"
+	"database/sql"
 	"encoding/hex"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
@@ -31,6 +33,13 @@ func newTestDBStore(t *testing.T) *DBStore {
 	return &DBStore{db: db, lggr: logger.TestLogger(t), clock: clockwork.NewFakeClock()}
 }
 
+// insertWorkflowSpec inserts a spec for the executions of workflowID to reference.
+func insertWorkflowSpec(t *testing.T, store *DBStore, workflowID string) {
+	_, err := store.db.ExecContext(tests.Context(t), `INSERT INTO workflow_specs (workflow, workflow_id, workflow_owner, workflow_name, created_at, updated_at)
+	VALUES ('', $1, '', $1, NOW(), NOW())`, workflowID)
+	require.NoError(t, err)
+}
+
 func Test_StoreDB(t *testing.T) {
 	store := newTestDBStore(t)
 
@@ -157,6 +166,11 @@ func Test_StoreDB_UpdateStep(t *testing.T) {
 
 	stepOne.Inputs = nm
 	stepOne.Outputs = StepOutput{Err: errors.New("some error")}
+	startedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stepOne.Attempts = []StepAttempt{
+		{StartedAt: startedAt, FinishedAt: startedAt.Add(time.Second), Err: "some error"},
+		{StartedAt: startedAt.Add(2 * time.Second), FinishedAt: startedAt.Add(3 * time.Second), Err: "some error"},
+	}
 
 	es, err = store.UpsertStep(tests.Context(t), stepOne)
 	require.NoError(t, err)
@@ -236,6 +250,9 @@ func Test_StoreDB_WorkflowStepStatus(t *testing.T) {
 
 func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	store := newTestDBStore(t)
+	workflowID, otherWorkflowID := randomID(), randomID()
+	insertWorkflowSpec(t, store, workflowID)
+	insertWorkflowSpec(t, store, otherWorkflowID)
 
 	id := randomID()
 	stepOne := &WorkflowExecutionStep{
@@ -253,8 +270,10 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 			"step1": stepOne,
 			"step2": stepTwo,
 		},
-		ExecutionID: id,
-		Status:      StatusStarted,
+		ExecutionID:     id,
+		WorkflowID:      workflowID,
+		WorkflowVersion: "2",
+		Status:          StatusStarted,
 	}
 
 	_, err := store.Add(tests.Context(t), &es)
@@ -263,13 +282,26 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	id = randomID()
 	esTwo := WorkflowExecution{
 		ExecutionID: id,
+		WorkflowID:  workflowID,
 		Status:      StatusCompleted,
 		Steps:       map[string]*WorkflowExecutionStep{},
 	}
 	_, err = store.Add(tests.Context(t), &esTwo)
 	require.NoError(t, err)
 
-	states, err := store.GetUnfinished(tests.Context(t), 0, 100)
+	// the unfinished executions of other workflows, e.g. other versions of it, are left out
+	id = randomID()
+	esOther := WorkflowExecution{
+		ExecutionID:     id,
+		WorkflowID:      otherWorkflowID,
+		WorkflowVersion: "1",
+		Status:          StatusStarted,
+		Steps:           map[string]*WorkflowExecutionStep{},
+	}
+	_, err = store.Add(tests.Context(t), &esOther)
+	require.NoError(t, err)
+
+	states, err := store.GetUnfinished(tests.Context(t), workflowID, 0, 100)
 	require.NoError(t, err)
 
 	assert.Len(t, states, 1)
@@ -277,3 +309,97 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	states[0].CreatedAt = nil
 	assert.Equal(t, es, states[0])
 }
+
+func Test_StoreDB_List(t *testing.T) {
+	store := newTestDBStore(t)
+	clock := store.clock.(clockwork.FakeClock)
+	ctx := tests.Context(t)
+
+	add := func(status, triggerEventID string) WorkflowExecution {
+		es := WorkflowExecution{
+			ExecutionID:    randomID(),
+			TriggerEventID: triggerEventID,
+			Status:         status,
+			Steps:          map[string]*WorkflowExecutionStep{},
+		}
+		_, err := store.Add(ctx, &es)
+		require.NoError(t, err)
+		clock.Advance(time.Minute)
+		return es
+	}
+	first := add(StatusCompleted, "event-1")
+	second := add(StatusErrored, "event-2")
+	third := add(StatusCompleted, "event-2")
+
+	ids := func(executions []WorkflowExecution) (ids []string) {
+		for _, es := range executions {
+			ids = append(ids, es.ExecutionID)
+		}
+		return
+	}
+
+	executions, count, err := store.List(ctx, ListFilter{}, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []string{third.ExecutionID, second.ExecutionID}, ids(executions), "most recent first")
+	assert.Equal(t, "event-2", executions[0].TriggerEventID)
+
+	executions, count, err = store.List(ctx, ListFilter{}, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []string{first.ExecutionID}, ids(executions))
+
+	executions, count, err = store.List(ctx, ListFilter{TriggerEventID: "event-2", Status: StatusCompleted}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []string{third.ExecutionID}, ids(executions))
+
+	createdAfter := clock.Now().Add(-3 * time.Minute)
+	createdBefore := clock.Now().Add(-2 * time.Minute)
+	executions, count, err = store.List(ctx, ListFilter{CreatedAfter: &createdAfter, CreatedBefore: &createdBefore}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{second.ExecutionID, first.ExecutionID}, ids(executions))
+}
+
+func Test_StoreDB_DeleteFinishedOlderThan(t *testing.T) {
+	store := newTestDBStore(t)
+	clock := store.clock.(clockwork.FakeClock)
+	ctx := tests.Context(t)
+
+	add := func() string {
+		id := randomID()
+		es := WorkflowExecution{
+			ExecutionID: id,
+			Status:      StatusStarted,
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: StatusCompleted},
+			},
+		}
+		_, err := store.Add(ctx, &es)
+		require.NoError(t, err)
+		return id
+	}
+	old := add()
+	require.NoError(t, store.UpdateStatus(ctx, old, StatusCompleted))
+	unfinished := add()
+
+	clock.Advance(2 * time.Hour)
+	recent := add()
+	require.NoError(t, store.UpdateStatus(ctx, recent, StatusErrored))
+
+	deleted, err := store.DeleteFinishedOlderThan(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, err = store.Get(ctx, old)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = store.Get(ctx, unfinished)
+	require.NoError(t, err)
+	_, err = store.Get(ctx, recent)
+	require.NoError(t, err)
+
+	var steps int
+	require.NoError(t, store.db.GetContext(ctx, &steps, `SELECT count(*) FROM workflow_steps WHERE workflow_execution_id = $1`, old))
+	assert.Zero(t, steps)
+}
@@ -0,0 +1,188 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+// `MemoryStore` is an in-memory data store of workflow progress,
+// for running workflows without a database, e.g. when simulating them.
+type MemoryStore struct {
+	mu         sync.RWMutex
+	clock      clockwork.Clock
+	executions map[string]*WorkflowExecution
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+func NewMemoryStore(clock clockwork.Clock) *MemoryStore {
+	return &MemoryStore{clock: clock, executions: map[string]*WorkflowExecution{}}
+}
+
+// Add stores a copy of the passed in execution and its steps.
+func (m *MemoryStore) Add(_ context.Context, state *WorkflowExecution) (WorkflowExecution, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.executions[state.ExecutionID]; ok {
+		return WorkflowExecution{}, fmt.Errorf("could not insert workflow execution %s: already exists", state.ExecutionID)
+	}
+
+	now := m.clock.Now()
+	wex := &WorkflowExecution{
+		Steps:           map[string]*WorkflowExecutionStep{},
+		ExecutionID:     state.ExecutionID,
+		WorkflowID:      state.WorkflowID,
+		WorkflowVersion: state.WorkflowVersion,
+		TriggerEventID:  state.TriggerEventID,
+		Status:          state.Status,
+		CreatedAt:       &now,
+	}
+	for ref, step := range state.Steps {
+		s := *step
+		s.Attempts = slices.Clone(step.Attempts)
+		s.UpdatedAt = &now
+		wex.Steps[ref] = &s
+	}
+	m.executions[state.ExecutionID] = wex
+	return copyExecution(wex), nil
+}
+
+// UpsertStep inserts or replaces the step with the same ref.
+func (m *MemoryStore) UpsertStep(_ context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	wex, ok := m.executions[step.ExecutionID]
+	if !ok {
+		return WorkflowExecution{}, fmt.Errorf("workflow execution %s not found", step.ExecutionID)
+	}
+	now := m.clock.Now()
+	s := *step
+	s.Attempts = slices.Clone(step.Attempts)
+	s.UpdatedAt = &now
+	wex.Steps[s.Ref] = &s
+	return copyExecution(wex), nil
+}
+
+// UpdateStatus updates the status of the given workflow execution.
+func (m *MemoryStore) UpdateStatus(_ context.Context, executionID string, status string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	wex, ok := m.executions[executionID]
+	if !ok {
+		return nil
+	}
+	now := m.clock.Now()
+	wex.Status = status
+	wex.UpdatedAt = &now
+	// If we're completing the workflow execution, let's also set a finished_at timestamp.
+	if status != StatusStarted {
+		wex.FinishedAt = &now
+	} else {
+		wex.FinishedAt = nil
+	}
+	return nil
+}
+
+func (m *MemoryStore) Get(_ context.Context, executionID string) (WorkflowExecution, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	wex, ok := m.executions[executionID]
+	if !ok {
+		return WorkflowExecution{}, fmt.Errorf("workflow execution %s not found", executionID)
+	}
+	return copyExecution(wex), nil
+}
+
+func (m *MemoryStore) GetUnfinished(_ context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	executions := m.sorted(func(wex *WorkflowExecution) bool {
+		return wex.WorkflowID == workflowID && wex.Status == StatusStarted
+	})
+	return page(executions, offset, limit), nil
+}
+
+// List returns a page of the executions matching filter, most recent first and without their steps,
+// along with the total number of matching executions.
+func (m *MemoryStore) List(_ context.Context, filter ListFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	executions := m.sorted(func(wex *WorkflowExecution) bool {
+		return (filter.WorkflowID == "" || wex.WorkflowID == filter.WorkflowID) &&
+			(filter.Status == "" || wex.Status == filter.Status) &&
+			(filter.TriggerEventID == "" || wex.TriggerEventID == filter.TriggerEventID) &&
+			(filter.CreatedAfter == nil || !wex.CreatedAt.Before(*filter.CreatedAfter)) &&
+			(filter.CreatedBefore == nil || !wex.CreatedAt.After(*filter.CreatedBefore))
+	})
+	for i := range executions {
+		executions[i].Steps = nil
+	}
+	return page(executions, offset, limit), len(executions), nil
+}
+
+// DeleteFinishedOlderThan deletes the executions which finished longer than threshold ago, along with their steps.
+func (m *MemoryStore) DeleteFinishedOlderThan(_ context.Context, threshold time.Duration) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	before := m.clock.Now().Add(-threshold)
+	var deleted int64
+	for id, wex := range m.executions {
+		if wex.FinishedAt != nil && wex.FinishedAt.Before(before) {
+			delete(m.executions, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// sorted returns copies of the executions matching the predicate, most recent first.
+func (m *MemoryStore) sorted(match func(*WorkflowExecution) bool) []WorkflowExecution {
+	var executions []WorkflowExecution
+	for _, wex := range m.executions {
+		if match(wex) {
+			executions = append(executions, copyExecution(wex))
+		}
+	}
+	slices.SortFunc(executions, func(a, b WorkflowExecution) int {
+		if c := b.CreatedAt.Compare(*a.CreatedAt); c != 0 {
+			return c
+		}
+		if a.ExecutionID < b.ExecutionID {
+			return -1
+		}
+		return 1
+	})
+	return executions
+}
+
+func page(executions []WorkflowExecution, offset, limit int) []WorkflowExecution {
+	if offset >= len(executions) {
+		return nil
+	}
+	return executions[offset:min(offset+limit, len(executions))]
+}
+
+// copyExecution copies an execution so that it can be handed out without racing with later updates.
+// Step values are immutable and therefore shared.
+func copyExecution(wex *WorkflowExecution) WorkflowExecution {
+	c := *wex
+	c.Steps = make(map[string]*WorkflowExecutionStep, len(wex.Steps))
+	for ref, step := range wex.Steps {
+		s := *step
+		s.Attempts = slices.Clone(step.Attempts)
+		c.Steps[ref] = &s
+	}
+	return c
+}
@@ -0,0 +1,84 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+)
+
+func Test_MemoryStore(t *testing.T) {
+	ctx := tests.Context(t)
+	clock := clockwork.NewFakeClock()
+	ms := NewMemoryStore(clock)
+
+	outputs, err := values.NewMap(map[string]any{"value": 1})
+	require.NoError(t, err)
+	add := func(id string) {
+		_, err := ms.Add(ctx, &WorkflowExecution{
+			ExecutionID: id,
+			WorkflowID:  "workflow",
+			Status:      StatusStarted,
+			Steps: map[string]*WorkflowExecutionStep{
+				"trigger": {ExecutionID: id, Ref: "trigger", Status: StatusCompleted, Outputs: StepOutput{Value: outputs}},
+			},
+		})
+		require.NoError(t, err)
+		clock.Advance(time.Minute)
+	}
+	add("1")
+	add("2")
+	add("3")
+
+	_, err = ms.Add(ctx, &WorkflowExecution{ExecutionID: "1"})
+	assert.ErrorContains(t, err, "already exists")
+
+	wex, err := ms.UpsertStep(ctx, &WorkflowExecutionStep{ExecutionID: "1", Ref: "step", Status: StatusStarted})
+	require.NoError(t, err)
+	assert.Len(t, wex.Steps, 2)
+	// executions handed out are copies
+	wex.Steps["step"].Status = StatusErrored
+	got, err := ms.Get(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, StatusStarted, got.Steps["step"].Status)
+
+	require.NoError(t, ms.UpdateStatus(ctx, "1", StatusCompleted))
+	got, err = ms.Get(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, StatusCompleted, got.Status)
+	assert.NotNil(t, got.FinishedAt)
+	// restarting an execution unfinishes it
+	require.NoError(t, ms.UpdateStatus(ctx, "1", StatusStarted))
+	got, err = ms.Get(ctx, "1")
+	require.NoError(t, err)
+	assert.Nil(t, got.FinishedAt)
+	require.NoError(t, ms.UpdateStatus(ctx, "1", StatusCompleted))
+
+	unfinished, err := ms.GetUnfinished(ctx, "workflow", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, unfinished, 2)
+	assert.Equal(t, "3", unfinished[0].ExecutionID)
+	assert.Len(t, unfinished[0].Steps, 1)
+	unfinished, err = ms.GetUnfinished(ctx, "other-workflow", 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, unfinished)
+
+	listed, count, err := ms.List(ctx, ListFilter{WorkflowID: "workflow"}, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, listed, 1)
+	assert.Equal(t, "2", listed[0].ExecutionID)
+	assert.Empty(t, listed[0].Steps)
+
+	clock.Advance(time.Hour)
+	deleted, err := ms.DeleteFinishedOlderThan(ctx, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	_, err = ms.Get(ctx, "1")
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,57 @@
+package workflows
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const (
+	executionSpanName = "workflow execution"
+	stepSpanName      = "workflow step"
+)
+
+// startExecutionSpan starts the span of an execution, which is the root of its trace, and returns a context carrying it.
+// The spans of its steps, and of the remote capability calls they make, are children of it.
+func (e *Engine) startExecutionSpan(ctx context.Context, executionID string) context.Context {
+	ctx, _ = otel.Tracer("").Start(ctx, executionSpanName, trace.WithAttributes(
+		attribute.String(wIDKey, e.workflow.id),
+		attribute.String(woIDKey, e.workflow.owner),
+		attribute.String(wnKey, e.workflow.name),
+		attribute.String(eIDKey, executionID),
+		attribute.Int64(dIDKey, int64(e.localNode.WorkflowDON.ID)),
+	))
+	return ctx
+}
+
+// startStepSpan starts the span of a step of an execution, within the span of the execution.
+func (e *Engine) startStepSpan(ctx context.Context, executionID string, stepRef string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String(wIDKey, e.workflow.id),
+		attribute.String(eIDKey, executionID),
+		attribute.String(sRKey, stepRef),
+	}
+	if s, err := e.workflow.Vertex(stepRef); err == nil {
+		attrs = append(attrs, attribute.String(cIDKey, s.ID))
+		if s.info.DON != nil {
+			attrs = append(attrs, attribute.Int64(dIDKey, int64(s.info.DON.ID)))
+		}
+	}
+	return otel.Tracer("").Start(ctx, stepSpanName, trace.WithAttributes(attrs...))
+}
+
+// setSpanStatus records the outcome of an execution or step on its span.
+func setSpanStatus(span trace.Span, status string, err error) {
+	span.SetAttributes(attribute.String("status", status))
+	if err != nil {
+		span.RecordError(err)
+	}
+	if status == store.StatusErrored || status == store.StatusTimeout {
+		span.SetStatus(codes.Error, status)
+	}
+}
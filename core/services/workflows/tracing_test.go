@@ -0,0 +1,71 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+// Not parallel, since it installs a global tracer provider.
+func TestEngine_TracesExecutions(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	provider := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(provider)
+		assert.NoError(t, tp.Shutdown(testutils.Context(t)))
+	})
+
+	eng, hooks, _, _, _ := newFlakyTargetEngine(t)
+	eid := getExecutionId(t, eng, hooks)
+
+	executionSpans := func() (execution sdktrace.ReadOnlySpan, steps map[string]sdktrace.ReadOnlySpan) {
+		steps = map[string]sdktrace.ReadOnlySpan{}
+		for _, span := range recorder.Ended() {
+			attrs := map[attribute.Key]attribute.Value{}
+			for _, kv := range span.Attributes() {
+				attrs[kv.Key] = kv.Value
+			}
+			if attrs[eIDKey].AsString() != eid {
+				continue
+			}
+			switch span.Name() {
+			case executionSpanName:
+				execution = span
+			case stepSpanName:
+				steps[attrs[sRKey].AsString()] = span
+			}
+		}
+		return execution, steps
+	}
+	testutils.RequireEventually(t, func() bool {
+		execution, steps := executionSpans()
+		return execution != nil && len(steps) == 2
+	})
+
+	execution, steps := executionSpans()
+	assert.Contains(t, execution.Attributes(), attribute.String(wIDKey, testWorkflowId))
+	assert.Contains(t, execution.Attributes(), attribute.String("status", "errored"))
+	assert.Equal(t, codes.Error, execution.Status().Code)
+
+	consensus := steps["evm_median"]
+	assert.Equal(t, execution.SpanContext().TraceID(), consensus.SpanContext().TraceID())
+	assert.Equal(t, execution.SpanContext().SpanID(), consensus.Parent().SpanID())
+	assert.Contains(t, consensus.Attributes(), attribute.String(cIDKey, "offchain_reporting@1.0.0"))
+	assert.Equal(t, codes.Unset, consensus.Status().Code)
+
+	target := steps[targetRef]
+	assert.Equal(t, execution.SpanContext().SpanID(), target.Parent().SpanID())
+	assert.Equal(t, codes.Error, target.Status().Code)
+	require.Len(t, target.Events(), 1)
+	assert.Equal(t, "exception", target.Events()[0].Name)
+}
@@ -0,0 +1,90 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+var (
+	// ErrNotWorkflowJob is returned when versioning a job which doesn't run a workflow.
+	ErrNotWorkflowJob = errors.New("not a workflow job")
+	// ErrInvalidWorkflowUpgrade is returned when a job can't be deployed as a new version of a workflow.
+	ErrInvalidWorkflowUpgrade = errors.New("invalid workflow upgrade")
+	// ErrWorkflowVersionRetired is returned when upgrading or rolling back a version of a workflow which isn't the active one.
+	ErrWorkflowVersionRetired = errors.New("workflow version is retired, only the active version can be upgraded or rolled back")
+	// ErrNoPreviousWorkflowVersion is returned when rolling back a workflow which has a single version.
+	ErrNoPreviousWorkflowVersion = errors.New("workflow has no previous version to roll back to")
+)
+
+// ValidateUpgrade checks that next can be deployed as the new version of the workflow of the current job,
+// which must be its active version.
+func ValidateUpgrade(current job.Job, next job.Job) error {
+	if current.WorkflowSpec == nil {
+		return fmt.Errorf("%w: job %d", ErrNotWorkflowJob, current.ID)
+	}
+	if current.WorkflowSpec.Retired {
+		return fmt.Errorf("%w: job %d", ErrWorkflowVersionRetired, current.ID)
+	}
+	if next.Type != job.Workflow || next.WorkflowSpec == nil {
+		return fmt.Errorf("%w: the new version must be a %s job", ErrInvalidWorkflowUpgrade, job.Workflow)
+	}
+
+	cur, nxt := current.WorkflowSpec, next.WorkflowSpec
+	if nxt.WorkflowOwner != cur.WorkflowOwner || nxt.WorkflowName != cur.WorkflowName {
+		return fmt.Errorf("%w: the new version is of workflow %s owned by %s, expected %s owned by %s", ErrInvalidWorkflowUpgrade,
+			nxt.WorkflowName, nxt.WorkflowOwner, cur.WorkflowName, cur.WorkflowOwner)
+	}
+	if nxt.WorkflowVersion == "" || nxt.WorkflowVersion == cur.WorkflowVersion {
+		return fmt.Errorf("%w: the new version must set a workflow_version other than %q", ErrInvalidWorkflowUpgrade, cur.WorkflowVersion)
+	}
+	// The workflow ID is derived from the workflow and its config, and identifies the executions of a version.
+	if nxt.WorkflowID == cur.WorkflowID {
+		return fmt.Errorf("%w: neither the workflow nor its config changed", ErrInvalidWorkflowUpgrade)
+	}
+	return nil
+}
+
+// Drain retires the version of the workflow run by the engine, when a newer version takes over from it,
+// or it's rolled back. Its triggers are deregistered so that it starts no new executions,
+// while the executions in progress run to completion.
+func (e *Engine) Drain(ctx context.Context) {
+	e.triggersMu.Lock()
+	defer e.triggersMu.Unlock()
+	if e.draining {
+		return
+	}
+	e.draining = true
+	e.logger.Infow("draining retired workflow version", "executionsInProgress", e.stepUpdatesChMap.len())
+	if err := e.deregisterTriggers(ctx); err != nil {
+		e.logger.Errorf("failed to deregister triggers: %v", err)
+	}
+}
+
+// Activate reinstates the version of the workflow run by a draining engine, when the workflow is rolled back to it.
+// Its triggers are registered again, unless the engine is still initializing, in which case it registers them
+// once it's done.
+func (e *Engine) Activate(_ context.Context) {
+	// The request may be cancelled as soon as the engine is marked active, so the triggers are registered within the
+	// engine's context.
+	ctx, cancel := e.stopCh.NewCtx()
+	defer cancel()
+	e.triggersMu.Lock()
+	defer e.triggersMu.Unlock()
+	if !e.draining {
+		return
+	}
+	e.draining = false
+	e.logger.Info("reinstating workflow version")
+	if e.initialized {
+		e.registerTriggers(ctx)
+	}
+}
+
+func (e *Engine) isDraining() bool {
+	e.triggersMu.Lock()
+	defer e.triggersMu.Unlock()
+	return e.draining
+}
@@ -0,0 +1,185 @@
+package workflows
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// registrationTrackingTrigger keeps track of whether the trigger is registered.
+type registrationTrackingTrigger struct {
+	*mockTriggerCapability
+	mu         sync.Mutex
+	registered bool
+}
+
+func (r *registrationTrackingTrigger) RegisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	r.mu.Lock()
+	r.registered = true
+	r.mu.Unlock()
+	return r.mockTriggerCapability.RegisterTrigger(ctx, req)
+}
+
+func (r *registrationTrackingTrigger) UnregisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) error {
+	r.mu.Lock()
+	r.registered = false
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *registrationTrackingTrigger) isRegistered() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.registered
+}
+
+func unfinishedExecution(t *testing.T, workflowID, executionID string) *store.WorkflowExecution {
+	resp, err := values.NewMap(map[string]any{
+		"123": decimal.NewFromFloat(1.00),
+		"456": decimal.NewFromFloat(1.25),
+		"789": decimal.NewFromFloat(1.50),
+	})
+	require.NoError(t, err)
+	return &store.WorkflowExecution{
+		Steps: map[string]*store.WorkflowExecutionStep{
+			workflows.KeywordTrigger: {
+				Outputs:     store.StepOutput{Value: resp},
+				Status:      store.StatusCompleted,
+				ExecutionID: executionID,
+				Ref:         workflows.KeywordTrigger,
+			},
+		},
+		WorkflowID:      workflowID,
+		WorkflowVersion: "1",
+		ExecutionID:     executionID,
+		Status:          store.StatusStarted,
+	}
+}
+
+func TestEngine_RetiredVersionDrainsItsExecutions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger := &registrationTrackingTrigger{mockTriggerCapability: mockNoopTrigger(t).(*mockTriggerCapability)}
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	var executions store.Store
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		executions = store.NewMemoryStore(c.clock)
+		c.Store = executions
+		c.WorkflowVersion = "1"
+		c.Retired = true
+	})
+	_, err := executions.Add(ctx, unfinishedExecution(t, testWorkflowId, "<execution-ID>"))
+	require.NoError(t, err)
+	// the executions of the other versions of the workflow are theirs to finish
+	_, err = executions.Add(ctx, unfinishedExecution(t, "<other-workflow-ID>", "<other-execution-ID>"))
+	require.NoError(t, err)
+	servicetest.Run(t, eng)
+
+	assert.Equal(t, "<execution-ID>", getExecutionId(t, eng, hooks))
+	drained, err := executions.Get(ctx, "<execution-ID>")
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, drained.Status)
+	assert.Equal(t, "1", drained.WorkflowVersion)
+
+	other, err := executions.Get(ctx, "<other-execution-ID>")
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusStarted, other.Status)
+
+	<-hooks.initSuccessful
+	assert.False(t, trigger.isRegistered(), "a retired version takes no new trigger events")
+}
+
+func TestEngine_DrainAndActivate(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	mt, event := mockTrigger(t)
+	trigger := &registrationTrackingTrigger{mockTriggerCapability: mt.(*mockTriggerCapability)}
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.Store = store.NewMemoryStore(c.clock)
+		c.WorkflowVersion = "2"
+	})
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.Equal(t, "2", state.WorkflowVersion, "executions are tagged with the version of the workflow")
+
+	eng.Drain(ctx)
+	assert.False(t, trigger.isRegistered())
+	eng.Drain(ctx) // draining is idempotent
+
+	eng.Activate(ctx)
+	assert.True(t, trigger.isRegistered())
+	// the event sent again on registration is a duplicate of the first one, so it's the next one which is executed
+	event.Event.ID = "event-2"
+	trigger.ch <- event
+
+	expected, err := generateExecutionID(testWorkflowId, "event-2")
+	require.NoError(t, err)
+	assert.Equal(t, expected, getExecutionId(t, eng, hooks))
+}
+
+func TestValidateUpgrade(t *testing.T) {
+	t.Parallel()
+	current := job.Job{ID: 1, Type: job.Workflow, WorkflowSpec: &job.WorkflowSpec{
+		WorkflowID: "<workflow-ID>", WorkflowOwner: "owner", WorkflowName: "name", WorkflowVersion: "1",
+	}}
+	next := func(modify func(s *job.WorkflowSpec)) job.Job {
+		s := job.WorkflowSpec{WorkflowID: "<new-workflow-ID>", WorkflowOwner: "owner", WorkflowName: "name", WorkflowVersion: "2"}
+		modify(&s)
+		return job.Job{Type: job.Workflow, WorkflowSpec: &s}
+	}
+	require.NoError(t, ValidateUpgrade(current, next(func(s *job.WorkflowSpec) {})))
+
+	for _, tc := range []struct {
+		name string
+		next job.Job
+		err  string
+	}{
+		{"different name", next(func(s *job.WorkflowSpec) { s.WorkflowName = "other" }), "expected name owned by owner"},
+		{"different owner", next(func(s *job.WorkflowSpec) { s.WorkflowOwner = "other" }), "expected name owned by owner"},
+		{"missing version", next(func(s *job.WorkflowSpec) { s.WorkflowVersion = "" }), "must set a workflow_version"},
+		{"same version", next(func(s *job.WorkflowSpec) { s.WorkflowVersion = "1" }), "must set a workflow_version"},
+		{"same workflow", next(func(s *job.WorkflowSpec) { s.WorkflowID = "<workflow-ID>" }), "neither the workflow nor its config changed"},
+		{"not a workflow", job.Job{Type: job.Cron}, "must be a workflow job"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateUpgrade(current, tc.next)
+			require.ErrorIs(t, err, ErrInvalidWorkflowUpgrade)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	retired := current
+	retired.WorkflowSpec = &job.WorkflowSpec{Retired: true}
+	require.ErrorIs(t, ValidateUpgrade(retired, next(func(s *job.WorkflowSpec) {})), ErrWorkflowVersionRetired)
+	require.ErrorIs(t, ValidateUpgrade(job.Job{Type: job.Cron}, next(func(s *job.WorkflowSpec) {})), ErrNotWorkflowJob)
+}
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE pipeline_run_replay_bundles (
+    pipeline_run_id BIGINT PRIMARY KEY REFERENCES pipeline_runs (id) ON DELETE CASCADE,
+    bundle JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE pipeline_run_replay_bundles;
+
+-- +goose StatementEnd
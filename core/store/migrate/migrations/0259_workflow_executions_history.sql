@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE workflow_executions ADD COLUMN trigger_event_id text;
+
+CREATE INDEX idx_workflow_executions_workflow_id_created_at ON workflow_executions (workflow_id, created_at);
+CREATE INDEX idx_workflow_executions_created_at ON workflow_executions (created_at);
+CREATE INDEX idx_workflow_executions_finished_at ON workflow_executions (finished_at) WHERE finished_at IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX idx_workflow_executions_finished_at;
+DROP INDEX idx_workflow_executions_created_at;
+DROP INDEX idx_workflow_executions_workflow_id_created_at;
+
+ALTER TABLE workflow_executions DROP COLUMN trigger_event_id;
+
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TYPE workflow_status ADD VALUE 'cancelled';
+
+-- +goose StatementBegin
+ALTER TABLE workflow_specs
+    ADD COLUMN max_concurrent_executions integer NOT NULL DEFAULT 0,
+    ADD COLUMN concurrency_mode varchar(255) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE workflow_specs
+    DROP COLUMN max_concurrent_executions,
+    DROP COLUMN concurrency_mode;
+-- +goose StatementEnd
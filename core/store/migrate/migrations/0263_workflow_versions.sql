@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE workflow_specs
+    ADD COLUMN workflow_version varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN retired boolean NOT NULL DEFAULT false,
+    DROP CONSTRAINT unique_workflow_owner_name,
+    ADD CONSTRAINT unique_workflow_owner_name_version unique (workflow_owner, workflow_name, workflow_version);
+
+-- only one version of a workflow takes new trigger events, while retired ones drain their executions
+CREATE UNIQUE INDEX idx_unique_active_workflow_owner_name ON workflow_specs (workflow_owner, workflow_name) WHERE NOT retired;
+
+ALTER TABLE workflow_executions
+    ADD COLUMN workflow_version varchar(255) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE workflow_executions
+    DROP COLUMN workflow_version;
+
+DROP INDEX idx_unique_active_workflow_owner_name;
+
+ALTER TABLE workflow_specs
+    DROP CONSTRAINT unique_workflow_owner_name_version,
+    DROP COLUMN retired,
+    DROP COLUMN workflow_version,
+    ADD CONSTRAINT unique_workflow_owner_name unique (workflow_owner, workflow_name);
+-- +goose StatementEnd
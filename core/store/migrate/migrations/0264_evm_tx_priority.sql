@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the priority lane of the transaction: 1 for critical, 0 for normal and -1 for bulk
+ALTER TABLE evm.txes ADD COLUMN priority smallint NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE evm.txes DROP COLUMN priority;
+-- +goose StatementEnd
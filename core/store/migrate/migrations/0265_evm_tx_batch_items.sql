@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the transactions merged into a Multicall3 batch transaction, kept for resuming their pipeline runs once the batch is confirmed
+CREATE TABLE evm.tx_batch_items (
+    id BIGSERIAL PRIMARY KEY,
+    batch_tx_id bigint NOT NULL REFERENCES evm.txes (id) ON DELETE CASCADE,
+    batch_index integer NOT NULL,
+    tx_id bigint NOT NULL,
+    to_address bytea NOT NULL,
+    encoded_payload bytea NOT NULL,
+    value numeric(78, 0) NOT NULL,
+    gas_limit bigint NOT NULL,
+    meta jsonb,
+    pipeline_task_run_id uuid,
+    signal_callback boolean NOT NULL DEFAULT FALSE,
+    callback_completed boolean NOT NULL DEFAULT FALSE,
+    created_at timestamptz NOT NULL,
+    CONSTRAINT unique_tx_batch_item_index UNIQUE (batch_tx_id, batch_index)
+);
+
+CREATE INDEX idx_tx_batch_items_pipeline_task_run_id ON evm.tx_batch_items (pipeline_task_run_id) WHERE pipeline_task_run_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE evm.tx_batch_items;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the reason a transaction was predicted to revert for when simulated before its first broadcast
+ALTER TABLE evm.txes ADD COLUMN simulation_revert_reason text;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE evm.txes DROP COLUMN simulation_revert_reason;
+-- +goose StatementEnd
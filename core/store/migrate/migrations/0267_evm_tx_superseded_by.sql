@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the transaction an operator cancelled or replaced this transaction with, at the same nonce
+ALTER TABLE evm.txes ADD COLUMN superseded_by_tx_id bigint REFERENCES evm.txes (id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE evm.txes DROP COLUMN superseded_by_tx_id;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the state changes of the transactions with an idempotency key, kept for delivering them to the webhook and the subscribers of the transaction manager
+CREATE TABLE evm.tx_status_events (
+    id BIGSERIAL PRIMARY KEY,
+    tx_id bigint NOT NULL REFERENCES evm.txes (id) ON DELETE CASCADE,
+    evm_chain_id numeric(78, 0) NOT NULL,
+    idempotency_key varchar(2000) NOT NULL,
+    from_address bytea NOT NULL,
+    state evm.txes_state NOT NULL,
+    error text,
+    created_at timestamptz NOT NULL,
+    delivery_attempts integer NOT NULL DEFAULT 0,
+    deliver_after timestamptz NOT NULL,
+    delivered_at timestamptz
+);
+
+CREATE INDEX idx_tx_status_events_evm_chain_id_id ON evm.tx_status_events (evm_chain_id, id);
+CREATE INDEX idx_tx_status_events_undelivered ON evm.tx_status_events (evm_chain_id, id) WHERE delivered_at IS NULL;
+CREATE INDEX idx_tx_status_events_tx_id ON evm.tx_status_events (tx_id);
+
+-- the events are recorded by triggers rather than by the queries changing the state of the transactions,
+-- so that none of the state changes is missed, and each is recorded in the same database transaction as the change
+CREATE FUNCTION evm.record_tx_status_event() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+        BEGIN
+        INSERT INTO evm.tx_status_events (tx_id, evm_chain_id, idempotency_key, from_address, state, error, created_at, deliver_after)
+        VALUES (NEW.id, NEW.evm_chain_id, NEW.idempotency_key, NEW.from_address, NEW.state, NEW.error, NOW(), NOW());
+        RETURN NULL;
+        END
+        $$;
+
+CREATE TRIGGER record_tx_status_event_on_insert AFTER INSERT ON evm.txes
+    FOR EACH ROW WHEN (NEW.idempotency_key IS NOT NULL)
+    EXECUTE PROCEDURE evm.record_tx_status_event();
+
+-- a transaction taking over the idempotency key of another one, such as the replacement of a transaction, has its
+-- current state recorded as well
+CREATE TRIGGER record_tx_status_event_on_update AFTER UPDATE OF state, idempotency_key ON evm.txes
+    FOR EACH ROW WHEN (NEW.idempotency_key IS NOT NULL AND (OLD.state IS DISTINCT FROM NEW.state OR OLD.idempotency_key IS NULL))
+    EXECUTE PROCEDURE evm.record_tx_status_event();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER record_tx_status_event_on_update ON evm.txes;
+DROP TRIGGER record_tx_status_event_on_insert ON evm.txes;
+DROP FUNCTION evm.record_tx_status_event();
+DROP TABLE evm.tx_status_events;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the transaction an operator cancelled or replaced with this transaction, at the same nonce
+ALTER TABLE evm.txes ADD COLUMN supersedes_tx_id bigint REFERENCES evm.txes (id) ON DELETE SET NULL;
+-- whether the transaction superseding this one cancels it, failing its pipeline runs if it is mined, rather than replaces it
+ALTER TABLE evm.txes ADD COLUMN superseded_by_cancel boolean NOT NULL DEFAULT FALSE;
+
+-- a superseding transaction shares its nonce with the transaction it supersedes until one of the two is mined
+DROP INDEX evm.idx_eth_txes_nonce_from_address_per_evm_chain_id;
+CREATE UNIQUE INDEX idx_eth_txes_nonce_from_address_per_evm_chain_id ON evm.txes (evm_chain_id, from_address, nonce) WHERE supersedes_tx_id IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- the transactions still sharing a nonce keep only one of them, the mined one if any, or else the superseded one
+UPDATE evm.txes SET state = 'fatal_error', nonce = NULL, error = 'superseded transactions sharing a nonce abandoned by a rollback'
+FROM (
+    SELECT id, row_number() OVER (
+        PARTITION BY evm_chain_id, from_address, nonce
+        ORDER BY state IN ('confirmed', 'confirmed_missing_receipt', 'finalized') DESC, supersedes_tx_id IS NULL DESC, id ASC
+    ) AS nonce_rank
+    FROM evm.txes WHERE nonce IS NOT NULL
+) ranked
+WHERE evm.txes.id = ranked.id AND ranked.nonce_rank > 1;
+
+DROP INDEX evm.idx_eth_txes_nonce_from_address_per_evm_chain_id;
+CREATE UNIQUE INDEX idx_eth_txes_nonce_from_address_per_evm_chain_id ON evm.txes (evm_chain_id, from_address, nonce);
+
+ALTER TABLE evm.txes DROP COLUMN superseded_by_cancel;
+ALTER TABLE evm.txes DROP COLUMN supersedes_tx_id;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- whether the call of the batch item succeeded within its mined batch transaction, decoded from the results of the batch
+ALTER TABLE evm.tx_batch_items ADD COLUMN call_succeeded boolean;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE evm.tx_batch_items DROP COLUMN call_succeeded;
+-- +goose StatementEnd
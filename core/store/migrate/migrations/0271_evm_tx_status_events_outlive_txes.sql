@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the events are kept when their transaction is deleted by the reaper, the pruning of the queue or the batching of
+-- transactions, so that undelivered events aren't lost, and are deleted by the reaper of the events instead
+ALTER TABLE evm.tx_status_events DROP CONSTRAINT tx_status_events_tx_id_fkey;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM evm.tx_status_events WHERE NOT EXISTS (SELECT 1 FROM evm.txes WHERE evm.txes.id = evm.tx_status_events.tx_id);
+ALTER TABLE evm.tx_status_events ADD CONSTRAINT tx_status_events_tx_id_fkey FOREIGN KEY (tx_id) REFERENCES evm.txes (id) ON DELETE CASCADE;
+-- +goose StatementEnd
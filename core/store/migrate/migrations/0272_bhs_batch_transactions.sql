@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE blockhash_store_specs ADD COLUMN batch_transactions boolean DEFAULT FALSE NOT NULL;
+
+-- +goose Down
+ALTER TABLE blockhash_store_specs DROP COLUMN batch_transactions;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- the workflow secrets of the node a workflow may reference, none unless listed
+ALTER TABLE workflow_specs ADD COLUMN allowed_secrets text[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE workflow_specs DROP COLUMN allowed_secrets;
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/robfig/cron/v3"
 	"github.com/tidwall/gjson"
@@ -258,6 +259,13 @@ type SendEtherRequest struct {
 	WaitAttemptTimeout *time.Duration `json:"waitAttemptTimeout"`
 }
 
+// ReplaceTxRequest represents a request to replace an unconfirmed transaction.
+// A missing Data or a zero GasLimit keeps the ones of the replaced transaction.
+type ReplaceTxRequest struct {
+	Data     hexutil.Bytes `json:"data"`
+	GasLimit uint64        `json:"gasLimit"`
+}
+
 // AddressCollection is an array of common.Address
 // serializable to and from a database.
 type AddressCollection []common.Address
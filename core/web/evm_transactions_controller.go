@@ -3,8 +3,12 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -22,6 +26,12 @@ func (tc *TransactionsController) Index(c *gin.Context, size, page, offset int)
 	txs, count, err := tc.App.TxmStorageService().TransactionsWithAttempts(c, offset, size)
 	ptxs := make([]presenters.EthTxResource, len(txs))
 	for i, tx := range txs {
+		if len(tx.TxAttempts) == 0 {
+			// a transaction predicted to revert may have been marked as fatally errored without being broadcast
+			ptxs[i] = presenters.NewEthTxResource(tx)
+			ptxs[i].JAID = presenters.NewPrefixedJAID(strconv.FormatInt(tx.ID, 10), tx.ChainID.String())
+			continue
+		}
 		tx.TxAttempts[0].Tx = tx
 		ptxs[i] = presenters.NewEthTxResourceFromAttempt(tx.TxAttempts[0])
 	}
@@ -47,3 +57,87 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel cancels an unconfirmed transaction, sending a transaction with no value from its sender to itself at its nonce.
+// Example:
+//
+//	"<application>/transactions/evm/:ID/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	txID, txm, ok := tc.txManagerFor(c)
+	if !ok {
+		return
+	}
+
+	etx, err := txm.CancelTransaction(c, txID)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("failed to cancel transaction: %v", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, map[string]interface{}{
+		"txID":  txID,
+		"ethTX": etx,
+	})
+
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(etx.TxAttempts[0]), "transaction")
+}
+
+// Replace replaces an unconfirmed transaction, sending a transaction with the given data and gas limit at its nonce.
+// Example:
+//
+//	"<application>/transactions/evm/:ID/replace"
+func (tc *TransactionsController) Replace(c *gin.Context) {
+	var request models.ReplaceTxRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	txID, txm, ok := tc.txManagerFor(c)
+	if !ok {
+		return
+	}
+
+	etx, err := txm.ReplaceTransaction(c, txID, request.Data, request.GasLimit)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("failed to replace transaction: %v", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionReplaced, map[string]interface{}{
+		"txID":  txID,
+		"ethTX": etx,
+	})
+
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(etx.TxAttempts[0]), "transaction")
+}
+
+// txManagerFor returns the ID of the transaction of the request and the TxManager of its chain
+func (tc *TransactionsController) txManagerFor(c *gin.Context) (txID int64, txm txmgr.TxManager, ok bool) {
+	txID, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid transaction ID: %v", err))
+		return
+	}
+
+	tx, err := tc.App.TxmStorageService().FindTxWithAttempts(c, txID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chain, err := getChain(tc.App.GetRelayers().LegacyEVMChains(), tx.ChainID.String())
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	return txID, chain.TxManager(), true
+}
@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"testing"
@@ -129,3 +130,39 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Post("/v2/transactions/evm/4242/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Post("/v2/transactions/evm/0xdeadbeef/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestTransactionsController_Replace_BadRequest(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Post("/v2/transactions/evm/4242/replace", bytes.NewBufferString(`{"data": "not hex"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = client.Post("/v2/transactions/evm/4242/replace", bytes.NewBufferString(`{"data": "0x010203", "gasLimit": 100000}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
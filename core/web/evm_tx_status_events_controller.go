@@ -0,0 +1,87 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+// txStatusEventsKeepAliveInterval is how often a comment is sent on an idle stream, so that proxies keep it open
+const txStatusEventsKeepAliveInterval = 15 * time.Second
+
+// TxStatusEventsController streams the state changes of the EVM transactions with an idempotency key.
+type TxStatusEventsController struct {
+	App chainlink.Application
+}
+
+// Stream streams the state changes of the transactions of a chain as server-sent events, following the event with the
+// ID of the Last-Event-ID header, if any, or else from now on.
+// Example:
+//
+//	"<application>/tx_status_events/evm?evmChainID=1"
+func (tc *TxStatusEventsController) Stream(c *gin.Context) {
+	chain, err := getChain(tc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	afterID := int64(-1)
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		afterID, err = strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil || afterID < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid Last-Event-ID: %s", lastEventID))
+			return
+		}
+	}
+
+	events, unsub, err := chain.TxManager().SubscribeTxStatusEvents(afterID)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("failed to subscribe to transaction status events: %w", err))
+		return
+	}
+	defer unsub()
+
+	// the stream is kept open past the write timeout of the server
+	if err = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		tc.App.GetLogger().Debugw("Failed to clear the write deadline of the transaction status event stream", "err", err)
+	}
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Status(http.StatusOK)
+
+	keepAlive := time.NewTicker(txStatusEventsKeepAliveInterval)
+	defer keepAlive.Stop()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				tc.App.GetLogger().Errorw("Failed to encode transaction status event", "eventID", event.ID, "err", err)
+				return false
+			}
+			_, err = fmt.Fprintf(w, "id: %d\nevent: status\ndata: %s\n\n", event.ID, data)
+			return err == nil
+		case <-keepAlive.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -92,6 +93,30 @@ func (jc *JobsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
 }
 
+// Stats summarises the recent pipeline runs of a job: run and task latency
+// percentiles, and error ratios over rolling windows.
+// Example:
+// "GET <application>/jobs/:ID/stats"
+func (jc *JobsController) Stats(c *gin.Context) {
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if _, err = jc.App.JobORM().FindJob(c.Request.Context(), j.ID); err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobStatsResource(jc.App.JobStatsV2(j.ID)), "jobStats")
+}
+
 // CreateJobRequest represents a request to create and start a job (V2).
 type CreateJobRequest struct {
 	TOML string `json:"toml"`
@@ -216,6 +241,39 @@ func (jc *JobsController) Update(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// SimulateJobRequest represents a request to dry run the pipeline of a job spec (V2).
+type SimulateJobRequest struct {
+	TOML     string                      `json:"toml"`
+	Fixtures pipeline.SimulationFixtures `json:"fixtures"`
+}
+
+// Simulate validates a job spec and executes its pipeline without side
+// effects, resolving external tasks against the supplied fixtures. The job
+// is not saved.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	run, err := jc.App.SimulateJobV2(c.Request.Context(), &jb, request.Fixtures)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunResource(*run, jc.App.GetLogger()), "pipelineRun")
+}
+
 func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
 	jobType, err := job.ValidateSpec(tomlString)
 	if err != nil {
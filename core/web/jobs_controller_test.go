@@ -37,6 +37,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -522,6 +523,58 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	tomlStr := testspecs.GetWebhookSpecNoBody(uuid.New(), "fetch_bridge", "submit_bridge")
+	body, err := json.Marshal(web.SimulateJobRequest{
+		TOML: tomlStr,
+		Fixtures: pipeline.SimulationFixtures{
+			Tasks: map[string]pipeline.SimulationFixture{
+				"fetch":  {Value: `{"data":{"result":"1.23"}}`},
+				"submit": {Value: `{"ok":true}`},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	var run presenters.PipelineRunResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &run))
+	require.Len(t, run.TaskRuns, 4)
+	require.Len(t, run.Outputs, 1)
+	assert.Equal(t, `{"ok":true}`, *run.Outputs[0])
+	for _, tr := range run.TaskRuns {
+		assert.Nil(t, tr.Error, tr.DotID)
+	}
+
+	// the job is never saved
+	jobs, count, err := app.JobORM().FindJobs(testutils.Context(t), 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+	assert.Zero(t, count)
+
+	t.Run("rejects fixtures for unknown tasks", func(t *testing.T) {
+		body, err := json.Marshal(web.SimulateJobRequest{
+			TOML: tomlStr,
+			Fixtures: pipeline.SimulationFixtures{
+				Tasks: map[string]pipeline.SimulationFixture{"nope": {}},
+			},
+		})
+		require.NoError(t, err)
+
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+	})
+}
+
 //go:embed webhook-spec-template.yml
 var webhookSpecTemplate string
 
@@ -628,6 +681,36 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_Stats(t *testing.T) {
+	_, client, _, jobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/stats", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var stats presenters.JobStatsResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &stats))
+	assert.Equal(t, fmt.Sprintf("%v", jobID), stats.ID)
+	require.Len(t, stats.Windows, len(pipeline.JobStatsWindows))
+	for i, w := range stats.Windows {
+		assert.Equal(t, pipeline.JobStatsWindows[i], w.Window.Duration())
+		assert.Zero(t, w.Runs)
+		assert.Empty(t, w.Tasks)
+	}
+
+	t.Run("invalid ID", func(t *testing.T) {
+		response, cleanup := client.Get("/v2/jobs/uuidLikeString/stats")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("non-existent ID", func(t *testing.T) {
+		response, cleanup := client.Get("/v2/jobs/999999999/stats")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
 func TestJobsController_Update_HappyPath(t *testing.T) {
 	ctx := testutils.Context(t)
 	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
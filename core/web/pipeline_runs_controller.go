@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -85,6 +86,68 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, res, "pipelineRun")
 }
 
+// Replay re-executes a recorded pipeline run from its replay bundle, without
+// side effects, and compares every task against the original run.
+// Example:
+// "POST <application>/pipeline/runs/:runID/replay"
+func (prc *PipelineRunsController) Replay(c *gin.Context) {
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	run, diffs, err := prc.App.ReplayRunV2(c.Request.Context(), pipelineRun.ID)
+	if errors.Is(err, pipeline.ErrNoReplayBundle) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunReplayResource(*run, diffs, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRunReplay")
+}
+
+// Cancel fails an unfinished pipeline run, along with its pending tasks, and
+// stops executing it.
+// Example:
+// "DELETE <application>/pipeline/runs/:runID"
+func (prc *PipelineRunsController) Cancel(c *gin.Context) {
+	ctx := c.Request.Context()
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = prc.App.CancelRunV2(ctx, pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	} else if errors.Is(err, pipeline.ErrRunFinished) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	prc.App.GetAuditLogger().Audit(audit.PipelineRunCancelled, map[string]interface{}{"runID": pipelineRun.ID})
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(ctx, pipelineRun.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunResource(pipelineRun, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
+
 // Create triggers a pipeline run for a job.
 // Example:
 // "POST <application>/jobs/:ID/runs"
@@ -250,6 +250,28 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_Cancel(t *testing.T) {
+	client, _, runIDs := setupPipelineRunsControllerTests(t)
+
+	t.Run("finished run", func(t *testing.T) {
+		response, cleanup := client.Delete(fmt.Sprintf("/v2/pipeline/runs/%v", runIDs[0]))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusConflict)
+	})
+
+	t.Run("unknown run", func(t *testing.T) {
+		response, cleanup := client.Delete(fmt.Sprintf("/v2/pipeline/runs/%v", runIDs[1]+1000))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+
+	t.Run("invalid run ID", func(t *testing.T) {
+		response, cleanup := client.Delete("/v2/pipeline/runs/invalid-run-ID")
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -26,6 +26,14 @@ type EthTxResource struct {
 	To         *common.Address `json:"to"`
 	Value      string          `json:"value"`
 	EVMChainID big.Big         `json:"evmChainID"`
+	// SimulationRevertReason is set if the transaction was predicted to revert when simulated before its first broadcast
+	SimulationRevertReason string `json:"simulationRevertReason,omitempty"`
+	// TxID is the ID of the transaction to cancel or replace it with
+	TxID string `json:"txID,omitempty"`
+	// SupersededByTxID is set if the transaction was cancelled or replaced
+	SupersededByTxID string `json:"supersededByTxID,omitempty"`
+	// SupersedesTxID is set if the transaction cancels or replaces another one
+	SupersedesTxID string `json:"supersedesTxID,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -52,6 +60,18 @@ func NewEthTxResource(tx txmgr.Tx) EthTxResource {
 	if tx.ChainID != nil {
 		r.EVMChainID = *big.New(tx.ChainID)
 	}
+	if tx.SimulationRevertReason.Valid {
+		r.SimulationRevertReason = tx.SimulationRevertReason.String
+	}
+	if tx.ID != 0 {
+		r.TxID = strconv.FormatInt(tx.ID, 10)
+	}
+	if tx.SupersededByTxID.Valid {
+		r.SupersededByTxID = strconv.FormatInt(tx.SupersededByTxID.Int64, 10)
+	}
+	if tx.SupersedesTxID.Valid {
+		r.SupersedesTxID = strconv.FormatInt(tx.SupersedesTxID.Int64, 10)
+	}
 	return r
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
@@ -54,7 +55,8 @@ func TestEthTxResource(t *testing.T) {
 			"sentAt": "",
 			"to": "0x0000000000000000000000000000000000000002",
 			"value": "0.000000000000000001",
-			"evmChainID": "54321"
+			"evmChainID": "54321",
+			"txID": "1"
 		  }
 		}
 	  }
@@ -100,7 +102,79 @@ func TestEthTxResource(t *testing.T) {
 			"sentAt": "300",
 			"to": "0x0000000000000000000000000000000000000002",
 			"value": "0.000000000000000001",
-			"evmChainID": "54321"
+			"evmChainID": "54321",
+			"txID": "1"
+		  }
+		}
+	  }
+	`
+
+	assert.JSONEq(t, expected, string(b))
+
+	tx.State = txmgrcommon.TxFatalError
+	tx.Sequence = nil
+	tx.SimulationRevertReason = null.StringFrom("NoSuchSubscription()")
+	r = NewEthTxResource(tx)
+
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected = `
+	{
+		"data": {
+		  "type": "evm_transactions",
+		  "id": "",
+		  "attributes": {
+			"state": "fatal_error",
+			"data": "0x7b2264617461223a202269732077696c64696e67206f7574227d",
+			"from": "0x0000000000000000000000000000000000000001",
+			"gasLimit": "5000",
+			"gasPrice": "",
+			"hash": "0x0000000000000000000000000000000000000000000000000000000000000000",
+			"rawHex": "",
+			"nonce": "",
+			"sentAt": "",
+			"to": "0x0000000000000000000000000000000000000002",
+			"value": "0.000000000000000001",
+			"evmChainID": "54321",
+			"simulationRevertReason": "NoSuchSubscription()",
+			"txID": "1"
+		  }
+		}
+	  }
+	`
+
+	assert.JSONEq(t, expected, string(b))
+
+	tx.SimulationRevertReason = null.String{}
+	tx.SupersededByTxID = null.IntFrom(2)
+	tx.SupersedesTxID = null.IntFrom(3)
+	r = NewEthTxResource(tx)
+
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected = `
+	{
+		"data": {
+		  "type": "evm_transactions",
+		  "id": "",
+		  "attributes": {
+			"state": "fatal_error",
+			"data": "0x7b2264617461223a202269732077696c64696e67206f7574227d",
+			"from": "0x0000000000000000000000000000000000000001",
+			"gasLimit": "5000",
+			"gasPrice": "",
+			"hash": "0x0000000000000000000000000000000000000000000000000000000000000000",
+			"rawHex": "",
+			"nonce": "",
+			"sentAt": "",
+			"to": "0x0000000000000000000000000000000000000002",
+			"value": "0.000000000000000001",
+			"evmChainID": "54321",
+			"txID": "1",
+			"supersededByTxID": "2",
+			"supersedesTxID": "3"
 		  }
 		}
 	  }
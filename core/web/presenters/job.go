@@ -329,6 +329,7 @@ type BlockhashStoreSpec struct {
 	RunTimeout                     time.Duration        `json:"runTimeout"`
 	EVMChainID                     *big.Big             `json:"evmChainID"`
 	FromAddresses                  []types.EIP55Address `json:"fromAddresses"`
+	BatchTransactions              bool                 `json:"batchTransactions"`
 	CreatedAt                      time.Time            `json:"createdAt"`
 	UpdatedAt                      time.Time            `json:"updatedAt"`
 }
@@ -349,6 +350,7 @@ func NewBlockhashStoreSpec(spec *job.BlockhashStoreSpec) *BlockhashStoreSpec {
 		RunTimeout:                     spec.RunTimeout,
 		EVMChainID:                     spec.EVMChainID,
 		FromAddresses:                  spec.FromAddresses,
+		BatchTransactions:              spec.BatchTransactions,
 	}
 }
 
@@ -433,22 +435,28 @@ func NewGatewaySpec(spec *job.GatewaySpec) *GatewaySpec {
 }
 
 type WorkflowSpec struct {
-	Workflow      string    `json:"workflow"`
-	WorkflowID    string    `json:"workflowId"`
-	WorkflowOwner string    `json:"workflowOwner"`
-	WorkflowName  string    `json:"workflowName"`
-	CreatedAt     time.Time `json:"createdAt"`
-	UpdatedAt     time.Time `json:"updatedAt"`
+	Workflow        string    `json:"workflow"`
+	WorkflowID      string    `json:"workflowId"`
+	WorkflowOwner   string    `json:"workflowOwner"`
+	WorkflowName    string    `json:"workflowName"`
+	WorkflowVersion string    `json:"workflowVersion"`
+	AllowedSecrets  []string  `json:"allowedSecrets"`
+	Retired         bool      `json:"retired"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
 }
 
 func NewWorkflowSpec(spec *job.WorkflowSpec) *WorkflowSpec {
 	return &WorkflowSpec{
-		Workflow:      spec.Workflow,
-		WorkflowID:    spec.WorkflowID,
-		WorkflowOwner: spec.WorkflowOwner,
-		WorkflowName:  spec.WorkflowName,
-		CreatedAt:     spec.CreatedAt,
-		UpdatedAt:     spec.UpdatedAt,
+		Workflow:        spec.Workflow,
+		WorkflowID:      spec.WorkflowID,
+		WorkflowOwner:   spec.WorkflowOwner,
+		WorkflowName:    spec.WorkflowName,
+		WorkflowVersion: spec.WorkflowVersion,
+		AllowedSecrets:  spec.AllowedSecrets,
+		Retired:         spec.Retired,
+		CreatedAt:       spec.CreatedAt,
+		UpdatedAt:       spec.UpdatedAt,
 	}
 }
 
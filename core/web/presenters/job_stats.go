@@ -0,0 +1,79 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// JobStatsResource summarises the recent pipeline runs of a job
+type JobStatsResource struct {
+	JAID
+	Windows []JobStatsWindowResource `json:"windows"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobStatsResource) GetName() string {
+	return "jobStats"
+}
+
+// NewJobStatsResource constructs a new JobStatsResource
+func NewJobStatsResource(stats pipeline.JobStats) *JobStatsResource {
+	windows := make([]JobStatsWindowResource, len(stats.Windows))
+	for i, w := range stats.Windows {
+		tasks := make([]TaskTypeStatsResource, len(w.Tasks))
+		for j, task := range w.Tasks {
+			tasks[j] = TaskTypeStatsResource{
+				Type:        task.Type,
+				Runs:        task.Runs,
+				ErroredRuns: task.ErroredRuns,
+				Duration:    NewLatencyPercentilesResource(task.Duration),
+			}
+		}
+		windows[i] = JobStatsWindowResource{
+			Window:       models.Interval(w.Window),
+			Runs:         w.Runs,
+			ErroredRuns:  w.ErroredRuns,
+			SuccessRatio: w.SuccessRatio,
+			RunDuration:  NewLatencyPercentilesResource(w.RunDuration),
+			Tasks:        tasks,
+		}
+	}
+
+	return &JobStatsResource{
+		JAID:    NewJAIDInt32(stats.JobID),
+		Windows: windows,
+	}
+}
+
+// JobStatsWindowResource summarises the runs of a job which finished within a rolling window
+type JobStatsWindowResource struct {
+	Window       models.Interval            `json:"window"`
+	Runs         uint64                     `json:"runs"`
+	ErroredRuns  uint64                     `json:"erroredRuns"`
+	SuccessRatio float64                    `json:"successRatio"`
+	RunDuration  LatencyPercentilesResource `json:"runDuration"`
+	Tasks        []TaskTypeStatsResource    `json:"tasks"`
+}
+
+// TaskTypeStatsResource summarises the task runs of a single type
+type TaskTypeStatsResource struct {
+	Type        pipeline.TaskType          `json:"type"`
+	Runs        uint64                     `json:"runs"`
+	ErroredRuns uint64                     `json:"erroredRuns"`
+	Duration    LatencyPercentilesResource `json:"duration"`
+}
+
+// LatencyPercentilesResource holds estimated latency percentiles
+type LatencyPercentilesResource struct {
+	P50 models.Interval `json:"p50"`
+	P95 models.Interval `json:"p95"`
+	P99 models.Interval `json:"p99"`
+}
+
+func NewLatencyPercentilesResource(p pipeline.LatencyPercentiles) LatencyPercentilesResource {
+	return LatencyPercentilesResource{
+		P50: models.Interval(p.P50),
+		P95: models.Interval(p.P95),
+		P99: models.Interval(p.P99),
+	}
+}
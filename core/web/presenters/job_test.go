@@ -599,6 +599,7 @@ func TestJob(t *testing.T) {
 					FromAddresses:                  []types.EIP55Address{fromAddress},
 					TrustedBlockhashStoreAddress:   &trustedBlockhashStoreAddress,
 					TrustedBlockhashStoreBatchSize: trustedBlockhashStoreBatchSize,
+					BatchTransactions:              true,
 				},
 				PipelineSpec: &pipeline.Spec{
 					ID:           1,
@@ -645,6 +646,7 @@ func TestJob(t *testing.T) {
 							"runTimeout": 10000000000,
 							"evmChainID": "4",
 							"fromAddresses": ["0xa8037A20989AFcBC51798de9762b351D63ff462e"],
+							"batchTransactions": true,
 							"createdAt": "0001-01-01T00:00:00Z",
 							"updatedAt": "0001-01-01T00:00:00Z"
 						},
@@ -882,11 +884,12 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				WorkflowSpec: &job.WorkflowSpec{
-					ID:            3,
-					WorkflowID:    "<test-workflow-id>",
-					Workflow:      `<test-workflow-spec>`,
-					WorkflowOwner: "<test-workflow-owner>",
-					WorkflowName:  "<test-workflow-name>",
+					ID:             3,
+					WorkflowID:     "<test-workflow-id>",
+					Workflow:       `<test-workflow-spec>`,
+					WorkflowOwner:  "<test-workflow-owner>",
+					WorkflowName:   "<test-workflow-name>",
+					AllowedSecrets: []string{"API_KEY"},
 				},
 				PipelineSpec: &pipeline.Spec{
 					ID:           1,
@@ -923,6 +926,9 @@ func TestJob(t *testing.T) {
 							"workflowId": "<test-workflow-id>",
 							"workflowOwner": "<test-workflow-owner>",
 							"workflowName": "<test-workflow-name>",
+							"workflowVersion": "",
+							"allowedSecrets": ["API_KEY"],
+							"retired": false,
 							"createdAt":"0001-01-01T00:00:00Z",
 							"updatedAt":"0001-01-01T00:00:00Z"
 						},
@@ -104,3 +104,75 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineRunReplayResource is the result of replaying a recorded pipeline run
+type PipelineRunReplayResource struct {
+	JAID
+	State       pipeline.RunStatus           `json:"state"`
+	Outputs     []*string                    `json:"outputs"`
+	FatalErrors []*string                    `json:"fatalErrors"`
+	Tasks       []PipelineTaskReplayResource `json:"tasks"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineRunReplayResource) GetName() string {
+	return "pipelineRunReplay"
+}
+
+func NewPipelineRunReplayResource(pr pipeline.Run, diffs []pipeline.ReplayTaskDiff, lggr logger.Logger) PipelineRunReplayResource {
+	lggr = lggr.Named("PipelineRunReplayResource")
+	outputs, err := pr.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", pr.Outputs)
+	}
+
+	tasks := make([]PipelineTaskReplayResource, len(diffs))
+	for i, diff := range diffs {
+		tasks[i] = NewPipelineTaskReplayResource(diff)
+	}
+
+	return PipelineRunReplayResource{
+		JAID:        NewJAIDInt64(pr.ID),
+		State:       pr.State,
+		Outputs:     outputs,
+		FatalErrors: pr.StringFatalErrors(),
+		Tasks:       tasks,
+	}
+}
+
+// PipelineTaskReplayResource compares the original and replayed result of a task
+type PipelineTaskReplayResource struct {
+	DotID          string            `json:"dotId"`
+	Type           pipeline.TaskType `json:"type"`
+	OriginalOutput *string           `json:"originalOutput"`
+	OriginalError  *string           `json:"originalError"`
+	ReplayedOutput *string           `json:"replayedOutput"`
+	ReplayedError  *string           `json:"replayedError"`
+	Match          bool              `json:"match"`
+}
+
+func NewPipelineTaskReplayResource(diff pipeline.ReplayTaskDiff) PipelineTaskReplayResource {
+	r := PipelineTaskReplayResource{
+		DotID: diff.DotID,
+		Type:  diff.Type,
+		Match: diff.Match(),
+	}
+	r.OriginalOutput, r.OriginalError = replayTaskResultStrings(diff.Original)
+	r.ReplayedOutput, r.ReplayedError = replayTaskResultStrings(diff.Replayed)
+	return r
+}
+
+func replayTaskResultStrings(result *pipeline.ReplayTaskResult) (output *string, errString *string) {
+	if result == nil {
+		return nil, nil
+	}
+	if result.Output.Valid {
+		outputBytes, _ := result.Output.MarshalJSON()
+		outputStr := string(outputBytes)
+		output = &outputStr
+	}
+	if result.Error != "" {
+		errString = &result.Error
+	}
+	return output, errString
+}
@@ -0,0 +1,120 @@
+package presenters
+
+import (
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResource represents a workflow execution, along with its steps
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID      string                          `json:"workflowID"`
+	WorkflowVersion string                          `json:"workflowVersion"`
+	TriggerEventID  string                          `json:"triggerEventID"`
+	Status          string                          `json:"status"`
+	CreatedAt       *time.Time                      `json:"createdAt"`
+	UpdatedAt       *time.Time                      `json:"updatedAt"`
+	FinishedAt      *time.Time                      `json:"finishedAt"`
+	Steps           []WorkflowExecutionStepResource `json:"steps"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowExecutionResource) GetName() string {
+	return "workflowExecution"
+}
+
+// WorkflowExecutionStepResource represents a single step of a workflow execution
+type WorkflowExecutionStepResource struct {
+	Ref       string                                 `json:"ref"`
+	Status    string                                 `json:"status"`
+	Inputs    any                                    `json:"inputs"`
+	Outputs   any                                    `json:"outputs"`
+	Error     *string                                `json:"error"`
+	Attempts  []WorkflowExecutionStepAttemptResource `json:"attempts"`
+	UpdatedAt *time.Time                             `json:"updatedAt"`
+}
+
+// WorkflowExecutionStepAttemptResource represents an attempt at executing the capability of a step
+type WorkflowExecutionStepAttemptResource struct {
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	Error      *string   `json:"error"`
+}
+
+// NewWorkflowExecutionResource constructs a new WorkflowExecutionResource.
+// Steps are sorted by ref.
+func NewWorkflowExecutionResource(we store.WorkflowExecution, lggr logger.Logger) WorkflowExecutionResource {
+	lggr = lggr.Named("WorkflowExecutionResource")
+
+	steps := make([]WorkflowExecutionStepResource, 0, len(we.Steps))
+	for _, step := range we.Steps {
+		steps = append(steps, newWorkflowExecutionStepResource(step, lggr))
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i].Ref < steps[j].Ref })
+
+	return WorkflowExecutionResource{
+		JAID:            NewJAID(we.ExecutionID),
+		WorkflowID:      we.WorkflowID,
+		WorkflowVersion: we.WorkflowVersion,
+		TriggerEventID:  we.TriggerEventID,
+		Status:          we.Status,
+		CreatedAt:       we.CreatedAt,
+		UpdatedAt:       we.UpdatedAt,
+		FinishedAt:      we.FinishedAt,
+		Steps:           steps,
+	}
+}
+
+// NewWorkflowExecutionResources constructs a list of WorkflowExecutionResources
+func NewWorkflowExecutionResources(wes []store.WorkflowExecution, lggr logger.Logger) []WorkflowExecutionResource {
+	rs := []WorkflowExecutionResource{}
+	for _, we := range wes {
+		rs = append(rs, NewWorkflowExecutionResource(we, lggr))
+	}
+	return rs
+}
+
+func newWorkflowExecutionStepResource(step *store.WorkflowExecutionStep, lggr logger.Logger) WorkflowExecutionStepResource {
+	r := WorkflowExecutionStepResource{
+		Ref:       step.Ref,
+		Status:    step.Status,
+		Attempts:  make([]WorkflowExecutionStepAttemptResource, len(step.Attempts)),
+		UpdatedAt: step.UpdatedAt,
+	}
+	for i, attempt := range step.Attempts {
+		r.Attempts[i] = WorkflowExecutionStepAttemptResource{
+			StartedAt:  attempt.StartedAt,
+			FinishedAt: attempt.FinishedAt,
+		}
+		if attempt.Err != "" {
+			errMsg := attempt.Err
+			r.Attempts[i].Error = &errMsg
+		}
+	}
+	if step.Inputs != nil {
+		r.Inputs = unwrapValue(step.Inputs, lggr)
+	}
+	r.Outputs = unwrapValue(step.Outputs.Value, lggr)
+	if step.Outputs.Err != nil {
+		errMsg := step.Outputs.Err.Error()
+		r.Error = &errMsg
+	}
+	return r
+}
+
+func unwrapValue(v values.Value, lggr logger.Logger) any {
+	if v == nil {
+		return nil
+	}
+	unwrapped, err := v.Unwrap()
+	if err != nil {
+		lggr.Errorw("Failed to unwrap workflow step value", "err", err)
+		return nil
+	}
+	return unwrapped
+}
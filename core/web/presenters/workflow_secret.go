@@ -0,0 +1,33 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowsecret"
+)
+
+// WorkflowSecretResource represents a workflow secret JSONAPI resource.
+// Only the name of the secret is exposed, never its value.
+type WorkflowSecretResource struct {
+	JAID
+	Name string `json:"name"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (WorkflowSecretResource) GetName() string {
+	return "workflowSecrets"
+}
+
+func NewWorkflowSecretResource(secret workflowsecret.Secret) *WorkflowSecretResource {
+	return &WorkflowSecretResource{
+		JAID: NewJAID(secret.ID()),
+		Name: secret.Name(),
+	}
+}
+
+func NewWorkflowSecretResources(secrets []workflowsecret.Secret) []WorkflowSecretResource {
+	rs := []WorkflowSecretResource{}
+	for _, secret := range secrets {
+		rs = append(rs, *NewWorkflowSecretResource(secret))
+	}
+
+	return rs
+}
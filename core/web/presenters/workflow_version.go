@@ -0,0 +1,39 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// WorkflowVersionResource represents a version of a workflow, identified by the ID of the job running it.
+type WorkflowVersionResource struct {
+	JAID
+	WorkflowID      string `json:"workflowID"`
+	WorkflowOwner   string `json:"workflowOwner"`
+	WorkflowName    string `json:"workflowName"`
+	WorkflowVersion string `json:"workflowVersion"`
+	Retired         bool   `json:"retired"`
+	// ExecutionsInProgress counts the executions of the version which didn't finish yet.
+	// A retired version has drained once it has none left.
+	ExecutionsInProgress int       `json:"executionsInProgress"`
+	CreatedAt            time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (WorkflowVersionResource) GetName() string {
+	return "workflowVersions"
+}
+
+func NewWorkflowVersionResource(jb job.Job, executionsInProgress int) *WorkflowVersionResource {
+	return &WorkflowVersionResource{
+		JAID:                 NewJAIDInt32(jb.ID),
+		WorkflowID:           jb.WorkflowSpec.WorkflowID,
+		WorkflowOwner:        jb.WorkflowSpec.WorkflowOwner,
+		WorkflowName:         jb.WorkflowSpec.WorkflowName,
+		WorkflowVersion:      jb.WorkflowSpec.WorkflowVersion,
+		Retired:              jb.WorkflowSpec.Retired,
+		ExecutionsInProgress: executionsInProgress,
+		CreatedAt:            jb.WorkflowSpec.CreatedAt,
+	}
+}
@@ -101,6 +101,11 @@ func (r *JobResolver) Spec() *SpecResolver {
 	return NewSpec(r.j)
 }
 
+// Stats resolves the stats of the job's recent runs.
+func (r *JobResolver) Stats() *JobStatsResolver {
+	return NewJobStats(r.app.JobStatsV2(r.j.ID))
+}
+
 // Runs fetches the runs for a Job.
 func (r *JobResolver) Runs(ctx context.Context, args struct {
 	Offset *int32
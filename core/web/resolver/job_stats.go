@@ -0,0 +1,109 @@
+package resolver
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// JobStatsResolver resolves the JobStats type.
+type JobStatsResolver struct {
+	stats pipeline.JobStats
+}
+
+func NewJobStats(stats pipeline.JobStats) *JobStatsResolver {
+	return &JobStatsResolver{stats: stats}
+}
+
+// Windows resolves the stats for each rolling window.
+func (r *JobStatsResolver) Windows() []*JobStatsWindowResolver {
+	resolvers := make([]*JobStatsWindowResolver, 0, len(r.stats.Windows))
+	for _, w := range r.stats.Windows {
+		resolvers = append(resolvers, &JobStatsWindowResolver{w: w})
+	}
+
+	return resolvers
+}
+
+// JobStatsWindowResolver resolves the JobStatsWindow type.
+type JobStatsWindowResolver struct {
+	w pipeline.JobStatsWindow
+}
+
+// Window resolves the length of the window.
+func (r *JobStatsWindowResolver) Window() string {
+	return r.w.Window.String()
+}
+
+// Runs resolves the number of runs which finished within the window.
+func (r *JobStatsWindowResolver) Runs() int32 {
+	return int32(r.w.Runs)
+}
+
+// ErroredRuns resolves the number of runs which finished with fatal errors.
+func (r *JobStatsWindowResolver) ErroredRuns() int32 {
+	return int32(r.w.ErroredRuns)
+}
+
+// SuccessRatio resolves the fraction of runs which finished without fatal errors.
+func (r *JobStatsWindowResolver) SuccessRatio() float64 {
+	return r.w.SuccessRatio
+}
+
+// RunDuration resolves the run duration percentiles.
+func (r *JobStatsWindowResolver) RunDuration() *LatencyPercentilesResolver {
+	return &LatencyPercentilesResolver{p: r.w.RunDuration}
+}
+
+// Tasks resolves the stats for each task type.
+func (r *JobStatsWindowResolver) Tasks() []*TaskTypeStatsResolver {
+	resolvers := make([]*TaskTypeStatsResolver, 0, len(r.w.Tasks))
+	for _, task := range r.w.Tasks {
+		resolvers = append(resolvers, &TaskTypeStatsResolver{task: task})
+	}
+
+	return resolvers
+}
+
+// TaskTypeStatsResolver resolves the TaskTypeStats type.
+type TaskTypeStatsResolver struct {
+	task pipeline.TaskTypeStats
+}
+
+// Type resolves the task type.
+func (r *TaskTypeStatsResolver) Type() string {
+	return string(r.task.Type)
+}
+
+// Runs resolves the number of finished task runs.
+func (r *TaskTypeStatsResolver) Runs() int32 {
+	return int32(r.task.Runs)
+}
+
+// ErroredRuns resolves the number of task runs which errored.
+func (r *TaskTypeStatsResolver) ErroredRuns() int32 {
+	return int32(r.task.ErroredRuns)
+}
+
+// Duration resolves the task duration percentiles.
+func (r *TaskTypeStatsResolver) Duration() *LatencyPercentilesResolver {
+	return &LatencyPercentilesResolver{p: r.task.Duration}
+}
+
+// LatencyPercentilesResolver resolves the LatencyPercentiles type.
+type LatencyPercentilesResolver struct {
+	p pipeline.LatencyPercentiles
+}
+
+// P50 resolves the median.
+func (r *LatencyPercentilesResolver) P50() string {
+	return r.p.P50.String()
+}
+
+// P95 resolves the 95th percentile.
+func (r *LatencyPercentilesResolver) P95() string {
+	return r.p.P95.String()
+}
+
+// P99 resolves the 99th percentile.
+func (r *LatencyPercentilesResolver) P99() string {
+	return r.p.P99.String()
+}
@@ -290,6 +290,113 @@ func TestResolver_Job(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_JobStats(t *testing.T) {
+	var (
+		id = int32(1)
+
+		query = `
+			query GetJob {
+				job(id: "1") {
+					... on Job {
+						stats {
+							windows {
+								window
+								runs
+								erroredRuns
+								successRatio
+								runDuration {
+									p50
+									p95
+									p99
+								}
+								tasks {
+									type
+									runs
+									erroredRuns
+									duration {
+										p50
+										p95
+										p99
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		`
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "job"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
+					ID:            1,
+					Type:          job.OffchainReporting,
+					OCROracleSpec: &job.OCROracleSpec{},
+				}, nil)
+				f.App.On("JobStatsV2", id).Return(pipeline.JobStats{
+					JobID: 1,
+					Windows: []pipeline.JobStatsWindow{
+						{
+							Window:       time.Hour,
+							Runs:         10,
+							ErroredRuns:  1,
+							SuccessRatio: 0.9,
+							RunDuration:  pipeline.LatencyPercentiles{P50: 100 * time.Millisecond, P95: time.Second, P99: 2 * time.Second},
+							Tasks: []pipeline.TaskTypeStats{{
+								Type:        pipeline.TaskTypeHTTP,
+								Runs:        10,
+								ErroredRuns: 1,
+								Duration:    pipeline.LatencyPercentiles{P50: 50 * time.Millisecond, P95: 900 * time.Millisecond, P99: 1900 * time.Millisecond},
+							}},
+						},
+						{
+							Window:      24 * time.Hour,
+							RunDuration: pipeline.LatencyPercentiles{},
+						},
+					},
+				})
+			},
+			query: query,
+			result: `
+				{
+					"job": {
+						"stats": {
+							"windows": [{
+								"window": "1h0m0s",
+								"runs": 10,
+								"erroredRuns": 1,
+								"successRatio": 0.9,
+								"runDuration": {"p50": "100ms", "p95": "1s", "p99": "2s"},
+								"tasks": [{
+									"type": "http",
+									"runs": 10,
+									"erroredRuns": 1,
+									"duration": {"p50": "50ms", "p95": "900ms", "p99": "1.9s"}
+								}]
+							}, {
+								"window": "24h0m0s",
+								"runs": 0,
+								"erroredRuns": 0,
+								"successRatio": 0,
+								"runDuration": {"p50": "0s", "p95": "0s", "p99": "0s"},
+								"tasks": []
+							}]
+						}
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_CreateJob(t *testing.T) {
 	t.Parallel()
 
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
@@ -629,3 +630,65 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// WorkflowExecution retrieves a workflow execution, along with its steps.
+func (r *Resolver) WorkflowExecution(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*WorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	we, err := r.App.WorkflowORM().Get(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewWorkflowExecutionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewWorkflowExecutionPayload(&we, nil), nil
+}
+
+// WorkflowExecutions retrieves a paginated list of workflow executions, most recent first.
+func (r *Resolver) WorkflowExecutions(ctx context.Context, args struct {
+	Offset         *int32
+	Limit          *int32
+	WorkflowID     *string
+	Status         *string
+	TriggerEventID *string
+	CreatedAfter   *graphql.Time
+	CreatedBefore  *graphql.Time
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	limit := pageLimit(args.Limit)
+	offset := pageOffset(args.Offset)
+
+	var filter store.ListFilter
+	if args.WorkflowID != nil {
+		filter.WorkflowID = *args.WorkflowID
+	}
+	if args.Status != nil {
+		filter.Status = *args.Status
+	}
+	if args.TriggerEventID != nil {
+		filter.TriggerEventID = *args.TriggerEventID
+	}
+	if args.CreatedAfter != nil {
+		filter.CreatedAfter = &args.CreatedAfter.Time
+	}
+	if args.CreatedBefore != nil {
+		filter.CreatedBefore = &args.CreatedBefore.Time
+	}
+
+	executions, count, err := r.App.WorkflowORM().List(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(executions, int32(count)), nil
+}
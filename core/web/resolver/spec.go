@@ -781,6 +781,11 @@ func (b *BlockhashStoreSpecResolver) TrustedBlockhashStoreBatchSize() int32 {
 	return b.spec.TrustedBlockhashStoreBatchSize
 }
 
+// BatchTransactions returns the job's BatchTransactions param.
+func (b *BlockhashStoreSpecResolver) BatchTransactions() bool {
+	return b.spec.BatchTransactions
+}
+
 // PollPeriod return's the job's PollPeriod param.
 func (b *BlockhashStoreSpecResolver) PollPeriod() string {
 	return b.spec.PollPeriod.String()
@@ -763,6 +763,7 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 						BlockhashStoreAddress:          blockhashStoreAddress,
 						TrustedBlockhashStoreAddress:   &trustedBlockhashStoreAddress,
 						TrustedBlockhashStoreBatchSize: trustedBlockhashStoreBatchSize,
+						BatchTransactions:              true,
 					},
 				}, nil)
 			},
@@ -787,6 +788,7 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 									trustedBlockhashStoreAddress
 									trustedBlockhashStoreBatchSize
 									heartbeatPeriod
+									batchTransactions
 								}
 							}
 						}
@@ -811,7 +813,8 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 							"blockhashStoreAddress": "0xb26A6829D454336818477B946f03Fb21c9706f3A",
 							"trustedBlockhashStoreAddress": "0x0ad9FE7a58216242a8475ca92F222b0640E26B63",
 							"trustedBlockhashStoreBatchSize": 20,
-							"heartbeatPeriod": "7m30s"
+							"heartbeatPeriod": "7m30s",
+							"batchTransactions": true
 						}
 					}
 				}
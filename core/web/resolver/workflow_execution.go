@@ -0,0 +1,203 @@
+package resolver
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResolver resolves the WorkflowExecution type.
+type WorkflowExecutionResolver struct {
+	we store.WorkflowExecution
+}
+
+func NewWorkflowExecution(we store.WorkflowExecution) *WorkflowExecutionResolver {
+	return &WorkflowExecutionResolver{we: we}
+}
+
+func NewWorkflowExecutions(wes []store.WorkflowExecution) []*WorkflowExecutionResolver {
+	resolvers := make([]*WorkflowExecutionResolver, 0, len(wes))
+	for _, we := range wes {
+		resolvers = append(resolvers, NewWorkflowExecution(we))
+	}
+	return resolvers
+}
+
+func (r *WorkflowExecutionResolver) ID() graphql.ID {
+	return graphql.ID(r.we.ExecutionID)
+}
+
+func (r *WorkflowExecutionResolver) WorkflowID() string {
+	return r.we.WorkflowID
+}
+
+func (r *WorkflowExecutionResolver) TriggerEventID() string {
+	return r.we.TriggerEventID
+}
+
+func (r *WorkflowExecutionResolver) Status() string {
+	return r.we.Status
+}
+
+func (r *WorkflowExecutionResolver) CreatedAt() *graphql.Time {
+	return optionalTime(r.we.CreatedAt)
+}
+
+func (r *WorkflowExecutionResolver) UpdatedAt() *graphql.Time {
+	return optionalTime(r.we.UpdatedAt)
+}
+
+func (r *WorkflowExecutionResolver) FinishedAt() *graphql.Time {
+	return optionalTime(r.we.FinishedAt)
+}
+
+// Steps returns the steps of the execution, sorted by ref.
+func (r *WorkflowExecutionResolver) Steps() []*WorkflowExecutionStepResolver {
+	resolvers := make([]*WorkflowExecutionStepResolver, 0, len(r.we.Steps))
+	for _, step := range r.we.Steps {
+		resolvers = append(resolvers, &WorkflowExecutionStepResolver{step: step})
+	}
+	sort.Slice(resolvers, func(i, j int) bool { return resolvers[i].step.Ref < resolvers[j].step.Ref })
+	return resolvers
+}
+
+// WorkflowExecutionStepResolver resolves the WorkflowExecutionStep type.
+type WorkflowExecutionStepResolver struct {
+	step *store.WorkflowExecutionStep
+}
+
+func (r *WorkflowExecutionStepResolver) Ref() string {
+	return r.step.Ref
+}
+
+func (r *WorkflowExecutionStepResolver) Status() string {
+	return r.step.Status
+}
+
+func (r *WorkflowExecutionStepResolver) Inputs() (*string, error) {
+	if r.step.Inputs == nil {
+		return nil, nil
+	}
+	return valueToJSON(r.step.Inputs)
+}
+
+func (r *WorkflowExecutionStepResolver) Outputs() (*string, error) {
+	return valueToJSON(r.step.Outputs.Value)
+}
+
+func (r *WorkflowExecutionStepResolver) Error() *string {
+	if r.step.Outputs.Err == nil {
+		return nil
+	}
+	errMsg := r.step.Outputs.Err.Error()
+	return &errMsg
+}
+
+// Attempts returns the attempts made at executing the capability of the step, in order.
+func (r *WorkflowExecutionStepResolver) Attempts() []*WorkflowExecutionStepAttemptResolver {
+	resolvers := make([]*WorkflowExecutionStepAttemptResolver, 0, len(r.step.Attempts))
+	for _, attempt := range r.step.Attempts {
+		resolvers = append(resolvers, &WorkflowExecutionStepAttemptResolver{attempt: attempt})
+	}
+	return resolvers
+}
+
+func (r *WorkflowExecutionStepResolver) UpdatedAt() *graphql.Time {
+	return optionalTime(r.step.UpdatedAt)
+}
+
+// WorkflowExecutionStepAttemptResolver resolves the WorkflowExecutionStepAttempt type.
+type WorkflowExecutionStepAttemptResolver struct {
+	attempt store.StepAttempt
+}
+
+func (r *WorkflowExecutionStepAttemptResolver) StartedAt() graphql.Time {
+	return graphql.Time{Time: r.attempt.StartedAt}
+}
+
+func (r *WorkflowExecutionStepAttemptResolver) FinishedAt() graphql.Time {
+	return graphql.Time{Time: r.attempt.FinishedAt}
+}
+
+func (r *WorkflowExecutionStepAttemptResolver) Error() *string {
+	if r.attempt.Err == "" {
+		return nil
+	}
+	errMsg := r.attempt.Err
+	return &errMsg
+}
+
+func optionalTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
+
+func valueToJSON(v values.Value) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	unwrapped, err := v.Unwrap()
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(unwrapped)
+	if err != nil {
+		return nil, err
+	}
+	s := string(b)
+	return &s, nil
+}
+
+// -- WorkflowExecution query --
+
+type WorkflowExecutionPayloadResolver struct {
+	we *store.WorkflowExecution
+	NotFoundErrorUnionType
+}
+
+func NewWorkflowExecutionPayload(we *store.WorkflowExecution, err error) *WorkflowExecutionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "workflow execution not found", isExpectedErrorFn: nil}
+
+	return &WorkflowExecutionPayloadResolver{we: we, NotFoundErrorUnionType: e}
+}
+
+func (r *WorkflowExecutionPayloadResolver) ToWorkflowExecution() (*WorkflowExecutionResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewWorkflowExecution(*r.we), true
+}
+
+// -- WorkflowExecutions query --
+
+// WorkflowExecutionsPayloadResolver resolves a page of workflow executions
+type WorkflowExecutionsPayloadResolver struct {
+	executions []store.WorkflowExecution
+	total      int32
+}
+
+func NewWorkflowExecutionsPayload(executions []store.WorkflowExecution, total int32) *WorkflowExecutionsPayloadResolver {
+	return &WorkflowExecutionsPayloadResolver{
+		executions: executions,
+		total:      total,
+	}
+}
+
+// Results returns the workflow executions.
+func (r *WorkflowExecutionsPayloadResolver) Results() []*WorkflowExecutionResolver {
+	return NewWorkflowExecutions(r.executions)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowExecutionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
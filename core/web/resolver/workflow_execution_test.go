@@ -0,0 +1,236 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestQuery_PaginatedWorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecutions {
+			workflowExecutions(workflowID: "workflow-1", status: "errored", createdAfter: "2021-01-01T00:00:00Z") {
+				results {
+					id
+					workflowID
+					triggerEventID
+					status
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "workflowExecutions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("List", mock.Anything, mock.MatchedBy(func(filter store.ListFilter) bool {
+					return filter.WorkflowID == "workflow-1" && filter.Status == store.StatusErrored && filter.TriggerEventID == "" &&
+						filter.CreatedAfter != nil && filter.CreatedAfter.Equal(f.Timestamp()) && filter.CreatedBefore == nil
+				}), PageDefaultOffset, PageDefaultLimit).Return([]store.WorkflowExecution{
+					{
+						ExecutionID:    "execution-1",
+						WorkflowID:     "workflow-1",
+						TriggerEventID: "event-1",
+						Status:         store.StatusErrored,
+					},
+				}, 1, nil)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query: query,
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [{
+							"id": "execution-1",
+							"workflowID": "workflow-1",
+							"triggerEventID": "event-1",
+							"status": "errored"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+		{
+			name:          "generic error on List()",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("List", mock.Anything, mock.Anything, PageDefaultOffset, PageDefaultLimit).Return(nil, 0, gError)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"workflowExecutions"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_WorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecution($id: ID!) {
+			workflowExecution(id: $id) {
+				... on WorkflowExecution {
+					id
+					status
+					createdAt
+					finishedAt
+					steps {
+						ref
+						status
+						inputs
+						outputs
+						error
+						attempts {
+							startedAt
+							error
+						}
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": "execution-1",
+	}
+	gError := errors.New("error")
+
+	inputs, err := values.NewMap(map[string]any{"foo": "bar"})
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecution"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				ts := f.Timestamp()
+				f.Mocks.workflowORM.On("Get", mock.Anything, "execution-1").Return(store.WorkflowExecution{
+					ExecutionID: "execution-1",
+					WorkflowID:  "workflow-1",
+					Status:      store.StatusErrored,
+					CreatedAt:   &ts,
+					FinishedAt:  &ts,
+					Steps: map[string]*store.WorkflowExecutionStep{
+						"write": {
+							Ref:     "write",
+							Status:  store.StatusErrored,
+							Outputs: store.StepOutput{Err: errors.New("write failed")},
+							Attempts: []store.StepAttempt{
+								{StartedAt: ts, FinishedAt: ts, Err: "write failed"},
+							},
+						},
+						"consensus": {
+							Ref:     "consensus",
+							Status:  store.StatusCompleted,
+							Inputs:  inputs,
+							Outputs: store.StepOutput{Value: values.NewString("baz")},
+						},
+					},
+				}, nil)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"id": "execution-1",
+						"status": "errored",
+						"createdAt": "2021-01-01T00:00:00Z",
+						"finishedAt": "2021-01-01T00:00:00Z",
+						"steps": [{
+							"ref": "consensus",
+							"status": "completed",
+							"inputs": "{\"foo\":\"bar\"}",
+							"outputs": "\"baz\"",
+							"error": null,
+							"attempts": []
+						}, {
+							"ref": "write",
+							"status": "errored",
+							"inputs": null,
+							"outputs": null,
+							"error": "write failed",
+							"attempts": [{
+								"startedAt": "2021-01-01T00:00:00Z",
+								"error": "write failed"
+							}]
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("Get", mock.Anything, "execution-1").Return(store.WorkflowExecution{}, sql.ErrNoRows)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"code": "NOT_FOUND",
+						"message": "workflow execution not found"
+					}
+				}`,
+		},
+		{
+			name:          "generic error on Get()",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("Get", mock.Anything, "execution-1").Return(store.WorkflowExecution{}, gError)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"workflowExecution"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
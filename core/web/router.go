@@ -283,9 +283,14 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
 		authv2.GET("/tx_attempts/evm", paginatedRequest(tas.Index))
 
+		tses := TxStatusEventsController{app}
+		authv2.GET("/tx_status_events/evm", tses.Stream)
+
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.POST("/transactions/evm/:ID/cancel", auth.RequiresAdminRole(txs.Cancel))
+		authv2.POST("/transactions/evm/:ID/replace", auth.RequiresAdminRole(txs.Replace))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
@@ -369,15 +374,35 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
+		authv2.GET("/jobs/:ID/stats", jc.Stats)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresRunRole(jc.Simulate))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
+		authv2.POST("/pipeline/runs/:runID/replay", auth.RequiresRunRole(prc.Replay))
+		authv2.DELETE("/pipeline/runs/:runID", auth.RequiresRunRole(prc.Cancel))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		wec := WorkflowExecutionsController{app}
+		authv2.GET("/workflows/executions", paginatedRequest(wec.Index))
+		authv2.GET("/workflows/executions/:executionID", wec.Show)
+		authv2.POST("/workflows/executions/:executionID/resume", auth.RequiresRunRole(wec.Resume))
+		authv2.POST("/workflows/executions/:executionID/rerun", auth.RequiresRunRole(wec.Rerun))
+
+		wsc := WorkflowSecretsController{app}
+		authv2.GET("/workflows/secrets", wsc.Index)
+		authv2.POST("/workflows/secrets", auth.RequiresEditRole(wsc.Create))
+		authv2.DELETE("/workflows/secrets/:name", auth.RequiresAdminRole(wsc.Delete))
+
+		wvc := WorkflowVersionsController{app}
+		authv2.GET("/workflows/:ID/versions", wvc.Index)
+		authv2.POST("/workflows/:ID/upgrade", auth.RequiresEditRole(wvc.Upgrade))
+		authv2.POST("/workflows/:ID/rollback", auth.RequiresEditRole(wvc.Rollback))
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
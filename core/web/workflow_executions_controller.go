@@ -0,0 +1,143 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowExecutionsController browses the history of workflow executions.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+}
+
+// Index lists workflow executions, most recent first. Executions can be
+// filtered by workflowID, status, triggerEventID, and by an inclusive
+// createdAfter/createdBefore range of RFC3339 timestamps.
+// Example:
+// "GET <application>/workflows/executions?workflowID=<id>&status=errored"
+func (wec *WorkflowExecutionsController) Index(c *gin.Context, size, page, offset int) {
+	filter := store.ListFilter{
+		WorkflowID:     c.Query("workflowID"),
+		Status:         c.Query("status"),
+		TriggerEventID: c.Query("triggerEventID"),
+	}
+	if filter.Status != "" && !store.ValidStatuses[filter.Status] {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid status %q", filter.Status))
+		return
+	}
+
+	var err error
+	if filter.CreatedAfter, err = parseTimeQuery(c, "createdAfter"); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if filter.CreatedBefore, err = parseTimeQuery(c, "createdBefore"); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	executions, count, err := wec.App.WorkflowORM().List(c.Request.Context(), filter, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewWorkflowExecutionResources(executions, wec.App.GetLogger())
+	paginatedResponse(c, "workflowExecution", size, page, res, count, err)
+}
+
+// Show returns a workflow execution, along with the inputs and outputs of its steps.
+// Example:
+// "GET <application>/workflows/executions/:executionID"
+func (wec *WorkflowExecutionsController) Show(c *gin.Context) {
+	execution, err := wec.App.WorkflowORM().Get(c.Request.Context(), c.Param("executionID"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow execution not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewWorkflowExecutionResource(execution, wec.App.GetLogger())
+	jsonAPIResponse(c, res, "workflowExecution")
+}
+
+// Resume resumes a failed or timed out workflow execution from the steps which
+// didn't complete, reusing the outputs of the completed ones.
+// Example:
+// "POST <application>/workflows/executions/:executionID/resume"
+func (wec *WorkflowExecutionsController) Resume(c *gin.Context) {
+	ctx := c.Request.Context()
+	executionID := c.Param("executionID")
+	if err := wec.App.ResumeWorkflowExecution(ctx, executionID); err != nil {
+		handleWorkflowExecutionActionError(c, err)
+		return
+	}
+
+	wec.App.GetAuditLogger().Audit(audit.WorkflowExecutionResumed, map[string]interface{}{"executionID": executionID})
+
+	wec.respondWithExecution(c, executionID)
+}
+
+// Rerun starts a new execution of the workflow from scratch, with the trigger
+// event of the given execution, and returns the new execution.
+// Example:
+// "POST <application>/workflows/executions/:executionID/rerun"
+func (wec *WorkflowExecutionsController) Rerun(c *gin.Context) {
+	ctx := c.Request.Context()
+	executionID := c.Param("executionID")
+	rerunID, err := wec.App.RerunWorkflowExecution(ctx, executionID)
+	if err != nil {
+		handleWorkflowExecutionActionError(c, err)
+		return
+	}
+
+	wec.App.GetAuditLogger().Audit(audit.WorkflowExecutionRerun, map[string]interface{}{"executionID": executionID, "rerunExecutionID": rerunID})
+
+	wec.respondWithExecution(c, rerunID)
+}
+
+func (wec *WorkflowExecutionsController) respondWithExecution(c *gin.Context, executionID string) {
+	execution, err := wec.App.WorkflowORM().Get(c.Request.Context(), executionID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewWorkflowExecutionResource(execution, wec.App.GetLogger())
+	jsonAPIResponse(c, res, "workflowExecution")
+}
+
+func handleWorkflowExecutionActionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow execution not found"))
+	case errors.Is(err, workflows.ErrExecutionNotResumable), errors.Is(err, workflows.ErrExecutionInProgress), errors.Is(err, workflows.ErrEngineNotFound):
+		jsonAPIError(c, http.StatusConflict, err)
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	}
+}
+
+func parseTimeQuery(c *gin.Context, key string) (*time.Time, error) {
+	s := c.Query(key)
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", key)
+	}
+	return &t, nil
+}
@@ -0,0 +1,118 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionsController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	inputs, err := values.NewMap(map[string]any{"foo": "bar"})
+	require.NoError(t, err)
+	for _, es := range []store.WorkflowExecution{
+		{
+			ExecutionID:    "execution-1",
+			TriggerEventID: "event-1",
+			Status:         store.StatusCompleted,
+			Steps: map[string]*store.WorkflowExecutionStep{
+				"step1": {
+					ExecutionID: "execution-1",
+					Ref:         "step1",
+					Status:      store.StatusCompleted,
+					Inputs:      inputs,
+					Outputs:     store.StepOutput{Value: values.NewString("baz")},
+				},
+			},
+		},
+		{
+			ExecutionID:    "execution-2",
+			TriggerEventID: "event-2",
+			Status:         store.StatusStarted,
+			Steps:          map[string]*store.WorkflowExecutionStep{},
+		},
+	} {
+		_, err = app.WorkflowORM().Add(ctx, &es)
+		require.NoError(t, err)
+	}
+
+	t.Run("index", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions?triggerEventID=event-1")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var executions []presenters.WorkflowExecutionResource
+		body := cltest.ParseResponseBody(t, resp)
+		assert.Contains(t, string(body), `"meta":{"count":1}`)
+		require.NoError(t, web.ParseJSONAPIResponse(body, &executions))
+		require.Len(t, executions, 1)
+		assert.Equal(t, "execution-1", executions[0].ID)
+		assert.Equal(t, "event-1", executions[0].TriggerEventID)
+	})
+
+	t.Run("index with invalid filters", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions?status=unknown")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+		resp, cleanup = client.Get("/v2/workflows/executions?createdAfter=yesterday")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("show", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions/execution-1")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var execution presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &execution))
+		assert.Equal(t, store.StatusCompleted, execution.Status)
+		require.Len(t, execution.Steps, 1)
+		assert.Equal(t, "step1", execution.Steps[0].Ref)
+		assert.Equal(t, map[string]any{"foo": "bar"}, execution.Steps[0].Inputs)
+		assert.Equal(t, "baz", execution.Steps[0].Outputs)
+	})
+
+	t.Run("show unknown execution", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions/unknown")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("resume and rerun unknown execution", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/executions/unknown/resume", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+		resp, cleanup = client.Post("/v2/workflows/executions/unknown/rerun", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("resume and rerun execution of a workflow which isn't running", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/executions/execution-1/resume", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+		resp, cleanup = client.Post("/v2/workflows/executions/execution-1/rerun", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusConflict)
+	})
+}
@@ -0,0 +1,85 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowsecret"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowSecretsController manages the secrets referenced by workflow specs.
+// Their values are write-only: they're never returned by the API.
+type WorkflowSecretsController struct {
+	App chainlink.Application
+}
+
+// CreateWorkflowSecretRequest is a JSONAPI request for creating a workflow secret.
+type CreateWorkflowSecretRequest struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Index lists the names of the workflow secrets
+// Example:
+// "GET <application>/workflows/secrets"
+func (wsc *WorkflowSecretsController) Index(c *gin.Context) {
+	secrets, err := wsc.App.GetKeyStore().WorkflowSecrets().GetAll()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewWorkflowSecretResources(secrets), "workflowSecrets")
+}
+
+// Create stores a new workflow secret
+// Example:
+// "POST <application>/workflows/secrets"
+func (wsc *WorkflowSecretsController) Create(c *gin.Context) {
+	request := CreateWorkflowSecretRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	secret, err := workflowsecret.New(request.Name, request.Value)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err = wsc.App.GetKeyStore().WorkflowSecrets().Add(c.Request.Context(), secret); err != nil {
+		if errors.Is(err, keystore.ErrKeyExists) {
+			jsonAPIError(c, http.StatusConflict, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	wsc.App.GetAuditLogger().Audit(audit.WorkflowSecretCreated, map[string]interface{}{"name": secret.Name()})
+	jsonAPIResponseWithStatus(c, presenters.NewWorkflowSecretResource(secret), "workflowSecret", http.StatusCreated)
+}
+
+// Delete removes a workflow secret
+// Example:
+// "DELETE <application>/workflows/secrets/:name"
+func (wsc *WorkflowSecretsController) Delete(c *gin.Context) {
+	name := c.Param("name")
+	if _, err := wsc.App.GetKeyStore().WorkflowSecrets().Delete(c.Request.Context(), name); err != nil {
+		var notFound keystore.KeyNotFoundError
+		if errors.As(err, &notFound) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	wsc.App.GetAuditLogger().Audit(audit.WorkflowSecretDeleted, map[string]interface{}{"name": name})
+	jsonAPIResponseWithStatus(c, nil, "workflowSecret", http.StatusNoContent)
+}
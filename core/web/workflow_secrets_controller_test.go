@@ -0,0 +1,87 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowSecretsController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	create := func(name, value string) *http.Response {
+		body, err := json.Marshal(web.CreateWorkflowSecretRequest{Name: name, Value: value})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/workflows/secrets", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	t.Run("create", func(t *testing.T) {
+		resp := create("API_KEY", "hunter2")
+		body := cltest.ParseResponseBody(t, resp)
+		require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+		assert.NotContains(t, string(body), "hunter2")
+
+		var secret presenters.WorkflowSecretResource
+		require.NoError(t, web.ParseJSONAPIResponse(body, &secret))
+		assert.Equal(t, "API_KEY", secret.ID)
+
+		stored, err := app.GetKeyStore().WorkflowSecrets().Get("API_KEY")
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", stored.Value())
+	})
+
+	t.Run("create existing or invalid secret", func(t *testing.T) {
+		cltest.AssertServerResponse(t, create("EXISTING", "foo"), http.StatusCreated)
+		cltest.AssertServerResponse(t, create("EXISTING", "bar"), http.StatusConflict)
+		cltest.AssertServerResponse(t, create("not-a-name", "foo"), http.StatusUnprocessableEntity)
+		cltest.AssertServerResponse(t, create("EMPTY", ""), http.StatusUnprocessableEntity)
+	})
+
+	t.Run("index", func(t *testing.T) {
+		cltest.AssertServerResponse(t, create("LISTED", "hunter3"), http.StatusCreated)
+
+		resp, cleanup := client.Get("/v2/workflows/secrets")
+		defer cleanup()
+		body := cltest.ParseResponseBody(t, resp)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		assert.NotContains(t, string(body), "hunter3")
+
+		var secrets []presenters.WorkflowSecretResource
+		require.NoError(t, web.ParseJSONAPIResponse(body, &secrets))
+		var names []string
+		for _, s := range secrets {
+			names = append(names, s.Name)
+		}
+		assert.Contains(t, names, "LISTED")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		cltest.AssertServerResponse(t, create("DELETED", "foo"), http.StatusCreated)
+
+		resp, cleanup := client.Delete("/v2/workflows/secrets/DELETED")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+		_, err := app.GetKeyStore().WorkflowSecrets().Get("DELETED")
+		require.Error(t, err)
+
+		resp, cleanup = client.Delete("/v2/workflows/secrets/DELETED")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
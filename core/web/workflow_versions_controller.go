@@ -0,0 +1,139 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowVersionsController deploys new versions of workflows, and rolls them back.
+// A workflow is identified by the ID of the job running any of its versions.
+type WorkflowVersionsController struct {
+	App chainlink.Application
+}
+
+// UpgradeWorkflowRequest is a JSONAPI request for deploying a new version of a workflow.
+type UpgradeWorkflowRequest struct {
+	TOML string `json:"toml"`
+}
+
+// Index lists the versions of a workflow, the active one first, along with the
+// number of their executions in progress.
+// Example:
+// "GET <application>/workflows/:ID/versions"
+func (wvc *WorkflowVersionsController) Index(c *gin.Context) {
+	jobID, ok := parseWorkflowJobID(c)
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+
+	versions, err := wvc.App.WorkflowVersions(ctx, jobID)
+	if err != nil {
+		workflowVersionError(c, err)
+		return
+	}
+	resources := make([]presenters.WorkflowVersionResource, 0, len(versions))
+	for _, jb := range versions {
+		_, inProgress, err := wvc.App.WorkflowORM().List(ctx, store.ListFilter{
+			WorkflowID: jb.WorkflowSpec.WorkflowID,
+			Status:     store.StatusStarted,
+		}, 0, 0)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		resources = append(resources, *presenters.NewWorkflowVersionResource(jb, inProgress))
+	}
+	jsonAPIResponse(c, resources, "workflowVersions")
+}
+
+// Upgrade deploys a new version of a workflow. The previous version is retired:
+// it starts no new executions, and its executions in progress run to completion.
+// Example:
+// "POST <application>/workflows/:ID/upgrade"
+func (wvc *WorkflowVersionsController) Upgrade(c *gin.Context) {
+	jobID, ok := parseWorkflowJobID(c)
+	if !ok {
+		return
+	}
+	request := UpgradeWorkflowRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jb, err := workflows.ValidatedWorkflowJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err = wvc.App.UpgradeWorkflow(c.Request.Context(), jobID, &jb); err != nil {
+		workflowVersionError(c, err)
+		return
+	}
+
+	wvc.App.GetAuditLogger().Audit(audit.WorkflowUpgraded, map[string]interface{}{
+		"previousJobID":   jobID,
+		"jobID":           jb.ID,
+		"workflowID":      jb.WorkflowSpec.WorkflowID,
+		"workflowVersion": jb.WorkflowSpec.WorkflowVersion,
+	})
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// Rollback reinstates the previous version of a workflow, retiring the active one.
+// Example:
+// "POST <application>/workflows/:ID/rollback"
+func (wvc *WorkflowVersionsController) Rollback(c *gin.Context) {
+	jobID, ok := parseWorkflowJobID(c)
+	if !ok {
+		return
+	}
+
+	jb, err := wvc.App.RollbackWorkflow(c.Request.Context(), jobID)
+	if err != nil {
+		workflowVersionError(c, err)
+		return
+	}
+
+	wvc.App.GetAuditLogger().Audit(audit.WorkflowRolledBack, map[string]interface{}{
+		"retiredJobID":    jobID,
+		"jobID":           jb.ID,
+		"workflowID":      jb.WorkflowSpec.WorkflowID,
+		"workflowVersion": jb.WorkflowSpec.WorkflowVersion,
+	})
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+func parseWorkflowJobID(c *gin.Context) (int32, bool) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return 0, false
+	}
+	return j.ID, true
+}
+
+func workflowVersionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow job not found"))
+	case errors.Is(err, workflows.ErrNotWorkflowJob), errors.Is(err, workflows.ErrInvalidWorkflowUpgrade):
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+	case errors.Is(err, workflows.ErrWorkflowVersionRetired), errors.Is(err, workflows.ErrNoPreviousWorkflowVersion),
+		errors.Is(err, workflows.ErrEngineNotFound):
+		jsonAPIError(c, http.StatusConflict, err)
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,108 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const versionedWorkflow = `
+name: "versioned"
+owner: "0x00000000000000000000000000000000000000bb"
+triggers:
+  - id: "a-trigger@1.0.0"
+    config: {}
+targets:
+  - id: "a-target@1.0.0"
+    config: {}
+    inputs:
+      consensus_output: $(trigger.outputs)
+`
+
+func workflowVersionTOML(t *testing.T, version string) string {
+	// the workflow has to change between versions, for them to have distinct IDs
+	spec := testspecs.GenerateWorkflowJobSpec(t, versionedWorkflow+"# version "+version+"\n")
+	return fmt.Sprintf("%s\nworkflow_version = %q\n", spec.Toml(), version)
+}
+
+func TestWorkflowVersionsController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	body, err := json.Marshal(web.CreateJobRequest{TOML: workflowVersionTOML(t, "1")})
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	v1 := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &v1))
+
+	upgrade := func(jobID, version string) *http.Response {
+		body, err := json.Marshal(web.UpgradeWorkflowRequest{TOML: workflowVersionTOML(t, version)})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/workflows/"+jobID+"/upgrade", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+	versions := func(jobID string) []presenters.WorkflowVersionResource {
+		resp, cleanup := client.Get("/v2/workflows/" + jobID + "/versions")
+		defer cleanup()
+		body := cltest.ParseResponseBody(t, resp)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		var versions []presenters.WorkflowVersionResource
+		require.NoError(t, web.ParseJSONAPIResponse(body, &versions))
+		return versions
+	}
+
+	resp = upgrade(v1.ID, "2")
+	respBody := cltest.ParseResponseBody(t, resp)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(respBody))
+	v2 := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(respBody, &v2))
+	assert.Equal(t, "2", v2.WorkflowSpec.WorkflowVersion)
+	assert.False(t, v2.WorkflowSpec.Retired)
+
+	listed := versions(v1.ID)
+	require.Len(t, listed, 2)
+	assert.Equal(t, v2.ID, listed[0].ID)
+	assert.False(t, listed[0].Retired)
+	assert.Equal(t, v1.ID, listed[1].ID)
+	assert.True(t, listed[1].Retired)
+	assert.Equal(t, "1", listed[1].WorkflowVersion)
+
+	// only the active version can be upgraded, and only to another version
+	cltest.AssertServerResponse(t, upgrade(v1.ID, "3"), http.StatusConflict)
+	cltest.AssertServerResponse(t, upgrade(v2.ID, "2"), http.StatusUnprocessableEntity)
+	cltest.AssertServerResponse(t, upgrade("999999", "3"), http.StatusNotFound)
+
+	resp, cleanup = client.Post("/v2/workflows/"+v2.ID+"/rollback", nil)
+	t.Cleanup(cleanup)
+	respBody = cltest.ParseResponseBody(t, resp)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(respBody))
+	reinstated := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(respBody, &reinstated))
+	assert.Equal(t, v1.ID, reinstated.ID)
+	assert.False(t, reinstated.WorkflowSpec.Retired)
+
+	listed = versions(v2.ID)
+	require.Len(t, listed, 2)
+	assert.Equal(t, v1.ID, listed[0].ID)
+	assert.True(t, listed[1].Retired)
+
+	resp, cleanup = client.Post("/v2/workflows/"+v2.ID+"/rollback", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+}